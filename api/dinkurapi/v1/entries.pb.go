@@ -75,20 +75,71 @@ const (
 	// on monday next week and the default end timestamp to 23:59:59 on sunday
 	// next week.
 	GetEntryListRequest_SHORTHAND_NEXT_MON_TO_SUN GetEntryListRequest_Shorthand = 8
+	// SHORTHAND_THIS_MONTH sets the default start timestamp to 00:00:00 on the
+	// first day of this month and the default end timestamp to 23:59:59 on
+	// the last day of this month.
+	GetEntryListRequest_SHORTHAND_THIS_MONTH GetEntryListRequest_Shorthand = 9
+	// SHORTHAND_PREV_MONTH sets the default start timestamp to 00:00:00 on the
+	// first day of last month and the default end timestamp to 23:59:59 on
+	// the last day of last month.
+	GetEntryListRequest_SHORTHAND_PREV_MONTH GetEntryListRequest_Shorthand = 10
+	// SHORTHAND_NEXT_MONTH sets the default start timestamp to 00:00:00 on the
+	// first day of next month and the default end timestamp to 23:59:59 on
+	// the last day of next month.
+	GetEntryListRequest_SHORTHAND_NEXT_MONTH GetEntryListRequest_Shorthand = 11
+	// SHORTHAND_THIS_QUARTER sets the default start timestamp to 00:00:00 on
+	// the first day of this quarter and the default end timestamp to 23:59:59
+	// on the last day of this quarter. Quarters start in january, april, july,
+	// and october.
+	GetEntryListRequest_SHORTHAND_THIS_QUARTER GetEntryListRequest_Shorthand = 12
+	// SHORTHAND_PREV_QUARTER sets the default start timestamp to 00:00:00 on
+	// the first day of last quarter and the default end timestamp to 23:59:59
+	// on the last day of last quarter.
+	GetEntryListRequest_SHORTHAND_PREV_QUARTER GetEntryListRequest_Shorthand = 13
+	// SHORTHAND_NEXT_QUARTER sets the default start timestamp to 00:00:00 on
+	// the first day of next quarter and the default end timestamp to 23:59:59
+	// on the last day of next quarter.
+	GetEntryListRequest_SHORTHAND_NEXT_QUARTER GetEntryListRequest_Shorthand = 14
+	// SHORTHAND_THIS_YEAR sets the default start timestamp to 00:00:00 on
+	// january 1st this year and the default end timestamp to 23:59:59 on
+	// december 31st this year.
+	GetEntryListRequest_SHORTHAND_THIS_YEAR GetEntryListRequest_Shorthand = 15
+	// SHORTHAND_PREV_YEAR sets the default start timestamp to 00:00:00 on
+	// january 1st last year and the default end timestamp to 23:59:59 on
+	// december 31st last year.
+	GetEntryListRequest_SHORTHAND_PREV_YEAR GetEntryListRequest_Shorthand = 16
+	// SHORTHAND_NEXT_YEAR sets the default start timestamp to 00:00:00 on
+	// january 1st next year and the default end timestamp to 23:59:59 on
+	// december 31st next year.
+	GetEntryListRequest_SHORTHAND_NEXT_YEAR GetEntryListRequest_Shorthand = 17
+	// SHORTHAND_LAST_DAYS sets the default start timestamp to 00:00:00 N-1
+	// days ago and the default end timestamp to 23:59:59 today, where N is
+	// set by the shorthand count field.
+	GetEntryListRequest_SHORTHAND_LAST_DAYS GetEntryListRequest_Shorthand = 18
 )
 
 // Enum value maps for GetEntryListRequest_Shorthand.
 var (
 	GetEntryListRequest_Shorthand_name = map[int32]string{
-		0: "SHORTHAND_UNSPECIFIED",
-		1: "SHORTHAND_PAST",
-		2: "SHORTHAND_FUTURE",
-		3: "SHORTHAND_THIS_DAY",
-		4: "SHORTHAND_THIS_MON_TO_SUN",
-		5: "SHORTHAND_PREV_DAY",
-		6: "SHORTHAND_PREV_MON_TO_SUN",
-		7: "SHORTHAND_NEXT_DAY",
-		8: "SHORTHAND_NEXT_MON_TO_SUN",
+		0:  "SHORTHAND_UNSPECIFIED",
+		1:  "SHORTHAND_PAST",
+		2:  "SHORTHAND_FUTURE",
+		3:  "SHORTHAND_THIS_DAY",
+		4:  "SHORTHAND_THIS_MON_TO_SUN",
+		5:  "SHORTHAND_PREV_DAY",
+		6:  "SHORTHAND_PREV_MON_TO_SUN",
+		7:  "SHORTHAND_NEXT_DAY",
+		8:  "SHORTHAND_NEXT_MON_TO_SUN",
+		9:  "SHORTHAND_THIS_MONTH",
+		10: "SHORTHAND_PREV_MONTH",
+		11: "SHORTHAND_NEXT_MONTH",
+		12: "SHORTHAND_THIS_QUARTER",
+		13: "SHORTHAND_PREV_QUARTER",
+		14: "SHORTHAND_NEXT_QUARTER",
+		15: "SHORTHAND_THIS_YEAR",
+		16: "SHORTHAND_PREV_YEAR",
+		17: "SHORTHAND_NEXT_YEAR",
+		18: "SHORTHAND_LAST_DAYS",
 	}
 	GetEntryListRequest_Shorthand_value = map[string]int32{
 		"SHORTHAND_UNSPECIFIED":     0,
@@ -100,6 +151,16 @@ var (
 		"SHORTHAND_PREV_MON_TO_SUN": 6,
 		"SHORTHAND_NEXT_DAY":        7,
 		"SHORTHAND_NEXT_MON_TO_SUN": 8,
+		"SHORTHAND_THIS_MONTH":      9,
+		"SHORTHAND_PREV_MONTH":      10,
+		"SHORTHAND_NEXT_MONTH":      11,
+		"SHORTHAND_THIS_QUARTER":    12,
+		"SHORTHAND_PREV_QUARTER":    13,
+		"SHORTHAND_NEXT_QUARTER":    14,
+		"SHORTHAND_THIS_YEAR":       15,
+		"SHORTHAND_PREV_YEAR":       16,
+		"SHORTHAND_NEXT_YEAR":       17,
+		"SHORTHAND_LAST_DAYS":       18,
 	}
 )
 
@@ -433,6 +494,10 @@ type GetEntryListRequest struct {
 	// NameHighlightEnd enables name search result highlighting. It does nothing
 	// if the fuzzy name query is empty.
 	NameHighlightEnd string `protobuf:"bytes,7,opt,name=name_highlight_end,json=nameHighlightEnd,proto3" json:"name_highlight_end,omitempty"`
+	// ShorthandCount is the number of units used by rolling shorthands, such as
+	// the N in SHORTHAND_LAST_DAYS. A value of zero is treated as 1. It is
+	// ignored by all other shorthands.
+	ShorthandCount uint64 `protobuf:"varint,8,opt,name=shorthand_count,json=shorthandCount,proto3" json:"shorthand_count,omitempty"`
 }
 
 func (x *GetEntryListRequest) Reset() {
@@ -516,6 +581,13 @@ func (x *GetEntryListRequest) GetNameHighlightEnd() string {
 	return ""
 }
 
+func (x *GetEntryListRequest) GetShorthandCount() uint64 {
+	if x != nil {
+		return x.ShorthandCount
+	}
+	return 0
+}
+
 // GetEntryListResponse holds the list of entries that matches the search
 // request.
 type GetEntryListResponse struct {
@@ -1131,6 +1203,225 @@ func (x *StopActiveEntryResponse) GetStoppedEntry() *Entry {
 	return nil
 }
 
+// PauseActiveEntryRequest holds fields used when pausing the currently active
+// entry.
+type PauseActiveEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// End allows changing the end timestamp of the active entry to pause. If not
+	// set, the current timestamp is used instead.
+	End *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *PauseActiveEntryRequest) Reset() {
+	*x = PauseActiveEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseActiveEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseActiveEntryRequest) ProtoMessage() {}
+
+func (x *PauseActiveEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseActiveEntryRequest.ProtoReflect.Descriptor instead.
+func (*PauseActiveEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PauseActiveEntryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// PauseActiveEntryResponse holds the entry that was paused (if any).
+type PauseActiveEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PausedEntry is the entry that was paused (if any).
+	PausedEntry *Entry `protobuf:"bytes,1,opt,name=paused_entry,json=pausedEntry,proto3" json:"paused_entry,omitempty"`
+}
+
+func (x *PauseActiveEntryResponse) Reset() {
+	*x = PauseActiveEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseActiveEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseActiveEntryResponse) ProtoMessage() {}
+
+func (x *PauseActiveEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseActiveEntryResponse.ProtoReflect.Descriptor instead.
+func (*PauseActiveEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PauseActiveEntryResponse) GetPausedEntry() *Entry {
+	if x != nil {
+		return x.PausedEntry
+	}
+	return nil
+}
+
+// ResumeEntryRequest holds fields used when resuming an entry.
+type ResumeEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IdOrZero is the ID of the entry to resume. If zero, then the paused entry
+	// is resumed.
+	IdOrZero uint64 `protobuf:"varint,1,opt,name=id_or_zero,json=idOrZero,proto3" json:"id_or_zero,omitempty"`
+	// Start allows changing the start timestamp of the resumed entry. If not
+	// set, the current timestamp is used instead.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *ResumeEntryRequest) Reset() {
+	*x = ResumeEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeEntryRequest) ProtoMessage() {}
+
+func (x *ResumeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeEntryRequest.ProtoReflect.Descriptor instead.
+func (*ResumeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResumeEntryRequest) GetIdOrZero() uint64 {
+	if x != nil {
+		return x.IdOrZero
+	}
+	return 0
+}
+
+func (x *ResumeEntryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+// ResumeEntryResponse holds the previously active entry (if any) and the newly
+// created entry that continues the resumed entry.
+type ResumeEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PreviouslyActiveEntry is the entry that was stopped (if any).
+	PreviouslyActiveEntry *Entry `protobuf:"bytes,1,opt,name=previously_active_entry,json=previouslyActiveEntry,proto3" json:"previously_active_entry,omitempty"`
+	// ResumedEntry is the newly created entry.
+	ResumedEntry *Entry `protobuf:"bytes,2,opt,name=resumed_entry,json=resumedEntry,proto3" json:"resumed_entry,omitempty"`
+}
+
+func (x *ResumeEntryResponse) Reset() {
+	*x = ResumeEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeEntryResponse) ProtoMessage() {}
+
+func (x *ResumeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeEntryResponse.ProtoReflect.Descriptor instead.
+func (*ResumeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResumeEntryResponse) GetPreviouslyActiveEntry() *Entry {
+	if x != nil {
+		return x.PreviouslyActiveEntry
+	}
+	return nil
+}
+
+func (x *ResumeEntryResponse) GetResumedEntry() *Entry {
+	if x != nil {
+		return x.ResumedEntry
+	}
+	return nil
+}
+
 // StreamEntryRequest is an empty message and unused. It is here as a
 // placeholder for potential future use.
 type StreamEntryRequest struct {
@@ -1142,7 +1433,7 @@ type StreamEntryRequest struct {
 func (x *StreamEntryRequest) Reset() {
 	*x = StreamEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +1446,7 @@ func (x *StreamEntryRequest) String() string {
 func (*StreamEntryRequest) ProtoMessage() {}
 
 func (x *StreamEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1459,7 @@ func (x *StreamEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEntryRequest.ProtoReflect.Descriptor instead.
 func (*StreamEntryRequest) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{16}
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{20}
 }
 
 // StreamEntryResponse is a entry event. A entry has been created, updated,
@@ -1187,7 +1478,7 @@ type StreamEntryResponse struct {
 func (x *StreamEntryResponse) Reset() {
 	*x = StreamEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1200,7 +1491,7 @@ func (x *StreamEntryResponse) String() string {
 func (*StreamEntryResponse) ProtoMessage() {}
 
 func (x *StreamEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1504,7 @@ func (x *StreamEntryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEntryResponse.ProtoReflect.Descriptor instead.
 func (*StreamEntryResponse) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{17}
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StreamEntryResponse) GetEntry() *Entry {
@@ -1253,12 +1544,20 @@ type Entry struct {
 	// End is the ending timestamp of this entry, as specified by the user, or
 	// is left unset if the entry is currently active.
 	End *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	// Uuid is the globally unique identifier of this entry. Unlike the Id,
+	// which is only unique within a single database, the Uuid is the same across
+	// all databases the entry has been synced to.
+	Uuid string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// ContinuesUuid is the Uuid of the entry that this entry continues, such as
+	// when resuming a paused entry, or is left empty. Entries linked this way
+	// make up segments of the same task.
+	ContinuesUuid string `protobuf:"bytes,8,opt,name=continues_uuid,json=continuesUuid,proto3" json:"continues_uuid,omitempty"`
 }
 
 func (x *Entry) Reset() {
 	*x = Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1271,7 +1570,7 @@ func (x *Entry) String() string {
 func (*Entry) ProtoMessage() {}
 
 func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1583,7 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entry.ProtoReflect.Descriptor instead.
 func (*Entry) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{18}
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Entry) GetId() uint64 {
@@ -1329,6 +1628,20 @@ func (x *Entry) GetEnd() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Entry) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Entry) GetContinuesUuid() string {
+	if x != nil {
+		return x.ContinuesUuid
+	}
+	return ""
+}
+
 var File_api_dinkurapi_v1_entries_proto protoreflect.FileDescriptor
 
 var file_api_dinkurapi_v1_entries_proto_rawDesc = []byte{
@@ -1353,7 +1666,7 @@ var file_api_dinkurapi_v1_entries_proto_rawDesc = []byte{
 	0x65, 0x12, 0x36, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xcd, 0x04, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xfc, 0x06, 0x0a, 0x13, 0x47, 0x65,
 	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
@@ -1375,179 +1688,239 @@ var file_api_dinkurapi_v1_entries_proto_rawDesc = []byte{
 	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68,
 	0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x10, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e,
-	0x64, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x12,
-	0x19, 0x0a, 0x15, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14,
-	0x0a, 0x10, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x54, 0x55,
-	0x52, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e,
-	0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
-	0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x4d,
-	0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x53,
-	0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x44, 0x41,
-	0x59, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44,
-	0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e,
-	0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f,
-	0x4e, 0x45, 0x58, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x4f, 0x4e,
-	0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x08, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0x98, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x16, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72,
-	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12,
-	0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72,
-	0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x4b, 0x0a,
-	0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x95, 0x03, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
-	0x65, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72,
-	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
-	0x4c, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75,
-	0x7a, 0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x46, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x75, 0x7a,
-	0x7a, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x7a,
-	0x7a, 0x79, 0x22, 0x6d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x65, 0x66,
-	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x22, 0x53, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x73,
-	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x68, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfb, 0x03, 0x0a, 0x09, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44,
+	0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x52, 0x54,
+	0x48, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f,
+	0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41,
+	0x4e, 0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53,
+	0x55, 0x4e, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e,
+	0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d,
+	0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44,
+	0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e,
+	0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f,
+	0x54, 0x48, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d,
+	0x4f, 0x4e, 0x54, 0x48, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48,
+	0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x0b,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x48,
+	0x49, 0x53, 0x5f, 0x51, 0x55, 0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x0c, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x51,
+	0x55, 0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x51, 0x55, 0x41, 0x52, 0x54,
+	0x45, 0x52, 0x10, 0x0e, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e,
+	0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x0f, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f,
+	0x59, 0x45, 0x41, 0x52, 0x10, 0x10, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48,
+	0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x11, 0x12,
+	0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x41, 0x53,
+	0x54, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x12, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64,
-	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x98, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f,
+	0x7a, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30,
+	0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65,
+	0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f,
+	0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x4b, 0x0a, 0x17,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x95, 0x03, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x32, 0xf9, 0x05, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d,
-	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
-	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
-	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69,
-	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c,
+	0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75, 0x7a,
+	0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x75, 0x7a, 0x7a, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x75, 0x7a, 0x7a,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x7a, 0x7a,
+	0x79, 0x22, 0x6d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
+	0x53, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x47, 0x0a, 0x17, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x52, 0x0a,
+	0x18, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x22, 0x64, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6f, 0x72,
+	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x4f,
+	0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x05, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x73, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x55, 0x75, 0x69, 0x64, 0x32, 0xb0,
+	0x07, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x50, 0x69,
+	0x6e, 0x67, 0x12, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x12, 0x52, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x69,
-	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x2b,
-	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64,
-	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1563,7 +1936,7 @@ func file_api_dinkurapi_v1_entries_proto_rawDescGZIP() []byte {
 }
 
 var file_api_dinkurapi_v1_entries_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_dinkurapi_v1_entries_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_api_dinkurapi_v1_entries_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_api_dinkurapi_v1_entries_proto_goTypes = []interface{}{
 	(GetEntryListRequest_Shorthand)(0), // 0: dinkurapi.v1.GetEntryListRequest.Shorthand
 	(*PingRequest)(nil),                // 1: dinkurapi.v1.PingRequest
@@ -1582,59 +1955,72 @@ var file_api_dinkurapi_v1_entries_proto_goTypes = []interface{}{
 	(*DeleteEntryResponse)(nil),        // 14: dinkurapi.v1.DeleteEntryResponse
 	(*StopActiveEntryRequest)(nil),     // 15: dinkurapi.v1.StopActiveEntryRequest
 	(*StopActiveEntryResponse)(nil),    // 16: dinkurapi.v1.StopActiveEntryResponse
-	(*StreamEntryRequest)(nil),         // 17: dinkurapi.v1.StreamEntryRequest
-	(*StreamEntryResponse)(nil),        // 18: dinkurapi.v1.StreamEntryResponse
-	(*Entry)(nil),                      // 19: dinkurapi.v1.Entry
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
-	(Event)(0),                         // 21: dinkurapi.v1.Event
+	(*PauseActiveEntryRequest)(nil),    // 17: dinkurapi.v1.PauseActiveEntryRequest
+	(*PauseActiveEntryResponse)(nil),   // 18: dinkurapi.v1.PauseActiveEntryResponse
+	(*ResumeEntryRequest)(nil),         // 19: dinkurapi.v1.ResumeEntryRequest
+	(*ResumeEntryResponse)(nil),        // 20: dinkurapi.v1.ResumeEntryResponse
+	(*StreamEntryRequest)(nil),         // 21: dinkurapi.v1.StreamEntryRequest
+	(*StreamEntryResponse)(nil),        // 22: dinkurapi.v1.StreamEntryResponse
+	(*Entry)(nil),                      // 23: dinkurapi.v1.Entry
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(Event)(0),                         // 25: dinkurapi.v1.Event
 }
 var file_api_dinkurapi_v1_entries_proto_depIdxs = []int32{
-	19, // 0: dinkurapi.v1.GetEntryResponse.entry:type_name -> dinkurapi.v1.Entry
-	19, // 1: dinkurapi.v1.GetActiveEntryResponse.active_entry:type_name -> dinkurapi.v1.Entry
-	20, // 2: dinkurapi.v1.GetEntryListRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 3: dinkurapi.v1.GetEntryListRequest.end:type_name -> google.protobuf.Timestamp
+	23, // 0: dinkurapi.v1.GetEntryResponse.entry:type_name -> dinkurapi.v1.Entry
+	23, // 1: dinkurapi.v1.GetActiveEntryResponse.active_entry:type_name -> dinkurapi.v1.Entry
+	24, // 2: dinkurapi.v1.GetEntryListRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 3: dinkurapi.v1.GetEntryListRequest.end:type_name -> google.protobuf.Timestamp
 	0,  // 4: dinkurapi.v1.GetEntryListRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
-	19, // 5: dinkurapi.v1.GetEntryListResponse.entries:type_name -> dinkurapi.v1.Entry
-	20, // 6: dinkurapi.v1.CreateEntryRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 7: dinkurapi.v1.CreateEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 8: dinkurapi.v1.CreateEntryResponse.created_entry:type_name -> dinkurapi.v1.Entry
-	19, // 9: dinkurapi.v1.CreateEntryResponse.previously_active_entry:type_name -> dinkurapi.v1.Entry
-	20, // 10: dinkurapi.v1.UpdateEntryRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 11: dinkurapi.v1.UpdateEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 12: dinkurapi.v1.UpdateEntryResponse.before:type_name -> dinkurapi.v1.Entry
-	19, // 13: dinkurapi.v1.UpdateEntryResponse.after:type_name -> dinkurapi.v1.Entry
-	19, // 14: dinkurapi.v1.DeleteEntryResponse.deleted_entry:type_name -> dinkurapi.v1.Entry
-	20, // 15: dinkurapi.v1.StopActiveEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 16: dinkurapi.v1.StopActiveEntryResponse.stopped_entry:type_name -> dinkurapi.v1.Entry
-	19, // 17: dinkurapi.v1.StreamEntryResponse.entry:type_name -> dinkurapi.v1.Entry
-	21, // 18: dinkurapi.v1.StreamEntryResponse.event:type_name -> dinkurapi.v1.Event
-	20, // 19: dinkurapi.v1.Entry.created:type_name -> google.protobuf.Timestamp
-	20, // 20: dinkurapi.v1.Entry.updated:type_name -> google.protobuf.Timestamp
-	20, // 21: dinkurapi.v1.Entry.start:type_name -> google.protobuf.Timestamp
-	20, // 22: dinkurapi.v1.Entry.end:type_name -> google.protobuf.Timestamp
-	1,  // 23: dinkurapi.v1.Entries.Ping:input_type -> dinkurapi.v1.PingRequest
-	3,  // 24: dinkurapi.v1.Entries.GetEntry:input_type -> dinkurapi.v1.GetEntryRequest
-	5,  // 25: dinkurapi.v1.Entries.GetActiveEntry:input_type -> dinkurapi.v1.GetActiveEntryRequest
-	7,  // 26: dinkurapi.v1.Entries.GetEntryList:input_type -> dinkurapi.v1.GetEntryListRequest
-	9,  // 27: dinkurapi.v1.Entries.CreateEntry:input_type -> dinkurapi.v1.CreateEntryRequest
-	11, // 28: dinkurapi.v1.Entries.UpdateEntry:input_type -> dinkurapi.v1.UpdateEntryRequest
-	13, // 29: dinkurapi.v1.Entries.DeleteEntry:input_type -> dinkurapi.v1.DeleteEntryRequest
-	15, // 30: dinkurapi.v1.Entries.StopActiveEntry:input_type -> dinkurapi.v1.StopActiveEntryRequest
-	17, // 31: dinkurapi.v1.Entries.StreamEntry:input_type -> dinkurapi.v1.StreamEntryRequest
-	2,  // 32: dinkurapi.v1.Entries.Ping:output_type -> dinkurapi.v1.PingResponse
-	4,  // 33: dinkurapi.v1.Entries.GetEntry:output_type -> dinkurapi.v1.GetEntryResponse
-	6,  // 34: dinkurapi.v1.Entries.GetActiveEntry:output_type -> dinkurapi.v1.GetActiveEntryResponse
-	8,  // 35: dinkurapi.v1.Entries.GetEntryList:output_type -> dinkurapi.v1.GetEntryListResponse
-	10, // 36: dinkurapi.v1.Entries.CreateEntry:output_type -> dinkurapi.v1.CreateEntryResponse
-	12, // 37: dinkurapi.v1.Entries.UpdateEntry:output_type -> dinkurapi.v1.UpdateEntryResponse
-	14, // 38: dinkurapi.v1.Entries.DeleteEntry:output_type -> dinkurapi.v1.DeleteEntryResponse
-	16, // 39: dinkurapi.v1.Entries.StopActiveEntry:output_type -> dinkurapi.v1.StopActiveEntryResponse
-	18, // 40: dinkurapi.v1.Entries.StreamEntry:output_type -> dinkurapi.v1.StreamEntryResponse
-	32, // [32:41] is the sub-list for method output_type
-	23, // [23:32] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	23, // 5: dinkurapi.v1.GetEntryListResponse.entries:type_name -> dinkurapi.v1.Entry
+	24, // 6: dinkurapi.v1.CreateEntryRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 7: dinkurapi.v1.CreateEntryRequest.end:type_name -> google.protobuf.Timestamp
+	23, // 8: dinkurapi.v1.CreateEntryResponse.created_entry:type_name -> dinkurapi.v1.Entry
+	23, // 9: dinkurapi.v1.CreateEntryResponse.previously_active_entry:type_name -> dinkurapi.v1.Entry
+	24, // 10: dinkurapi.v1.UpdateEntryRequest.start:type_name -> google.protobuf.Timestamp
+	24, // 11: dinkurapi.v1.UpdateEntryRequest.end:type_name -> google.protobuf.Timestamp
+	23, // 12: dinkurapi.v1.UpdateEntryResponse.before:type_name -> dinkurapi.v1.Entry
+	23, // 13: dinkurapi.v1.UpdateEntryResponse.after:type_name -> dinkurapi.v1.Entry
+	23, // 14: dinkurapi.v1.DeleteEntryResponse.deleted_entry:type_name -> dinkurapi.v1.Entry
+	24, // 15: dinkurapi.v1.StopActiveEntryRequest.end:type_name -> google.protobuf.Timestamp
+	23, // 16: dinkurapi.v1.StopActiveEntryResponse.stopped_entry:type_name -> dinkurapi.v1.Entry
+	24, // 17: dinkurapi.v1.PauseActiveEntryRequest.end:type_name -> google.protobuf.Timestamp
+	23, // 18: dinkurapi.v1.PauseActiveEntryResponse.paused_entry:type_name -> dinkurapi.v1.Entry
+	24, // 19: dinkurapi.v1.ResumeEntryRequest.start:type_name -> google.protobuf.Timestamp
+	23, // 20: dinkurapi.v1.ResumeEntryResponse.previously_active_entry:type_name -> dinkurapi.v1.Entry
+	23, // 21: dinkurapi.v1.ResumeEntryResponse.resumed_entry:type_name -> dinkurapi.v1.Entry
+	23, // 22: dinkurapi.v1.StreamEntryResponse.entry:type_name -> dinkurapi.v1.Entry
+	25, // 23: dinkurapi.v1.StreamEntryResponse.event:type_name -> dinkurapi.v1.Event
+	24, // 24: dinkurapi.v1.Entry.created:type_name -> google.protobuf.Timestamp
+	24, // 25: dinkurapi.v1.Entry.updated:type_name -> google.protobuf.Timestamp
+	24, // 26: dinkurapi.v1.Entry.start:type_name -> google.protobuf.Timestamp
+	24, // 27: dinkurapi.v1.Entry.end:type_name -> google.protobuf.Timestamp
+	1,  // 28: dinkurapi.v1.Entries.Ping:input_type -> dinkurapi.v1.PingRequest
+	3,  // 29: dinkurapi.v1.Entries.GetEntry:input_type -> dinkurapi.v1.GetEntryRequest
+	5,  // 30: dinkurapi.v1.Entries.GetActiveEntry:input_type -> dinkurapi.v1.GetActiveEntryRequest
+	7,  // 31: dinkurapi.v1.Entries.GetEntryList:input_type -> dinkurapi.v1.GetEntryListRequest
+	9,  // 32: dinkurapi.v1.Entries.CreateEntry:input_type -> dinkurapi.v1.CreateEntryRequest
+	11, // 33: dinkurapi.v1.Entries.UpdateEntry:input_type -> dinkurapi.v1.UpdateEntryRequest
+	13, // 34: dinkurapi.v1.Entries.DeleteEntry:input_type -> dinkurapi.v1.DeleteEntryRequest
+	15, // 35: dinkurapi.v1.Entries.StopActiveEntry:input_type -> dinkurapi.v1.StopActiveEntryRequest
+	17, // 36: dinkurapi.v1.Entries.PauseActiveEntry:input_type -> dinkurapi.v1.PauseActiveEntryRequest
+	19, // 37: dinkurapi.v1.Entries.ResumeEntry:input_type -> dinkurapi.v1.ResumeEntryRequest
+	21, // 38: dinkurapi.v1.Entries.StreamEntry:input_type -> dinkurapi.v1.StreamEntryRequest
+	2,  // 39: dinkurapi.v1.Entries.Ping:output_type -> dinkurapi.v1.PingResponse
+	4,  // 40: dinkurapi.v1.Entries.GetEntry:output_type -> dinkurapi.v1.GetEntryResponse
+	6,  // 41: dinkurapi.v1.Entries.GetActiveEntry:output_type -> dinkurapi.v1.GetActiveEntryResponse
+	8,  // 42: dinkurapi.v1.Entries.GetEntryList:output_type -> dinkurapi.v1.GetEntryListResponse
+	10, // 43: dinkurapi.v1.Entries.CreateEntry:output_type -> dinkurapi.v1.CreateEntryResponse
+	12, // 44: dinkurapi.v1.Entries.UpdateEntry:output_type -> dinkurapi.v1.UpdateEntryResponse
+	14, // 45: dinkurapi.v1.Entries.DeleteEntry:output_type -> dinkurapi.v1.DeleteEntryResponse
+	16, // 46: dinkurapi.v1.Entries.StopActiveEntry:output_type -> dinkurapi.v1.StopActiveEntryResponse
+	18, // 47: dinkurapi.v1.Entries.PauseActiveEntry:output_type -> dinkurapi.v1.PauseActiveEntryResponse
+	20, // 48: dinkurapi.v1.Entries.ResumeEntry:output_type -> dinkurapi.v1.ResumeEntryResponse
+	22, // 49: dinkurapi.v1.Entries.StreamEntry:output_type -> dinkurapi.v1.StreamEntryResponse
+	39, // [39:50] is the sub-list for method output_type
+	28, // [28:39] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_api_dinkurapi_v1_entries_proto_init() }
@@ -1837,7 +2223,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 			}
 		}
 		file_api_dinkurapi_v1_entries_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamEntryRequest); i {
+			switch v := v.(*PauseActiveEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1849,7 +2235,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 			}
 		}
 		file_api_dinkurapi_v1_entries_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamEntryResponse); i {
+			switch v := v.(*PauseActiveEntryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1861,6 +2247,54 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 			}
 		}
 		file_api_dinkurapi_v1_entries_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Entry); i {
 			case 0:
 				return &v.state
@@ -1879,7 +2313,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_dinkurapi_v1_entries_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,6 +41,15 @@ type EntriesClient interface {
 	// StopActiveEntry stops the currently active entry and returns that entry
 	// (if any).
 	StopActiveEntry(ctx context.Context, in *StopActiveEntryRequest, opts ...grpc.CallOption) (*StopActiveEntryResponse, error)
+	// PauseActiveEntry stops the currently active entry and returns that entry
+	// (if any), and remembers it as paused so it can later be resumed.
+	PauseActiveEntry(ctx context.Context, in *PauseActiveEntryRequest, opts ...grpc.CallOption) (*PauseActiveEntryResponse, error)
+	// ResumeEntry creates a new entry with the same name as a previous entry,
+	// linked to the previous entry as its continuation, and stops any currently
+	// active entries. Status 5 "NOT_FOUND" is reported if no entry was found by
+	// that ID, and status 9 "FAILED_PRECONDITION" if no ID was given and there
+	// is no paused entry.
+	ResumeEntry(ctx context.Context, in *ResumeEntryRequest, opts ...grpc.CallOption) (*ResumeEntryResponse, error)
 	// StreamAlert streams entry change events: created, updated, deleted.
 	StreamEntry(ctx context.Context, in *StreamEntryRequest, opts ...grpc.CallOption) (Entries_StreamEntryClient, error)
 }
@@ -125,6 +134,24 @@ func (c *entriesClient) StopActiveEntry(ctx context.Context, in *StopActiveEntry
 	return out, nil
 }
 
+func (c *entriesClient) PauseActiveEntry(ctx context.Context, in *PauseActiveEntryRequest, opts ...grpc.CallOption) (*PauseActiveEntryResponse, error) {
+	out := new(PauseActiveEntryResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/PauseActiveEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) ResumeEntry(ctx context.Context, in *ResumeEntryRequest, opts ...grpc.CallOption) (*ResumeEntryResponse, error) {
+	out := new(ResumeEntryResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/ResumeEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entriesClient) StreamEntry(ctx context.Context, in *StreamEntryRequest, opts ...grpc.CallOption) (Entries_StreamEntryClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Entries_ServiceDesc.Streams[0], "/dinkurapi.v1.Entries/StreamEntry", opts...)
 	if err != nil {
@@ -184,6 +211,15 @@ type EntriesServer interface {
 	// StopActiveEntry stops the currently active entry and returns that entry
 	// (if any).
 	StopActiveEntry(context.Context, *StopActiveEntryRequest) (*StopActiveEntryResponse, error)
+	// PauseActiveEntry stops the currently active entry and returns that entry
+	// (if any), and remembers it as paused so it can later be resumed.
+	PauseActiveEntry(context.Context, *PauseActiveEntryRequest) (*PauseActiveEntryResponse, error)
+	// ResumeEntry creates a new entry with the same name as a previous entry,
+	// linked to the previous entry as its continuation, and stops any currently
+	// active entries. Status 5 "NOT_FOUND" is reported if no entry was found by
+	// that ID, and status 9 "FAILED_PRECONDITION" if no ID was given and there
+	// is no paused entry.
+	ResumeEntry(context.Context, *ResumeEntryRequest) (*ResumeEntryResponse, error)
 	// StreamAlert streams entry change events: created, updated, deleted.
 	StreamEntry(*StreamEntryRequest, Entries_StreamEntryServer) error
 	mustEmbedUnimplementedEntriesServer()
@@ -217,6 +253,12 @@ func (UnimplementedEntriesServer) DeleteEntry(context.Context, *DeleteEntryReque
 func (UnimplementedEntriesServer) StopActiveEntry(context.Context, *StopActiveEntryRequest) (*StopActiveEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopActiveEntry not implemented")
 }
+func (UnimplementedEntriesServer) PauseActiveEntry(context.Context, *PauseActiveEntryRequest) (*PauseActiveEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActiveEntry not implemented")
+}
+func (UnimplementedEntriesServer) ResumeEntry(context.Context, *ResumeEntryRequest) (*ResumeEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEntry not implemented")
+}
 func (UnimplementedEntriesServer) StreamEntry(*StreamEntryRequest, Entries_StreamEntryServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamEntry not implemented")
 }
@@ -377,6 +419,42 @@ func _Entries_StopActiveEntry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entries_PauseActiveEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActiveEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).PauseActiveEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/PauseActiveEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).PauseActiveEntry(ctx, req.(*PauseActiveEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_ResumeEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).ResumeEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/ResumeEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).ResumeEntry(ctx, req.(*ResumeEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entries_StreamEntry_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamEntryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -437,6 +515,14 @@ var Entries_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopActiveEntry",
 			Handler:    _Entries_StopActiveEntry_Handler,
 		},
+		{
+			MethodName: "PauseActiveEntry",
+			Handler:    _Entries_PauseActiveEntry_Handler,
+		},
+		{
+			MethodName: "ResumeEntry",
+			Handler:    _Entries_ResumeEntry_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
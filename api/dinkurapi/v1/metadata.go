@@ -0,0 +1,31 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package v1
+
+// ProfileMetadataKey is the gRPC metadata header used to select which of the
+// daemon's profiles, i.e which database, that a request is targeting. The
+// daemon's default profile is used when the header is omitted or empty.
+// Profile names are case-insensitive, and are sent in lowercase.
+const ProfileMetadataKey = "dinkur-profile"
+
+// PeerTokenMetadataKey is the gRPC metadata header used by paired daemons to
+// authenticate themselves using the token they got when pairing.
+const PeerTokenMetadataKey = "dinkur-peer-token"
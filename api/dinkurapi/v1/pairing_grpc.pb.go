@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PairingClient is the client API for Pairing service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PairingClient interface {
+	// StartPairing creates a new short pairing code, replacing any previous
+	// code. The code is valid for a few minutes.
+	StartPairing(ctx context.Context, in *StartPairingRequest, opts ...grpc.CallOption) (*StartPairingResponse, error)
+	// WaitForPairing waits until another daemon has completed the pairing
+	// using the given code. Status 16 "UNAUTHENTICATED" is reported if the code
+	// is not valid or expires while waiting.
+	WaitForPairing(ctx context.Context, in *WaitForPairingRequest, opts ...grpc.CallOption) (*WaitForPairingResponse, error)
+	// RequestPairing makes this daemon pair with another daemon, using the code
+	// shown by the other daemon.
+	RequestPairing(ctx context.Context, in *RequestPairingRequest, opts ...grpc.CallOption) (*RequestPairingResponse, error)
+	// ExchangePairingKey is called by the daemon requesting the pairing, to
+	// start a key exchange keyed by the current pairing code. The response
+	// includes this daemon's key confirmation, which the requesting daemon must
+	// verify before calling CompletePairing. Each call counts as an attempt, and
+	// the pairing code is revoked after a few attempts. Status 16
+	// "UNAUTHENTICATED" is reported if there is no valid pairing code.
+	ExchangePairingKey(ctx context.Context, in *ExchangePairingKeyRequest, opts ...grpc.CallOption) (*ExchangePairingKeyResponse, error)
+	// CompletePairing is called by the daemon requesting the pairing, with its
+	// key confirmation from the preceding ExchangePairingKey call. Status 16
+	// "UNAUTHENTICATED" is reported if the confirmation does not match, such as
+	// when the wrong pairing code was used.
+	CompletePairing(ctx context.Context, in *CompletePairingRequest, opts ...grpc.CallOption) (*CompletePairingResponse, error)
+	// GetPeerList lists all paired daemons.
+	GetPeerList(ctx context.Context, in *GetPeerListRequest, opts ...grpc.CallOption) (*GetPeerListResponse, error)
+	// DeletePeer revokes the pairing with a daemon, so its token is no longer
+	// accepted. Status 5 "NOT_FOUND" is reported if no peer has the given name.
+	DeletePeer(ctx context.Context, in *DeletePeerRequest, opts ...grpc.CallOption) (*DeletePeerResponse, error)
+}
+
+type pairingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPairingClient(cc grpc.ClientConnInterface) PairingClient {
+	return &pairingClient{cc}
+}
+
+func (c *pairingClient) StartPairing(ctx context.Context, in *StartPairingRequest, opts ...grpc.CallOption) (*StartPairingResponse, error) {
+	out := new(StartPairingResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/StartPairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) WaitForPairing(ctx context.Context, in *WaitForPairingRequest, opts ...grpc.CallOption) (*WaitForPairingResponse, error) {
+	out := new(WaitForPairingResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/WaitForPairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) RequestPairing(ctx context.Context, in *RequestPairingRequest, opts ...grpc.CallOption) (*RequestPairingResponse, error) {
+	out := new(RequestPairingResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/RequestPairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) ExchangePairingKey(ctx context.Context, in *ExchangePairingKeyRequest, opts ...grpc.CallOption) (*ExchangePairingKeyResponse, error) {
+	out := new(ExchangePairingKeyResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/ExchangePairingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) CompletePairing(ctx context.Context, in *CompletePairingRequest, opts ...grpc.CallOption) (*CompletePairingResponse, error) {
+	out := new(CompletePairingResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/CompletePairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) GetPeerList(ctx context.Context, in *GetPeerListRequest, opts ...grpc.CallOption) (*GetPeerListResponse, error) {
+	out := new(GetPeerListResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/GetPeerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pairingClient) DeletePeer(ctx context.Context, in *DeletePeerRequest, opts ...grpc.CallOption) (*DeletePeerResponse, error) {
+	out := new(DeletePeerResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pairing/DeletePeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PairingServer is the server API for Pairing service.
+// All implementations must embed UnimplementedPairingServer
+// for forward compatibility
+type PairingServer interface {
+	// StartPairing creates a new short pairing code, replacing any previous
+	// code. The code is valid for a few minutes.
+	StartPairing(context.Context, *StartPairingRequest) (*StartPairingResponse, error)
+	// WaitForPairing waits until another daemon has completed the pairing
+	// using the given code. Status 16 "UNAUTHENTICATED" is reported if the code
+	// is not valid or expires while waiting.
+	WaitForPairing(context.Context, *WaitForPairingRequest) (*WaitForPairingResponse, error)
+	// RequestPairing makes this daemon pair with another daemon, using the code
+	// shown by the other daemon.
+	RequestPairing(context.Context, *RequestPairingRequest) (*RequestPairingResponse, error)
+	// ExchangePairingKey is called by the daemon requesting the pairing, to
+	// start a key exchange keyed by the current pairing code. The response
+	// includes this daemon's key confirmation, which the requesting daemon must
+	// verify before calling CompletePairing. Each call counts as an attempt, and
+	// the pairing code is revoked after a few attempts. Status 16
+	// "UNAUTHENTICATED" is reported if there is no valid pairing code.
+	ExchangePairingKey(context.Context, *ExchangePairingKeyRequest) (*ExchangePairingKeyResponse, error)
+	// CompletePairing is called by the daemon requesting the pairing, with its
+	// key confirmation from the preceding ExchangePairingKey call. Status 16
+	// "UNAUTHENTICATED" is reported if the confirmation does not match, such as
+	// when the wrong pairing code was used.
+	CompletePairing(context.Context, *CompletePairingRequest) (*CompletePairingResponse, error)
+	// GetPeerList lists all paired daemons.
+	GetPeerList(context.Context, *GetPeerListRequest) (*GetPeerListResponse, error)
+	// DeletePeer revokes the pairing with a daemon, so its token is no longer
+	// accepted. Status 5 "NOT_FOUND" is reported if no peer has the given name.
+	DeletePeer(context.Context, *DeletePeerRequest) (*DeletePeerResponse, error)
+	mustEmbedUnimplementedPairingServer()
+}
+
+// UnimplementedPairingServer must be embedded to have forward compatible implementations.
+type UnimplementedPairingServer struct {
+}
+
+func (UnimplementedPairingServer) StartPairing(context.Context, *StartPairingRequest) (*StartPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPairing not implemented")
+}
+func (UnimplementedPairingServer) WaitForPairing(context.Context, *WaitForPairingRequest) (*WaitForPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForPairing not implemented")
+}
+func (UnimplementedPairingServer) RequestPairing(context.Context, *RequestPairingRequest) (*RequestPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPairing not implemented")
+}
+func (UnimplementedPairingServer) ExchangePairingKey(context.Context, *ExchangePairingKeyRequest) (*ExchangePairingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangePairingKey not implemented")
+}
+func (UnimplementedPairingServer) CompletePairing(context.Context, *CompletePairingRequest) (*CompletePairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePairing not implemented")
+}
+func (UnimplementedPairingServer) GetPeerList(context.Context, *GetPeerListRequest) (*GetPeerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerList not implemented")
+}
+func (UnimplementedPairingServer) DeletePeer(context.Context, *DeletePeerRequest) (*DeletePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePeer not implemented")
+}
+func (UnimplementedPairingServer) mustEmbedUnimplementedPairingServer() {}
+
+// UnsafePairingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PairingServer will
+// result in compilation errors.
+type UnsafePairingServer interface {
+	mustEmbedUnimplementedPairingServer()
+}
+
+func RegisterPairingServer(s grpc.ServiceRegistrar, srv PairingServer) {
+	s.RegisterService(&Pairing_ServiceDesc, srv)
+}
+
+func _Pairing_StartPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).StartPairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/StartPairing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).StartPairing(ctx, req.(*StartPairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_WaitForPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).WaitForPairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/WaitForPairing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).WaitForPairing(ctx, req.(*WaitForPairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_RequestPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).RequestPairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/RequestPairing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).RequestPairing(ctx, req.(*RequestPairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_ExchangePairingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairingKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).ExchangePairingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/ExchangePairingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).ExchangePairingKey(ctx, req.(*ExchangePairingKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_CompletePairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).CompletePairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/CompletePairing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).CompletePairing(ctx, req.(*CompletePairingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_GetPeerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).GetPeerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/GetPeerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).GetPeerList(ctx, req.(*GetPeerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pairing_DeletePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PairingServer).DeletePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pairing/DeletePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PairingServer).DeletePeer(ctx, req.(*DeletePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pairing_ServiceDesc is the grpc.ServiceDesc for Pairing service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pairing_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dinkurapi.v1.Pairing",
+	HandlerType: (*PairingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartPairing",
+			Handler:    _Pairing_StartPairing_Handler,
+		},
+		{
+			MethodName: "WaitForPairing",
+			Handler:    _Pairing_WaitForPairing_Handler,
+		},
+		{
+			MethodName: "RequestPairing",
+			Handler:    _Pairing_RequestPairing_Handler,
+		},
+		{
+			MethodName: "ExchangePairingKey",
+			Handler:    _Pairing_ExchangePairingKey_Handler,
+		},
+		{
+			MethodName: "CompletePairing",
+			Handler:    _Pairing_CompletePairing_Handler,
+		},
+		{
+			MethodName: "GetPeerList",
+			Handler:    _Pairing_GetPeerList_Handler,
+		},
+		{
+			MethodName: "DeletePeer",
+			Handler:    _Pairing_DeletePeer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/dinkurapi/v1/pairing.proto",
+}
@@ -0,0 +1,1540 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.21.2
+// source: api/dinkurapi/v1/pomodoro.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PomodoroPhase is an enumeration of the periods of a Pomodoro timer.
+type PomodoroPhase int32
+
+const (
+	// POMODORO_PHASE_UNSPECIFIED means the timer is not running.
+	PomodoroPhase_POMODORO_PHASE_UNSPECIFIED PomodoroPhase = 0
+	// POMODORO_PHASE_WORK means the timer is in a work period.
+	PomodoroPhase_POMODORO_PHASE_WORK PomodoroPhase = 1
+	// POMODORO_PHASE_BREAK means the timer is in a short break.
+	PomodoroPhase_POMODORO_PHASE_BREAK PomodoroPhase = 2
+	// POMODORO_PHASE_LONG_BREAK means the timer is in a long break.
+	PomodoroPhase_POMODORO_PHASE_LONG_BREAK PomodoroPhase = 3
+)
+
+// Enum value maps for PomodoroPhase.
+var (
+	PomodoroPhase_name = map[int32]string{
+		0: "POMODORO_PHASE_UNSPECIFIED",
+		1: "POMODORO_PHASE_WORK",
+		2: "POMODORO_PHASE_BREAK",
+		3: "POMODORO_PHASE_LONG_BREAK",
+	}
+	PomodoroPhase_value = map[string]int32{
+		"POMODORO_PHASE_UNSPECIFIED": 0,
+		"POMODORO_PHASE_WORK":        1,
+		"POMODORO_PHASE_BREAK":       2,
+		"POMODORO_PHASE_LONG_BREAK":  3,
+	}
+)
+
+func (x PomodoroPhase) Enum() *PomodoroPhase {
+	p := new(PomodoroPhase)
+	*p = x
+	return p
+}
+
+func (x PomodoroPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PomodoroPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_pomodoro_proto_enumTypes[0].Descriptor()
+}
+
+func (PomodoroPhase) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_pomodoro_proto_enumTypes[0]
+}
+
+func (x PomodoroPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PomodoroPhase.Descriptor instead.
+func (PomodoroPhase) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{0}
+}
+
+// PomodoroEvent is an enumeration of the events of a Pomodoro timer.
+type PomodoroEvent int32
+
+const (
+	// POMODORO_EVENT_UNSPECIFIED is an unknown event.
+	PomodoroEvent_POMODORO_EVENT_UNSPECIFIED PomodoroEvent = 0
+	// POMODORO_EVENT_STARTED means the timer was started.
+	PomodoroEvent_POMODORO_EVENT_STARTED PomodoroEvent = 1
+	// POMODORO_EVENT_BREAK_STARTED means a work period was completed and a
+	// break started.
+	PomodoroEvent_POMODORO_EVENT_BREAK_STARTED PomodoroEvent = 2
+	// POMODORO_EVENT_WORK_STARTED means a break ended and a new work period
+	// started.
+	PomodoroEvent_POMODORO_EVENT_WORK_STARTED PomodoroEvent = 3
+	// POMODORO_EVENT_STOPPED means the timer was stopped, either by the user
+	// or because the active entry was changed by the user.
+	PomodoroEvent_POMODORO_EVENT_STOPPED PomodoroEvent = 4
+)
+
+// Enum value maps for PomodoroEvent.
+var (
+	PomodoroEvent_name = map[int32]string{
+		0: "POMODORO_EVENT_UNSPECIFIED",
+		1: "POMODORO_EVENT_STARTED",
+		2: "POMODORO_EVENT_BREAK_STARTED",
+		3: "POMODORO_EVENT_WORK_STARTED",
+		4: "POMODORO_EVENT_STOPPED",
+	}
+	PomodoroEvent_value = map[string]int32{
+		"POMODORO_EVENT_UNSPECIFIED":   0,
+		"POMODORO_EVENT_STARTED":       1,
+		"POMODORO_EVENT_BREAK_STARTED": 2,
+		"POMODORO_EVENT_WORK_STARTED":  3,
+		"POMODORO_EVENT_STOPPED":       4,
+	}
+)
+
+func (x PomodoroEvent) Enum() *PomodoroEvent {
+	p := new(PomodoroEvent)
+	*p = x
+	return p
+}
+
+func (x PomodoroEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PomodoroEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_pomodoro_proto_enumTypes[1].Descriptor()
+}
+
+func (PomodoroEvent) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_pomodoro_proto_enumTypes[1]
+}
+
+func (x PomodoroEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PomodoroEvent.Descriptor instead.
+func (PomodoroEvent) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{1}
+}
+
+// PomodoroSettings is the durations used by a Pomodoro timer.
+type PomodoroSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Work is the duration of each work period.
+	Work *durationpb.Duration `protobuf:"bytes,1,opt,name=work,proto3" json:"work,omitempty"`
+	// Break is the duration of the short breaks between work periods.
+	Break *durationpb.Duration `protobuf:"bytes,2,opt,name=break,proto3" json:"break,omitempty"`
+	// LongBreak is the duration of every long break.
+	LongBreak *durationpb.Duration `protobuf:"bytes,3,opt,name=long_break,json=longBreak,proto3" json:"long_break,omitempty"`
+	// LongBreakEvery is after how many completed work periods a long break is
+	// taken instead of a short break.
+	LongBreakEvery uint64 `protobuf:"varint,4,opt,name=long_break_every,json=longBreakEvery,proto3" json:"long_break_every,omitempty"`
+	// BreakEntryName is the name of the entry started during breaks. The active
+	// entry is instead stopped during breaks if this is empty.
+	BreakEntryName string `protobuf:"bytes,5,opt,name=break_entry_name,json=breakEntryName,proto3" json:"break_entry_name,omitempty"`
+}
+
+func (x *PomodoroSettings) Reset() {
+	*x = PomodoroSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PomodoroSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PomodoroSettings) ProtoMessage() {}
+
+func (x *PomodoroSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PomodoroSettings.ProtoReflect.Descriptor instead.
+func (*PomodoroSettings) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PomodoroSettings) GetWork() *durationpb.Duration {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *PomodoroSettings) GetBreak() *durationpb.Duration {
+	if x != nil {
+		return x.Break
+	}
+	return nil
+}
+
+func (x *PomodoroSettings) GetLongBreak() *durationpb.Duration {
+	if x != nil {
+		return x.LongBreak
+	}
+	return nil
+}
+
+func (x *PomodoroSettings) GetLongBreakEvery() uint64 {
+	if x != nil {
+		return x.LongBreakEvery
+	}
+	return 0
+}
+
+func (x *PomodoroSettings) GetBreakEntryName() string {
+	if x != nil {
+		return x.BreakEntryName
+	}
+	return ""
+}
+
+// PomodoroState is the current state of a Pomodoro timer.
+type PomodoroState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntryName is the name of the entry started during work periods.
+	EntryName string `protobuf:"bytes,1,opt,name=entry_name,json=entryName,proto3" json:"entry_name,omitempty"`
+	// Settings is the durations used by the timer.
+	Settings *PomodoroSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Phase is whether the timer is in a work period or a break.
+	Phase PomodoroPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=dinkurapi.v1.PomodoroPhase" json:"phase,omitempty"`
+	// PhaseStart is when the current period started.
+	PhaseStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=phase_start,json=phaseStart,proto3" json:"phase_start,omitempty"`
+	// PhaseEnd is when the current period ends.
+	PhaseEnd *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=phase_end,json=phaseEnd,proto3" json:"phase_end,omitempty"`
+	// CompletedCycles is the number of work periods completed since the timer
+	// was started.
+	CompletedCycles uint64 `protobuf:"varint,6,opt,name=completed_cycles,json=completedCycles,proto3" json:"completed_cycles,omitempty"`
+}
+
+func (x *PomodoroState) Reset() {
+	*x = PomodoroState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PomodoroState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PomodoroState) ProtoMessage() {}
+
+func (x *PomodoroState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PomodoroState.ProtoReflect.Descriptor instead.
+func (*PomodoroState) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PomodoroState) GetEntryName() string {
+	if x != nil {
+		return x.EntryName
+	}
+	return ""
+}
+
+func (x *PomodoroState) GetSettings() *PomodoroSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *PomodoroState) GetPhase() PomodoroPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return PomodoroPhase_POMODORO_PHASE_UNSPECIFIED
+}
+
+func (x *PomodoroState) GetPhaseStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PhaseStart
+	}
+	return nil
+}
+
+func (x *PomodoroState) GetPhaseEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PhaseEnd
+	}
+	return nil
+}
+
+func (x *PomodoroState) GetCompletedCycles() uint64 {
+	if x != nil {
+		return x.CompletedCycles
+	}
+	return 0
+}
+
+// PomodoroCycle is a completed work period of a Pomodoro timer.
+type PomodoroCycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of this Pomodoro cycle.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created is a timestamp of when the cycle was initially created.
+	Created *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Updated is a timestamp of when the cycle was most recently changed.
+	Updated *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// EntryUuid is the UUID of the entry that was active during the work
+	// period.
+	EntryUuid string `protobuf:"bytes,4,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// Start is when the work period started.
+	Start *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	// End is when the work period was completed.
+	End *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *PomodoroCycle) Reset() {
+	*x = PomodoroCycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PomodoroCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PomodoroCycle) ProtoMessage() {}
+
+func (x *PomodoroCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PomodoroCycle.ProtoReflect.Descriptor instead.
+func (*PomodoroCycle) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PomodoroCycle) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PomodoroCycle) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *PomodoroCycle) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *PomodoroCycle) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *PomodoroCycle) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *PomodoroCycle) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// StartPomodoroRequest holds the name of the entry and the timer settings.
+type StartPomodoroRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntryName is the name of the entry started during work periods.
+	EntryName string `protobuf:"bytes,1,opt,name=entry_name,json=entryName,proto3" json:"entry_name,omitempty"`
+	// Settings is the durations used by the timer. Any unset durations are
+	// replaced by the daemon's default values.
+	Settings *PomodoroSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *StartPomodoroRequest) Reset() {
+	*x = StartPomodoroRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPomodoroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPomodoroRequest) ProtoMessage() {}
+
+func (x *StartPomodoroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPomodoroRequest.ProtoReflect.Descriptor instead.
+func (*StartPomodoroRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartPomodoroRequest) GetEntryName() string {
+	if x != nil {
+		return x.EntryName
+	}
+	return ""
+}
+
+func (x *StartPomodoroRequest) GetSettings() *PomodoroSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// StartPomodoroResponse holds the state of the newly started timer.
+type StartPomodoroResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pomodoro is the state of the newly started timer.
+	Pomodoro *PomodoroState `protobuf:"bytes,1,opt,name=pomodoro,proto3" json:"pomodoro,omitempty"`
+}
+
+func (x *StartPomodoroResponse) Reset() {
+	*x = StartPomodoroResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPomodoroResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPomodoroResponse) ProtoMessage() {}
+
+func (x *StartPomodoroResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPomodoroResponse.ProtoReflect.Descriptor instead.
+func (*StartPomodoroResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartPomodoroResponse) GetPomodoro() *PomodoroState {
+	if x != nil {
+		return x.Pomodoro
+	}
+	return nil
+}
+
+// StopPomodoroRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type StopPomodoroRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopPomodoroRequest) Reset() {
+	*x = StopPomodoroRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPomodoroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPomodoroRequest) ProtoMessage() {}
+
+func (x *StopPomodoroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPomodoroRequest.ProtoReflect.Descriptor instead.
+func (*StopPomodoroRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{5}
+}
+
+// StopPomodoroResponse holds the state of the stopped timer.
+type StopPomodoroResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pomodoro is the state of the timer when it was stopped, or is left unset
+	// if no timer was running.
+	Pomodoro *PomodoroState `protobuf:"bytes,1,opt,name=pomodoro,proto3" json:"pomodoro,omitempty"`
+}
+
+func (x *StopPomodoroResponse) Reset() {
+	*x = StopPomodoroResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPomodoroResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPomodoroResponse) ProtoMessage() {}
+
+func (x *StopPomodoroResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPomodoroResponse.ProtoReflect.Descriptor instead.
+func (*StopPomodoroResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StopPomodoroResponse) GetPomodoro() *PomodoroState {
+	if x != nil {
+		return x.Pomodoro
+	}
+	return nil
+}
+
+// GetPomodoroRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type GetPomodoroRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPomodoroRequest) Reset() {
+	*x = GetPomodoroRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPomodoroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPomodoroRequest) ProtoMessage() {}
+
+func (x *GetPomodoroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPomodoroRequest.ProtoReflect.Descriptor instead.
+func (*GetPomodoroRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{7}
+}
+
+// GetPomodoroResponse holds the state of the running timer.
+type GetPomodoroResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pomodoro is the state of the running timer, or is left unset if no timer
+	// is running.
+	Pomodoro *PomodoroState `protobuf:"bytes,1,opt,name=pomodoro,proto3" json:"pomodoro,omitempty"`
+}
+
+func (x *GetPomodoroResponse) Reset() {
+	*x = GetPomodoroResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPomodoroResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPomodoroResponse) ProtoMessage() {}
+
+func (x *GetPomodoroResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPomodoroResponse.ProtoReflect.Descriptor instead.
+func (*GetPomodoroResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPomodoroResponse) GetPomodoro() *PomodoroState {
+	if x != nil {
+		return x.Pomodoro
+	}
+	return nil
+}
+
+// StreamPomodoroRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type StreamPomodoroRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamPomodoroRequest) Reset() {
+	*x = StreamPomodoroRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPomodoroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPomodoroRequest) ProtoMessage() {}
+
+func (x *StreamPomodoroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPomodoroRequest.ProtoReflect.Descriptor instead.
+func (*StreamPomodoroRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{9}
+}
+
+// StreamPomodoroResponse holds a Pomodoro timer event.
+type StreamPomodoroResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pomodoro is the state of the timer after the event.
+	Pomodoro *PomodoroState `protobuf:"bytes,1,opt,name=pomodoro,proto3" json:"pomodoro,omitempty"`
+	// Event is the type of event.
+	Event PomodoroEvent `protobuf:"varint,2,opt,name=event,proto3,enum=dinkurapi.v1.PomodoroEvent" json:"event,omitempty"`
+}
+
+func (x *StreamPomodoroResponse) Reset() {
+	*x = StreamPomodoroResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPomodoroResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPomodoroResponse) ProtoMessage() {}
+
+func (x *StreamPomodoroResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPomodoroResponse.ProtoReflect.Descriptor instead.
+func (*StreamPomodoroResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamPomodoroResponse) GetPomodoro() *PomodoroState {
+	if x != nil {
+		return x.Pomodoro
+	}
+	return nil
+}
+
+func (x *StreamPomodoroResponse) GetEvent() PomodoroEvent {
+	if x != nil {
+		return x.Event
+	}
+	return PomodoroEvent_POMODORO_EVENT_UNSPECIFIED
+}
+
+// CreatePomodoroCycleRequest holds the completed work period to add.
+type CreatePomodoroCycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntryUuid is the UUID of the entry that was active during the work
+	// period.
+	EntryUuid string `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// Start is when the work period started.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End is when the work period was completed.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *CreatePomodoroCycleRequest) Reset() {
+	*x = CreatePomodoroCycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePomodoroCycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePomodoroCycleRequest) ProtoMessage() {}
+
+func (x *CreatePomodoroCycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePomodoroCycleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePomodoroCycleRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePomodoroCycleRequest) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *CreatePomodoroCycleRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreatePomodoroCycleRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// CreatePomodoroCycleResponse holds the newly added Pomodoro cycle.
+type CreatePomodoroCycleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cycle is the newly added Pomodoro cycle.
+	Cycle *PomodoroCycle `protobuf:"bytes,1,opt,name=cycle,proto3" json:"cycle,omitempty"`
+}
+
+func (x *CreatePomodoroCycleResponse) Reset() {
+	*x = CreatePomodoroCycleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePomodoroCycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePomodoroCycleResponse) ProtoMessage() {}
+
+func (x *CreatePomodoroCycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePomodoroCycleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePomodoroCycleResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreatePomodoroCycleResponse) GetCycle() *PomodoroCycle {
+	if x != nil {
+		return x.Cycle
+	}
+	return nil
+}
+
+// GetPomodoroCycleListRequest holds the search parameters.
+type GetPomodoroCycleListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start is the starting timestamp bound of cycles to list. Any cycle that
+	// ends after this time is included.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the ending timestamp bound of cycles to list. Any cycle that
+	// starts before this time is included.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Limit is the number of cycles to include in the results. A value of zero
+	// means no limit is applied. The limit is applied at the end of the
+	// results, so a limit of 3 will return the 3 last cycles.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPomodoroCycleListRequest) Reset() {
+	*x = GetPomodoroCycleListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPomodoroCycleListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPomodoroCycleListRequest) ProtoMessage() {}
+
+func (x *GetPomodoroCycleListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPomodoroCycleListRequest.ProtoReflect.Descriptor instead.
+func (*GetPomodoroCycleListRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPomodoroCycleListRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetPomodoroCycleListRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetPomodoroCycleListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetPomodoroCycleListResponse holds the list of Pomodoro cycles.
+type GetPomodoroCycleListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cycles is the list of Pomodoro cycles that matched the search query.
+	Cycles []*PomodoroCycle `protobuf:"bytes,1,rep,name=cycles,proto3" json:"cycles,omitempty"`
+}
+
+func (x *GetPomodoroCycleListResponse) Reset() {
+	*x = GetPomodoroCycleListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPomodoroCycleListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPomodoroCycleListResponse) ProtoMessage() {}
+
+func (x *GetPomodoroCycleListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_pomodoro_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPomodoroCycleListResponse.ProtoReflect.Descriptor instead.
+func (*GetPomodoroCycleListResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPomodoroCycleListResponse) GetCycles() []*PomodoroCycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+var File_api_dinkurapi_v1_pomodoro_proto protoreflect.FileDescriptor
+
+var file_api_dinkurapi_v1_pomodoro_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x80, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x65, 0x76,
+	0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x45, 0x76, 0x65, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x31, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x70, 0x68, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x37, 0x0a, 0x09, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x70, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72,
+	0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x71, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f,
+	0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f,
+	0x72, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x50, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6d,
+	0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x70, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x6f,
+	0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x6f,
+	0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a,
+	0x14, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64,
+	0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d,
+	0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x6f, 0x6d, 0x6f,
+	0x64, 0x6f, 0x72, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f,
+	0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01,
+	0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x6f, 0x6d, 0x6f,
+	0x64, 0x6f, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f,
+	0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72,
+	0x6f, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x22, 0x50, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6d, 0x6f,
+	0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x05, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f,
+	0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x53, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f,
+	0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x2a,
+	0x81, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f,
+	0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x52, 0x45,
+	0x41, 0x4b, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f,
+	0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x5f, 0x42, 0x52, 0x45, 0x41,
+	0x4b, 0x10, 0x03, 0x2a, 0xaa, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52,
+	0x4f, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52,
+	0x4f, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x4d, 0x4f, 0x44, 0x4f, 0x52, 0x4f,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04,
+	0x32, 0xc9, 0x04, 0x0a, 0x08, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x12, 0x58, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x12, 0x22,
+	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x50,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6d, 0x6f, 0x64,
+	0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x6f,
+	0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x12, 0x20, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x6d, 0x6f,
+	0x64, 0x6f, 0x72, 0x6f, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f,
+	0x72, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x6a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6d, 0x6f, 0x64,
+	0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f,
+	0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f,
+	0x43, 0x79, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6d, 0x6f, 0x64, 0x6f, 0x72, 0x6f, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_api_dinkurapi_v1_pomodoro_proto_rawDescOnce sync.Once
+	file_api_dinkurapi_v1_pomodoro_proto_rawDescData = file_api_dinkurapi_v1_pomodoro_proto_rawDesc
+)
+
+func file_api_dinkurapi_v1_pomodoro_proto_rawDescGZIP() []byte {
+	file_api_dinkurapi_v1_pomodoro_proto_rawDescOnce.Do(func() {
+		file_api_dinkurapi_v1_pomodoro_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_dinkurapi_v1_pomodoro_proto_rawDescData)
+	})
+	return file_api_dinkurapi_v1_pomodoro_proto_rawDescData
+}
+
+var file_api_dinkurapi_v1_pomodoro_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_dinkurapi_v1_pomodoro_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_dinkurapi_v1_pomodoro_proto_goTypes = []interface{}{
+	(PomodoroPhase)(0),                   // 0: dinkurapi.v1.PomodoroPhase
+	(PomodoroEvent)(0),                   // 1: dinkurapi.v1.PomodoroEvent
+	(*PomodoroSettings)(nil),             // 2: dinkurapi.v1.PomodoroSettings
+	(*PomodoroState)(nil),                // 3: dinkurapi.v1.PomodoroState
+	(*PomodoroCycle)(nil),                // 4: dinkurapi.v1.PomodoroCycle
+	(*StartPomodoroRequest)(nil),         // 5: dinkurapi.v1.StartPomodoroRequest
+	(*StartPomodoroResponse)(nil),        // 6: dinkurapi.v1.StartPomodoroResponse
+	(*StopPomodoroRequest)(nil),          // 7: dinkurapi.v1.StopPomodoroRequest
+	(*StopPomodoroResponse)(nil),         // 8: dinkurapi.v1.StopPomodoroResponse
+	(*GetPomodoroRequest)(nil),           // 9: dinkurapi.v1.GetPomodoroRequest
+	(*GetPomodoroResponse)(nil),          // 10: dinkurapi.v1.GetPomodoroResponse
+	(*StreamPomodoroRequest)(nil),        // 11: dinkurapi.v1.StreamPomodoroRequest
+	(*StreamPomodoroResponse)(nil),       // 12: dinkurapi.v1.StreamPomodoroResponse
+	(*CreatePomodoroCycleRequest)(nil),   // 13: dinkurapi.v1.CreatePomodoroCycleRequest
+	(*CreatePomodoroCycleResponse)(nil),  // 14: dinkurapi.v1.CreatePomodoroCycleResponse
+	(*GetPomodoroCycleListRequest)(nil),  // 15: dinkurapi.v1.GetPomodoroCycleListRequest
+	(*GetPomodoroCycleListResponse)(nil), // 16: dinkurapi.v1.GetPomodoroCycleListResponse
+	(*durationpb.Duration)(nil),          // 17: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 18: google.protobuf.Timestamp
+}
+var file_api_dinkurapi_v1_pomodoro_proto_depIdxs = []int32{
+	17, // 0: dinkurapi.v1.PomodoroSettings.work:type_name -> google.protobuf.Duration
+	17, // 1: dinkurapi.v1.PomodoroSettings.break:type_name -> google.protobuf.Duration
+	17, // 2: dinkurapi.v1.PomodoroSettings.long_break:type_name -> google.protobuf.Duration
+	2,  // 3: dinkurapi.v1.PomodoroState.settings:type_name -> dinkurapi.v1.PomodoroSettings
+	0,  // 4: dinkurapi.v1.PomodoroState.phase:type_name -> dinkurapi.v1.PomodoroPhase
+	18, // 5: dinkurapi.v1.PomodoroState.phase_start:type_name -> google.protobuf.Timestamp
+	18, // 6: dinkurapi.v1.PomodoroState.phase_end:type_name -> google.protobuf.Timestamp
+	18, // 7: dinkurapi.v1.PomodoroCycle.created:type_name -> google.protobuf.Timestamp
+	18, // 8: dinkurapi.v1.PomodoroCycle.updated:type_name -> google.protobuf.Timestamp
+	18, // 9: dinkurapi.v1.PomodoroCycle.start:type_name -> google.protobuf.Timestamp
+	18, // 10: dinkurapi.v1.PomodoroCycle.end:type_name -> google.protobuf.Timestamp
+	2,  // 11: dinkurapi.v1.StartPomodoroRequest.settings:type_name -> dinkurapi.v1.PomodoroSettings
+	3,  // 12: dinkurapi.v1.StartPomodoroResponse.pomodoro:type_name -> dinkurapi.v1.PomodoroState
+	3,  // 13: dinkurapi.v1.StopPomodoroResponse.pomodoro:type_name -> dinkurapi.v1.PomodoroState
+	3,  // 14: dinkurapi.v1.GetPomodoroResponse.pomodoro:type_name -> dinkurapi.v1.PomodoroState
+	3,  // 15: dinkurapi.v1.StreamPomodoroResponse.pomodoro:type_name -> dinkurapi.v1.PomodoroState
+	1,  // 16: dinkurapi.v1.StreamPomodoroResponse.event:type_name -> dinkurapi.v1.PomodoroEvent
+	18, // 17: dinkurapi.v1.CreatePomodoroCycleRequest.start:type_name -> google.protobuf.Timestamp
+	18, // 18: dinkurapi.v1.CreatePomodoroCycleRequest.end:type_name -> google.protobuf.Timestamp
+	4,  // 19: dinkurapi.v1.CreatePomodoroCycleResponse.cycle:type_name -> dinkurapi.v1.PomodoroCycle
+	18, // 20: dinkurapi.v1.GetPomodoroCycleListRequest.start:type_name -> google.protobuf.Timestamp
+	18, // 21: dinkurapi.v1.GetPomodoroCycleListRequest.end:type_name -> google.protobuf.Timestamp
+	4,  // 22: dinkurapi.v1.GetPomodoroCycleListResponse.cycles:type_name -> dinkurapi.v1.PomodoroCycle
+	5,  // 23: dinkurapi.v1.Pomodoro.StartPomodoro:input_type -> dinkurapi.v1.StartPomodoroRequest
+	7,  // 24: dinkurapi.v1.Pomodoro.StopPomodoro:input_type -> dinkurapi.v1.StopPomodoroRequest
+	9,  // 25: dinkurapi.v1.Pomodoro.GetPomodoro:input_type -> dinkurapi.v1.GetPomodoroRequest
+	11, // 26: dinkurapi.v1.Pomodoro.StreamPomodoro:input_type -> dinkurapi.v1.StreamPomodoroRequest
+	13, // 27: dinkurapi.v1.Pomodoro.CreatePomodoroCycle:input_type -> dinkurapi.v1.CreatePomodoroCycleRequest
+	15, // 28: dinkurapi.v1.Pomodoro.GetPomodoroCycleList:input_type -> dinkurapi.v1.GetPomodoroCycleListRequest
+	6,  // 29: dinkurapi.v1.Pomodoro.StartPomodoro:output_type -> dinkurapi.v1.StartPomodoroResponse
+	8,  // 30: dinkurapi.v1.Pomodoro.StopPomodoro:output_type -> dinkurapi.v1.StopPomodoroResponse
+	10, // 31: dinkurapi.v1.Pomodoro.GetPomodoro:output_type -> dinkurapi.v1.GetPomodoroResponse
+	12, // 32: dinkurapi.v1.Pomodoro.StreamPomodoro:output_type -> dinkurapi.v1.StreamPomodoroResponse
+	14, // 33: dinkurapi.v1.Pomodoro.CreatePomodoroCycle:output_type -> dinkurapi.v1.CreatePomodoroCycleResponse
+	16, // 34: dinkurapi.v1.Pomodoro.GetPomodoroCycleList:output_type -> dinkurapi.v1.GetPomodoroCycleListResponse
+	29, // [29:35] is the sub-list for method output_type
+	23, // [23:29] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_api_dinkurapi_v1_pomodoro_proto_init() }
+func file_api_dinkurapi_v1_pomodoro_proto_init() {
+	if File_api_dinkurapi_v1_pomodoro_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PomodoroSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PomodoroState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PomodoroCycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPomodoroRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPomodoroResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPomodoroRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPomodoroResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPomodoroRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPomodoroResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPomodoroRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPomodoroResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePomodoroCycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePomodoroCycleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPomodoroCycleListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_pomodoro_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPomodoroCycleListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_dinkurapi_v1_pomodoro_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_dinkurapi_v1_pomodoro_proto_goTypes,
+		DependencyIndexes: file_api_dinkurapi_v1_pomodoro_proto_depIdxs,
+		EnumInfos:         file_api_dinkurapi_v1_pomodoro_proto_enumTypes,
+		MessageInfos:      file_api_dinkurapi_v1_pomodoro_proto_msgTypes,
+	}.Build()
+	File_api_dinkurapi_v1_pomodoro_proto = out.File
+	file_api_dinkurapi_v1_pomodoro_proto_rawDesc = nil
+	file_api_dinkurapi_v1_pomodoro_proto_goTypes = nil
+	file_api_dinkurapi_v1_pomodoro_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PomodoroClient is the client API for Pomodoro service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PomodoroClient interface {
+	// StartPomodoro starts a new Pomodoro timer, by creating a new entry and
+	// starting the first work period. Any previous Pomodoro timer is stopped.
+	StartPomodoro(ctx context.Context, in *StartPomodoroRequest, opts ...grpc.CallOption) (*StartPomodoroResponse, error)
+	// StopPomodoro stops the Pomodoro timer, as well as the active entry if it
+	// was started by the timer.
+	StopPomodoro(ctx context.Context, in *StopPomodoroRequest, opts ...grpc.CallOption) (*StopPomodoroResponse, error)
+	// GetPomodoro gets the current state of the Pomodoro timer.
+	GetPomodoro(ctx context.Context, in *GetPomodoroRequest, opts ...grpc.CallOption) (*GetPomodoroResponse, error)
+	// StreamPomodoro streams Pomodoro timer events, such as when a break
+	// starts.
+	StreamPomodoro(ctx context.Context, in *StreamPomodoroRequest, opts ...grpc.CallOption) (Pomodoro_StreamPomodoroClient, error)
+	// CreatePomodoroCycle adds a completed work period to the Pomodoro cycle
+	// history.
+	CreatePomodoroCycle(ctx context.Context, in *CreatePomodoroCycleRequest, opts ...grpc.CallOption) (*CreatePomodoroCycleResponse, error)
+	// GetPomodoroCycleList queries for a list of completed work periods.
+	GetPomodoroCycleList(ctx context.Context, in *GetPomodoroCycleListRequest, opts ...grpc.CallOption) (*GetPomodoroCycleListResponse, error)
+}
+
+type pomodoroClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPomodoroClient(cc grpc.ClientConnInterface) PomodoroClient {
+	return &pomodoroClient{cc}
+}
+
+func (c *pomodoroClient) StartPomodoro(ctx context.Context, in *StartPomodoroRequest, opts ...grpc.CallOption) (*StartPomodoroResponse, error) {
+	out := new(StartPomodoroResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pomodoro/StartPomodoro", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pomodoroClient) StopPomodoro(ctx context.Context, in *StopPomodoroRequest, opts ...grpc.CallOption) (*StopPomodoroResponse, error) {
+	out := new(StopPomodoroResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pomodoro/StopPomodoro", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pomodoroClient) GetPomodoro(ctx context.Context, in *GetPomodoroRequest, opts ...grpc.CallOption) (*GetPomodoroResponse, error) {
+	out := new(GetPomodoroResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pomodoro/GetPomodoro", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pomodoroClient) StreamPomodoro(ctx context.Context, in *StreamPomodoroRequest, opts ...grpc.CallOption) (Pomodoro_StreamPomodoroClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Pomodoro_ServiceDesc.Streams[0], "/dinkurapi.v1.Pomodoro/StreamPomodoro", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pomodoroStreamPomodoroClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pomodoro_StreamPomodoroClient interface {
+	Recv() (*StreamPomodoroResponse, error)
+	grpc.ClientStream
+}
+
+type pomodoroStreamPomodoroClient struct {
+	grpc.ClientStream
+}
+
+func (x *pomodoroStreamPomodoroClient) Recv() (*StreamPomodoroResponse, error) {
+	m := new(StreamPomodoroResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pomodoroClient) CreatePomodoroCycle(ctx context.Context, in *CreatePomodoroCycleRequest, opts ...grpc.CallOption) (*CreatePomodoroCycleResponse, error) {
+	out := new(CreatePomodoroCycleResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pomodoro/CreatePomodoroCycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pomodoroClient) GetPomodoroCycleList(ctx context.Context, in *GetPomodoroCycleListRequest, opts ...grpc.CallOption) (*GetPomodoroCycleListResponse, error) {
+	out := new(GetPomodoroCycleListResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Pomodoro/GetPomodoroCycleList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PomodoroServer is the server API for Pomodoro service.
+// All implementations must embed UnimplementedPomodoroServer
+// for forward compatibility
+type PomodoroServer interface {
+	// StartPomodoro starts a new Pomodoro timer, by creating a new entry and
+	// starting the first work period. Any previous Pomodoro timer is stopped.
+	StartPomodoro(context.Context, *StartPomodoroRequest) (*StartPomodoroResponse, error)
+	// StopPomodoro stops the Pomodoro timer, as well as the active entry if it
+	// was started by the timer.
+	StopPomodoro(context.Context, *StopPomodoroRequest) (*StopPomodoroResponse, error)
+	// GetPomodoro gets the current state of the Pomodoro timer.
+	GetPomodoro(context.Context, *GetPomodoroRequest) (*GetPomodoroResponse, error)
+	// StreamPomodoro streams Pomodoro timer events, such as when a break
+	// starts.
+	StreamPomodoro(*StreamPomodoroRequest, Pomodoro_StreamPomodoroServer) error
+	// CreatePomodoroCycle adds a completed work period to the Pomodoro cycle
+	// history.
+	CreatePomodoroCycle(context.Context, *CreatePomodoroCycleRequest) (*CreatePomodoroCycleResponse, error)
+	// GetPomodoroCycleList queries for a list of completed work periods.
+	GetPomodoroCycleList(context.Context, *GetPomodoroCycleListRequest) (*GetPomodoroCycleListResponse, error)
+	mustEmbedUnimplementedPomodoroServer()
+}
+
+// UnimplementedPomodoroServer must be embedded to have forward compatible implementations.
+type UnimplementedPomodoroServer struct {
+}
+
+func (UnimplementedPomodoroServer) StartPomodoro(context.Context, *StartPomodoroRequest) (*StartPomodoroResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPomodoro not implemented")
+}
+func (UnimplementedPomodoroServer) StopPomodoro(context.Context, *StopPomodoroRequest) (*StopPomodoroResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPomodoro not implemented")
+}
+func (UnimplementedPomodoroServer) GetPomodoro(context.Context, *GetPomodoroRequest) (*GetPomodoroResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPomodoro not implemented")
+}
+func (UnimplementedPomodoroServer) StreamPomodoro(*StreamPomodoroRequest, Pomodoro_StreamPomodoroServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPomodoro not implemented")
+}
+func (UnimplementedPomodoroServer) CreatePomodoroCycle(context.Context, *CreatePomodoroCycleRequest) (*CreatePomodoroCycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePomodoroCycle not implemented")
+}
+func (UnimplementedPomodoroServer) GetPomodoroCycleList(context.Context, *GetPomodoroCycleListRequest) (*GetPomodoroCycleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPomodoroCycleList not implemented")
+}
+func (UnimplementedPomodoroServer) mustEmbedUnimplementedPomodoroServer() {}
+
+// UnsafePomodoroServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PomodoroServer will
+// result in compilation errors.
+type UnsafePomodoroServer interface {
+	mustEmbedUnimplementedPomodoroServer()
+}
+
+func RegisterPomodoroServer(s grpc.ServiceRegistrar, srv PomodoroServer) {
+	s.RegisterService(&Pomodoro_ServiceDesc, srv)
+}
+
+func _Pomodoro_StartPomodoro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPomodoroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PomodoroServer).StartPomodoro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pomodoro/StartPomodoro",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PomodoroServer).StartPomodoro(ctx, req.(*StartPomodoroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pomodoro_StopPomodoro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPomodoroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PomodoroServer).StopPomodoro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pomodoro/StopPomodoro",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PomodoroServer).StopPomodoro(ctx, req.(*StopPomodoroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pomodoro_GetPomodoro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPomodoroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PomodoroServer).GetPomodoro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pomodoro/GetPomodoro",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PomodoroServer).GetPomodoro(ctx, req.(*GetPomodoroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pomodoro_StreamPomodoro_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPomodoroRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PomodoroServer).StreamPomodoro(m, &pomodoroStreamPomodoroServer{stream})
+}
+
+type Pomodoro_StreamPomodoroServer interface {
+	Send(*StreamPomodoroResponse) error
+	grpc.ServerStream
+}
+
+type pomodoroStreamPomodoroServer struct {
+	grpc.ServerStream
+}
+
+func (x *pomodoroStreamPomodoroServer) Send(m *StreamPomodoroResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pomodoro_CreatePomodoroCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePomodoroCycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PomodoroServer).CreatePomodoroCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pomodoro/CreatePomodoroCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PomodoroServer).CreatePomodoroCycle(ctx, req.(*CreatePomodoroCycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pomodoro_GetPomodoroCycleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPomodoroCycleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PomodoroServer).GetPomodoroCycleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Pomodoro/GetPomodoroCycleList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PomodoroServer).GetPomodoroCycleList(ctx, req.(*GetPomodoroCycleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pomodoro_ServiceDesc is the grpc.ServiceDesc for Pomodoro service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pomodoro_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dinkurapi.v1.Pomodoro",
+	HandlerType: (*PomodoroServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartPomodoro",
+			Handler:    _Pomodoro_StartPomodoro_Handler,
+		},
+		{
+			MethodName: "StopPomodoro",
+			Handler:    _Pomodoro_StopPomodoro_Handler,
+		},
+		{
+			MethodName: "GetPomodoro",
+			Handler:    _Pomodoro_GetPomodoro_Handler,
+		},
+		{
+			MethodName: "CreatePomodoroCycle",
+			Handler:    _Pomodoro_CreatePomodoroCycle_Handler,
+		},
+		{
+			MethodName: "GetPomodoroCycleList",
+			Handler:    _Pomodoro_GetPomodoroCycleList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPomodoro",
+			Handler:       _Pomodoro_StreamPomodoro_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/dinkurapi/v1/pomodoro.proto",
+}
@@ -29,6 +29,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -41,6 +42,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AfkResolution is an enumeration of how an AFK period was resolved.
+type AfkResolution int32
+
+const (
+	// AFK_RESOLUTION_UNSPECIFIED means the AFK period has not yet been resolved.
+	AfkResolution_AFK_RESOLUTION_UNSPECIFIED AfkResolution = 0
+	// AFK_RESOLUTION_KEEP means the time spent away was kept in the active entry.
+	AfkResolution_AFK_RESOLUTION_KEEP AfkResolution = 1
+	// AFK_RESOLUTION_DISCARD means the time spent away was discarded by ending
+	// the active entry when the user went AFK.
+	AfkResolution_AFK_RESOLUTION_DISCARD AfkResolution = 2
+	// AFK_RESOLUTION_NEW_ENTRY means the time spent away was saved as a new
+	// entry.
+	AfkResolution_AFK_RESOLUTION_NEW_ENTRY AfkResolution = 3
+	// AFK_RESOLUTION_DISMISSED means the AFK status was cleared without the user
+	// resolving it, such as when the active entry was changed while AFK.
+	AfkResolution_AFK_RESOLUTION_DISMISSED AfkResolution = 4
+	// AFK_RESOLUTION_SPLIT means the time spent away was split into multiple new
+	// entries.
+	AfkResolution_AFK_RESOLUTION_SPLIT AfkResolution = 5
+)
+
+// Enum value maps for AfkResolution.
+var (
+	AfkResolution_name = map[int32]string{
+		0: "AFK_RESOLUTION_UNSPECIFIED",
+		1: "AFK_RESOLUTION_KEEP",
+		2: "AFK_RESOLUTION_DISCARD",
+		3: "AFK_RESOLUTION_NEW_ENTRY",
+		4: "AFK_RESOLUTION_DISMISSED",
+		5: "AFK_RESOLUTION_SPLIT",
+	}
+	AfkResolution_value = map[string]int32{
+		"AFK_RESOLUTION_UNSPECIFIED": 0,
+		"AFK_RESOLUTION_KEEP":        1,
+		"AFK_RESOLUTION_DISCARD":     2,
+		"AFK_RESOLUTION_NEW_ENTRY":   3,
+		"AFK_RESOLUTION_DISMISSED":   4,
+		"AFK_RESOLUTION_SPLIT":       5,
+	}
+)
+
+func (x AfkResolution) Enum() *AfkResolution {
+	p := new(AfkResolution)
+	*p = x
+	return p
+}
+
+func (x AfkResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AfkResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_statuses_proto_enumTypes[0].Descriptor()
+}
+
+func (AfkResolution) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_statuses_proto_enumTypes[0]
+}
+
+func (x AfkResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AfkResolution.Descriptor instead.
+func (AfkResolution) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{0}
+}
+
 // StreamStatusRequest is an empty message and unused. It is here as a
 // placeholder for potential future use.
 type StreamStatusRequest struct {
@@ -141,6 +211,14 @@ type SetStatusRequest struct {
 	// BackSince is set whenever the user has returned from being AFK, but has not
 	// yet resolved their AFK status.
 	BackSince *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=back_since,json=backSince,proto3" json:"back_since,omitempty"`
+	// AfkHook is the name of the AFK detector hook that detected that the user
+	// went AFK. It is only used when the afk_since field is newly set, and is
+	// stored in the AFK period history.
+	AfkHook string `protobuf:"bytes,3,opt,name=afk_hook,json=afkHook,proto3" json:"afk_hook,omitempty"`
+	// AfkResolution states how the user resolved the time spent away. It is only
+	// used when the afk_since field is cleared, and is stored in the AFK period
+	// history. Defaults to AFK_RESOLUTION_DISMISSED.
+	AfkResolution AfkResolution `protobuf:"varint,4,opt,name=afk_resolution,json=afkResolution,proto3,enum=dinkurapi.v1.AfkResolution" json:"afk_resolution,omitempty"`
 }
 
 func (x *SetStatusRequest) Reset() {
@@ -189,6 +267,20 @@ func (x *SetStatusRequest) GetBackSince() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SetStatusRequest) GetAfkHook() string {
+	if x != nil {
+		return x.AfkHook
+	}
+	return ""
+}
+
+func (x *SetStatusRequest) GetAfkResolution() AfkResolution {
+	if x != nil {
+		return x.AfkResolution
+	}
+	return AfkResolution_AFK_RESOLUTION_UNSPECIFIED
+}
+
 // SetStatusResponse is an empty message and unused. It is here as a placeholder
 // for potential future use.
 type SetStatusResponse struct {
@@ -318,28 +410,19 @@ func (x *GetStatusResponse) GetStatus() *Status {
 	return nil
 }
 
-// Status is a sort of notification issued by the Dinkur daemon, and contains a
-// union type of different status types.
-type Status struct {
+// SetHeartbeatRequest holds the new heartbeat timestamp.
+type SetHeartbeatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Created is a timestamp of when the status was initially issued.
-	Created *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
-	// Updated is a timestamp of when the status was most recently changed. This
-	// has the same value as when the status was created if it has never been
-	// updated.
-	Updated *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
-	// AfkSince is set whenever the user has gone AFK.
-	AfkSince *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=afk_since,json=afkSince,proto3" json:"afk_since,omitempty"`
-	// BackSince is set whenever the user has returned from being AFK, but has not
-	// yet resolved their AFK status.
-	BackSince *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=back_since,json=backSince,proto3" json:"back_since,omitempty"`
+	// Heartbeat is the timestamp of when the Dinkur daemon was last known to be
+	// running. If left unset, the current timestamp is used instead.
+	Heartbeat *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *SetHeartbeatRequest) Reset() {
+	*x = SetHeartbeatRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,13 +430,13 @@ func (x *Status) Reset() {
 	}
 }
 
-func (x *Status) String() string {
+func (x *SetHeartbeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*SetHeartbeatRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *SetHeartbeatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,196 +448,1444 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*SetHeartbeatRequest) Descriptor() ([]byte, []int) {
 	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Status) GetCreated() *timestamppb.Timestamp {
+func (x *SetHeartbeatRequest) GetHeartbeat() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Created
+		return x.Heartbeat
 	}
 	return nil
 }
 
-func (x *Status) GetUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Updated
+// SetHeartbeatResponse is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type SetHeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetHeartbeatResponse) Reset() {
+	*x = SetHeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Status) GetAfkSince() *timestamppb.Timestamp {
+func (x *SetHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHeartbeatResponse) ProtoMessage() {}
+
+func (x *SetHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*SetHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{7}
+}
+
+// SetNotTrackingRequest holds the new "not tracking" status.
+type SetNotTrackingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NotTrackingSince is set whenever the user has been at the keyboard for a
+	// while without any active entry. Leave it unset to clear the status.
+	NotTrackingSince *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=not_tracking_since,json=notTrackingSince,proto3" json:"not_tracking_since,omitempty"`
+	// SuggestedEntryName is the name of an entry that the user may want to
+	// start, based on their most recent entries. Ignored if the
+	// not_tracking_since field is unset.
+	SuggestedEntryName string `protobuf:"bytes,2,opt,name=suggested_entry_name,json=suggestedEntryName,proto3" json:"suggested_entry_name,omitempty"`
+}
+
+func (x *SetNotTrackingRequest) Reset() {
+	*x = SetNotTrackingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotTrackingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotTrackingRequest) ProtoMessage() {}
+
+func (x *SetNotTrackingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotTrackingRequest.ProtoReflect.Descriptor instead.
+func (*SetNotTrackingRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetNotTrackingRequest) GetNotTrackingSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AfkSince
+		return x.NotTrackingSince
 	}
 	return nil
 }
 
-func (x *Status) GetBackSince() *timestamppb.Timestamp {
+func (x *SetNotTrackingRequest) GetSuggestedEntryName() string {
 	if x != nil {
-		return x.BackSince
+		return x.SuggestedEntryName
 	}
-	return nil
+	return ""
 }
 
-var File_api_dinkurapi_v1_statuses_proto protoreflect.FileDescriptor
+// SetNotTrackingResponse is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type SetNotTrackingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-var file_api_dinkurapi_v1_statuses_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0c, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x86, 0x01,
-	0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x66, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x08, 0x61, 0x66, 0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63,
-	0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x66, 0x6b,
-	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x66, 0x6b, 0x53, 0x69, 0x6e,
-	0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x32, 0xff, 0x01,
-	0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
-	0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+func (x *SetNotTrackingResponse) Reset() {
+	*x = SetNotTrackingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_api_dinkurapi_v1_statuses_proto_rawDescOnce sync.Once
-	file_api_dinkurapi_v1_statuses_proto_rawDescData = file_api_dinkurapi_v1_statuses_proto_rawDesc
-)
+func (x *SetNotTrackingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_api_dinkurapi_v1_statuses_proto_rawDescGZIP() []byte {
-	file_api_dinkurapi_v1_statuses_proto_rawDescOnce.Do(func() {
-		file_api_dinkurapi_v1_statuses_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_dinkurapi_v1_statuses_proto_rawDescData)
-	})
-	return file_api_dinkurapi_v1_statuses_proto_rawDescData
+func (*SetNotTrackingResponse) ProtoMessage() {}
+
+func (x *SetNotTrackingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_api_dinkurapi_v1_statuses_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_api_dinkurapi_v1_statuses_proto_goTypes = []interface{}{
-	(*StreamStatusRequest)(nil),   // 0: dinkurapi.v1.StreamStatusRequest
-	(*StreamStatusResponse)(nil),  // 1: dinkurapi.v1.StreamStatusResponse
-	(*SetStatusRequest)(nil),      // 2: dinkurapi.v1.SetStatusRequest
-	(*SetStatusResponse)(nil),     // 3: dinkurapi.v1.SetStatusResponse
-	(*GetStatusRequest)(nil),      // 4: dinkurapi.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),     // 5: dinkurapi.v1.GetStatusResponse
-	(*Status)(nil),                // 6: dinkurapi.v1.Status
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+// Deprecated: Use SetNotTrackingResponse.ProtoReflect.Descriptor instead.
+func (*SetNotTrackingResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{9}
 }
-var file_api_dinkurapi_v1_statuses_proto_depIdxs = []int32{
-	6,  // 0: dinkurapi.v1.StreamStatusResponse.status:type_name -> dinkurapi.v1.Status
-	7,  // 1: dinkurapi.v1.SetStatusRequest.afk_since:type_name -> google.protobuf.Timestamp
-	7,  // 2: dinkurapi.v1.SetStatusRequest.back_since:type_name -> google.protobuf.Timestamp
-	6,  // 3: dinkurapi.v1.GetStatusResponse.status:type_name -> dinkurapi.v1.Status
-	7,  // 4: dinkurapi.v1.Status.created:type_name -> google.protobuf.Timestamp
-	7,  // 5: dinkurapi.v1.Status.updated:type_name -> google.protobuf.Timestamp
-	7,  // 6: dinkurapi.v1.Status.afk_since:type_name -> google.protobuf.Timestamp
-	7,  // 7: dinkurapi.v1.Status.back_since:type_name -> google.protobuf.Timestamp
-	0,  // 8: dinkurapi.v1.Statuses.StreamStatus:input_type -> dinkurapi.v1.StreamStatusRequest
-	2,  // 9: dinkurapi.v1.Statuses.SetStatus:input_type -> dinkurapi.v1.SetStatusRequest
-	4,  // 10: dinkurapi.v1.Statuses.GetStatus:input_type -> dinkurapi.v1.GetStatusRequest
-	1,  // 11: dinkurapi.v1.Statuses.StreamStatus:output_type -> dinkurapi.v1.StreamStatusResponse
-	3,  // 12: dinkurapi.v1.Statuses.SetStatus:output_type -> dinkurapi.v1.SetStatusResponse
-	5,  // 13: dinkurapi.v1.Statuses.GetStatus:output_type -> dinkurapi.v1.GetStatusResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+// GetAfkSettingsRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type GetAfkSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func init() { file_api_dinkurapi_v1_statuses_proto_init() }
-func file_api_dinkurapi_v1_statuses_proto_init() {
-	if File_api_dinkurapi_v1_statuses_proto != nil {
-		return
+func (x *GetAfkSettingsRequest) Reset() {
+	*x = GetAfkSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_dinkurapi_v1_statuses_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_dinkurapi_v1_statuses_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamStatusResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *GetAfkSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfkSettingsRequest) ProtoMessage() {}
+
+func (x *GetAfkSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_dinkurapi_v1_statuses_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfkSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetAfkSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{10}
+}
+
+// GetAfkSettingsResponse holds the current AFK detection settings.
+type GetAfkSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Settings is the current AFK detection settings.
+	Settings *AfkSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetAfkSettingsResponse) Reset() {
+	*x = GetAfkSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAfkSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfkSettingsResponse) ProtoMessage() {}
+
+func (x *GetAfkSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_dinkurapi_v1_statuses_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetStatusResponse); i {
-			case 0:
-				return &v.state
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfkSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetAfkSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAfkSettingsResponse) GetSettings() *AfkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateAfkSettingsRequest holds the changes to the AFK detection settings.
+type UpdateAfkSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Settings is the AFK detection settings to change. Any unset durations and
+	// empty hook lists are left unchanged. A duration set to zero is reset to
+	// the daemon's default value.
+	Settings *AfkSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// ClearAllowHooks empties the allow hooks list, so all available hooks are
+	// used. This is ignored if the allow hooks list in the settings field is
+	// not empty.
+	ClearAllowHooks bool `protobuf:"varint,2,opt,name=clear_allow_hooks,json=clearAllowHooks,proto3" json:"clear_allow_hooks,omitempty"`
+	// ClearDenyHooks empties the deny hooks list. This is ignored if the deny
+	// hooks list in the settings field is not empty.
+	ClearDenyHooks bool `protobuf:"varint,3,opt,name=clear_deny_hooks,json=clearDenyHooks,proto3" json:"clear_deny_hooks,omitempty"`
+}
+
+func (x *UpdateAfkSettingsRequest) Reset() {
+	*x = UpdateAfkSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAfkSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAfkSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateAfkSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAfkSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAfkSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateAfkSettingsRequest) GetSettings() *AfkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateAfkSettingsRequest) GetClearAllowHooks() bool {
+	if x != nil {
+		return x.ClearAllowHooks
+	}
+	return false
+}
+
+func (x *UpdateAfkSettingsRequest) GetClearDenyHooks() bool {
+	if x != nil {
+		return x.ClearDenyHooks
+	}
+	return false
+}
+
+// UpdateAfkSettingsResponse holds the AFK detection settings after the update.
+type UpdateAfkSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Settings is the AFK detection settings after the update, including any
+	// default values.
+	Settings *AfkSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateAfkSettingsResponse) Reset() {
+	*x = UpdateAfkSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAfkSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAfkSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateAfkSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAfkSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAfkSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateAfkSettingsResponse) GetSettings() *AfkSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// AfkSettings holds the settings used by the Dinkur daemon's AFK detection.
+type AfkSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Threshold is how long the user needs to be idle before being considered
+	// AFK.
+	Threshold *durationpb.Duration `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// PollInterval is how often the user's idle time is checked.
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// AllowHooks is a list of names of the AFK hooks to use. All available hooks
+	// are used if the list is empty.
+	AllowHooks []string `protobuf:"bytes,3,rep,name=allow_hooks,json=allowHooks,proto3" json:"allow_hooks,omitempty"`
+	// DenyHooks is a list of names of the AFK hooks to never use. This takes
+	// precedence over the allow hooks list.
+	DenyHooks []string `protobuf:"bytes,4,rep,name=deny_hooks,json=denyHooks,proto3" json:"deny_hooks,omitempty"`
+	// AvailableHooks is a list of names of all AFK hooks available on the
+	// daemon's OS. This field is ignored when updating the settings.
+	AvailableHooks []string `protobuf:"bytes,5,rep,name=available_hooks,json=availableHooks,proto3" json:"available_hooks,omitempty"`
+}
+
+func (x *AfkSettings) Reset() {
+	*x = AfkSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AfkSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfkSettings) ProtoMessage() {}
+
+func (x *AfkSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfkSettings.ProtoReflect.Descriptor instead.
+func (*AfkSettings) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AfkSettings) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *AfkSettings) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *AfkSettings) GetAllowHooks() []string {
+	if x != nil {
+		return x.AllowHooks
+	}
+	return nil
+}
+
+func (x *AfkSettings) GetDenyHooks() []string {
+	if x != nil {
+		return x.DenyHooks
+	}
+	return nil
+}
+
+func (x *AfkSettings) GetAvailableHooks() []string {
+	if x != nil {
+		return x.AvailableHooks
+	}
+	return nil
+}
+
+// GetAfkPeriodListRequest holds search parameters for AFK periods. An empty
+// request message will return all AFK periods.
+type GetAfkPeriodListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start is the starting timestamp bound of AFK periods to list. Any period
+	// that ends after this time, or has not yet ended, is included.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the ending timestamp bound of AFK periods to list. Any period that
+	// starts before this time is included.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Limit is the number of AFK periods to include in the results. A value of
+	// zero means no limit is applied. The limit is applied at the end of the
+	// results, so a limit of 3 will return the 3 last AFK periods.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Shorthand sets the default start and end timestamps to some predefined
+	// time ranges, relative to now. Setting the start or end fields separately
+	// will override the shorthand ranges.
+	Shorthand GetEntryListRequest_Shorthand `protobuf:"varint,4,opt,name=shorthand,proto3,enum=dinkurapi.v1.GetEntryListRequest_Shorthand" json:"shorthand,omitempty"`
+	// ShorthandCount is the number of units used by rolling shorthands, such as
+	// the N in SHORTHAND_LAST_DAYS. A value of zero is treated as 1. It is
+	// ignored by all other shorthands.
+	ShorthandCount uint64 `protobuf:"varint,5,opt,name=shorthand_count,json=shorthandCount,proto3" json:"shorthand_count,omitempty"`
+}
+
+func (x *GetAfkPeriodListRequest) Reset() {
+	*x = GetAfkPeriodListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAfkPeriodListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfkPeriodListRequest) ProtoMessage() {}
+
+func (x *GetAfkPeriodListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfkPeriodListRequest.ProtoReflect.Descriptor instead.
+func (*GetAfkPeriodListRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAfkPeriodListRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetAfkPeriodListRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetAfkPeriodListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAfkPeriodListRequest) GetShorthand() GetEntryListRequest_Shorthand {
+	if x != nil {
+		return x.Shorthand
+	}
+	return GetEntryListRequest_SHORTHAND_UNSPECIFIED
+}
+
+func (x *GetAfkPeriodListRequest) GetShorthandCount() uint64 {
+	if x != nil {
+		return x.ShorthandCount
+	}
+	return 0
+}
+
+// GetAfkPeriodListResponse holds the list of AFK periods that matches the
+// search query.
+type GetAfkPeriodListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AfkPeriods is the list of AFK periods that matched the search query.
+	AfkPeriods []*AfkPeriod `protobuf:"bytes,1,rep,name=afk_periods,json=afkPeriods,proto3" json:"afk_periods,omitempty"`
+}
+
+func (x *GetAfkPeriodListResponse) Reset() {
+	*x = GetAfkPeriodListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAfkPeriodListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfkPeriodListResponse) ProtoMessage() {}
+
+func (x *GetAfkPeriodListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfkPeriodListResponse.ProtoReflect.Descriptor instead.
+func (*GetAfkPeriodListResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAfkPeriodListResponse) GetAfkPeriods() []*AfkPeriod {
+	if x != nil {
+		return x.AfkPeriods
+	}
+	return nil
+}
+
+// ResolveAfkRequest holds how to resolve the current AFK status.
+type ResolveAfkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resolution states how to resolve the time spent away. All resolutions
+	// except AFK_RESOLUTION_KEEP and AFK_RESOLUTION_DISMISSED will end the active
+	// entry at the time the user went AFK. The AFK_RESOLUTION_UNSPECIFIED value
+	// is not allowed.
+	Resolution AfkResolution `protobuf:"varint,1,opt,name=resolution,proto3,enum=dinkurapi.v1.AfkResolution" json:"resolution,omitempty"`
+	// NewEntries are the entries to create for the time spent away, in order.
+	// Required when using AFK_RESOLUTION_NEW_ENTRY or AFK_RESOLUTION_SPLIT, and
+	// ignored otherwise.
+	NewEntries []*AfkNewEntry `protobuf:"bytes,2,rep,name=new_entries,json=newEntries,proto3" json:"new_entries,omitempty"`
+}
+
+func (x *ResolveAfkRequest) Reset() {
+	*x = ResolveAfkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveAfkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAfkRequest) ProtoMessage() {}
+
+func (x *ResolveAfkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAfkRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAfkRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveAfkRequest) GetResolution() AfkResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return AfkResolution_AFK_RESOLUTION_UNSPECIFIED
+}
+
+func (x *ResolveAfkRequest) GetNewEntries() []*AfkNewEntry {
+	if x != nil {
+		return x.NewEntries
+	}
+	return nil
+}
+
+// AfkNewEntry holds a new entry to create when resolving an AFK status.
+type AfkNewEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the name of the new entry.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Start is the starting timestamp of the new entry. If left unset, the new
+	// entry starts where the previous new entry ended, or when the user went AFK
+	// for the first new entry.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the ending timestamp of the new entry. If left unset, the new entry
+	// is left active.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *AfkNewEntry) Reset() {
+	*x = AfkNewEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AfkNewEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfkNewEntry) ProtoMessage() {}
+
+func (x *AfkNewEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfkNewEntry.ProtoReflect.Descriptor instead.
+func (*AfkNewEntry) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AfkNewEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AfkNewEntry) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AfkNewEntry) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// ResolveAfkResponse holds the changes made when resolving the AFK status.
+type ResolveAfkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EditedBefore is the state of the active entry before it was ended, or is
+	// left unset if the active entry was not changed.
+	EditedBefore *Entry `protobuf:"bytes,1,opt,name=edited_before,json=editedBefore,proto3" json:"edited_before,omitempty"`
+	// EditedAfter is the state of the active entry after it was ended, or is
+	// left unset if the active entry was not changed.
+	EditedAfter *Entry `protobuf:"bytes,2,opt,name=edited_after,json=editedAfter,proto3" json:"edited_after,omitempty"`
+	// Created is the list of new entries created for the time spent away.
+	Created []*Entry `protobuf:"bytes,3,rep,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *ResolveAfkResponse) Reset() {
+	*x = ResolveAfkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveAfkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAfkResponse) ProtoMessage() {}
+
+func (x *ResolveAfkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAfkResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAfkResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResolveAfkResponse) GetEditedBefore() *Entry {
+	if x != nil {
+		return x.EditedBefore
+	}
+	return nil
+}
+
+func (x *ResolveAfkResponse) GetEditedAfter() *Entry {
+	if x != nil {
+		return x.EditedAfter
+	}
+	return nil
+}
+
+func (x *ResolveAfkResponse) GetCreated() []*Entry {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+// AfkPeriod is a historical record of when the user was AFK.
+type AfkPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of this AFK period.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created is a timestamp of when the AFK period was initially created.
+	Created *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Updated is a timestamp of when the AFK period was most recently changed.
+	Updated *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Start is the timestamp of when the user went AFK.
+	Start *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the timestamp of when the user returned from being AFK, or is left
+	// unset if the user is still AFK.
+	End *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	// Hook is the name of the AFK detector hook that detected that the user
+	// went AFK, such as "dbus". May be empty if unknown.
+	Hook string `protobuf:"bytes,6,opt,name=hook,proto3" json:"hook,omitempty"`
+	// Resolution is how the user resolved the time spent away.
+	Resolution AfkResolution `protobuf:"varint,7,opt,name=resolution,proto3,enum=dinkurapi.v1.AfkResolution" json:"resolution,omitempty"`
+}
+
+func (x *AfkPeriod) Reset() {
+	*x = AfkPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AfkPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfkPeriod) ProtoMessage() {}
+
+func (x *AfkPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfkPeriod.ProtoReflect.Descriptor instead.
+func (*AfkPeriod) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AfkPeriod) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AfkPeriod) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *AfkPeriod) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *AfkPeriod) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AfkPeriod) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *AfkPeriod) GetHook() string {
+	if x != nil {
+		return x.Hook
+	}
+	return ""
+}
+
+func (x *AfkPeriod) GetResolution() AfkResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return AfkResolution_AFK_RESOLUTION_UNSPECIFIED
+}
+
+// Status is a sort of notification issued by the Dinkur daemon, and contains a
+// union type of different status types.
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Created is a timestamp of when the status was initially issued.
+	Created *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Updated is a timestamp of when the status was most recently changed. This
+	// has the same value as when the status was created if it has never been
+	// updated.
+	Updated *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	// AfkSince is set whenever the user has gone AFK.
+	AfkSince *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=afk_since,json=afkSince,proto3" json:"afk_since,omitempty"`
+	// BackSince is set whenever the user has returned from being AFK, but has not
+	// yet resolved their AFK status.
+	BackSince *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=back_since,json=backSince,proto3" json:"back_since,omitempty"`
+	// Heartbeat is the timestamp of when the Dinkur daemon was last known to be
+	// running. It is used to detect downtime, such as when the computer was
+	// rebooted or shut down without the daemon closing gracefully.
+	Heartbeat *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// NotTrackingSince is set whenever the user has been at the keyboard for a
+	// while without any active entry, and is used to remind the user to start
+	// tracking.
+	NotTrackingSince *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=not_tracking_since,json=notTrackingSince,proto3" json:"not_tracking_since,omitempty"`
+	// SuggestedEntryName is the name of an entry that the user may want to
+	// start, based on their most recent entries. Only set together with the
+	// not_tracking_since field, and may be empty if there is no suggestion.
+	SuggestedEntryName string `protobuf:"bytes,8,opt,name=suggested_entry_name,json=suggestedEntryName,proto3" json:"suggested_entry_name,omitempty"`
+	// PausedEntryId is the ID of the entry that was paused and can be resumed,
+	// or zero if there is no paused entry.
+	PausedEntryId uint64 `protobuf:"varint,9,opt,name=paused_entry_id,json=pausedEntryId,proto3" json:"paused_entry_id,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_statuses_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_statuses_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Status) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Status) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Status) GetAfkSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AfkSince
+	}
+	return nil
+}
+
+func (x *Status) GetBackSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackSince
+	}
+	return nil
+}
+
+func (x *Status) GetHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Status) GetNotTrackingSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotTrackingSince
+	}
+	return nil
+}
+
+func (x *Status) GetSuggestedEntryName() string {
+	if x != nil {
+		return x.SuggestedEntryName
+	}
+	return ""
+}
+
+func (x *Status) GetPausedEntryId() uint64 {
+	if x != nil {
+		return x.PausedEntryId
+	}
+	return 0
+}
+
+var File_api_dinkurapi_v1_statuses_proto protoreflect.FileDescriptor
+
+var file_api_dinkurapi_v1_statuses_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a,
+	0x1e, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe5, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x66, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x61, 0x66, 0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66, 0x6b, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x66, 0x6b, 0x48, 0x6f, 0x6f,
+	0x6b, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x66, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x66, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x6e, 0x6f,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x65,
+	0x6e, 0x79, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x52,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x70,
+	0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x6e, 0x79, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x65, 0x6e, 0x79, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68,
+	0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x68, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x41, 0x66, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x66, 0x6b, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x0a, 0x61, 0x66, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x22, 0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x66, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b, 0x4e, 0x65, 0x77, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x0b, 0x41, 0x66, 0x6b, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41,
+	0x66, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0xb8, 0x02, 0x0a, 0x09,
+	0x41, 0x66, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x66, 0x6b,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x03, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x61, 0x66, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x66,
+	0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x6e,
+	0x6f, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x2a,
+	0xba, 0x01, 0x0a, 0x0d, 0x41, 0x66, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x46, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x46, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x46,
+	0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53,
+	0x43, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x46, 0x4b, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x45, 0x4e, 0x54,
+	0x52, 0x59, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x46, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x46, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x05, 0x32, 0xaa, 0x06, 0x0a,
+	0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x21,
+	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x66, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x66, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x41, 0x66, 0x6b, 0x12, 0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x66, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x66,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_dinkurapi_v1_statuses_proto_rawDescOnce sync.Once
+	file_api_dinkurapi_v1_statuses_proto_rawDescData = file_api_dinkurapi_v1_statuses_proto_rawDesc
+)
+
+func file_api_dinkurapi_v1_statuses_proto_rawDescGZIP() []byte {
+	file_api_dinkurapi_v1_statuses_proto_rawDescOnce.Do(func() {
+		file_api_dinkurapi_v1_statuses_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_dinkurapi_v1_statuses_proto_rawDescData)
+	})
+	return file_api_dinkurapi_v1_statuses_proto_rawDescData
+}
+
+var file_api_dinkurapi_v1_statuses_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_dinkurapi_v1_statuses_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_api_dinkurapi_v1_statuses_proto_goTypes = []interface{}{
+	(AfkResolution)(0),                 // 0: dinkurapi.v1.AfkResolution
+	(*StreamStatusRequest)(nil),        // 1: dinkurapi.v1.StreamStatusRequest
+	(*StreamStatusResponse)(nil),       // 2: dinkurapi.v1.StreamStatusResponse
+	(*SetStatusRequest)(nil),           // 3: dinkurapi.v1.SetStatusRequest
+	(*SetStatusResponse)(nil),          // 4: dinkurapi.v1.SetStatusResponse
+	(*GetStatusRequest)(nil),           // 5: dinkurapi.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),          // 6: dinkurapi.v1.GetStatusResponse
+	(*SetHeartbeatRequest)(nil),        // 7: dinkurapi.v1.SetHeartbeatRequest
+	(*SetHeartbeatResponse)(nil),       // 8: dinkurapi.v1.SetHeartbeatResponse
+	(*SetNotTrackingRequest)(nil),      // 9: dinkurapi.v1.SetNotTrackingRequest
+	(*SetNotTrackingResponse)(nil),     // 10: dinkurapi.v1.SetNotTrackingResponse
+	(*GetAfkSettingsRequest)(nil),      // 11: dinkurapi.v1.GetAfkSettingsRequest
+	(*GetAfkSettingsResponse)(nil),     // 12: dinkurapi.v1.GetAfkSettingsResponse
+	(*UpdateAfkSettingsRequest)(nil),   // 13: dinkurapi.v1.UpdateAfkSettingsRequest
+	(*UpdateAfkSettingsResponse)(nil),  // 14: dinkurapi.v1.UpdateAfkSettingsResponse
+	(*AfkSettings)(nil),                // 15: dinkurapi.v1.AfkSettings
+	(*GetAfkPeriodListRequest)(nil),    // 16: dinkurapi.v1.GetAfkPeriodListRequest
+	(*GetAfkPeriodListResponse)(nil),   // 17: dinkurapi.v1.GetAfkPeriodListResponse
+	(*ResolveAfkRequest)(nil),          // 18: dinkurapi.v1.ResolveAfkRequest
+	(*AfkNewEntry)(nil),                // 19: dinkurapi.v1.AfkNewEntry
+	(*ResolveAfkResponse)(nil),         // 20: dinkurapi.v1.ResolveAfkResponse
+	(*AfkPeriod)(nil),                  // 21: dinkurapi.v1.AfkPeriod
+	(*Status)(nil),                     // 22: dinkurapi.v1.Status
+	(*timestamppb.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 24: google.protobuf.Duration
+	(GetEntryListRequest_Shorthand)(0), // 25: dinkurapi.v1.GetEntryListRequest.Shorthand
+	(*Entry)(nil),                      // 26: dinkurapi.v1.Entry
+}
+var file_api_dinkurapi_v1_statuses_proto_depIdxs = []int32{
+	22, // 0: dinkurapi.v1.StreamStatusResponse.status:type_name -> dinkurapi.v1.Status
+	23, // 1: dinkurapi.v1.SetStatusRequest.afk_since:type_name -> google.protobuf.Timestamp
+	23, // 2: dinkurapi.v1.SetStatusRequest.back_since:type_name -> google.protobuf.Timestamp
+	0,  // 3: dinkurapi.v1.SetStatusRequest.afk_resolution:type_name -> dinkurapi.v1.AfkResolution
+	22, // 4: dinkurapi.v1.GetStatusResponse.status:type_name -> dinkurapi.v1.Status
+	23, // 5: dinkurapi.v1.SetHeartbeatRequest.heartbeat:type_name -> google.protobuf.Timestamp
+	23, // 6: dinkurapi.v1.SetNotTrackingRequest.not_tracking_since:type_name -> google.protobuf.Timestamp
+	15, // 7: dinkurapi.v1.GetAfkSettingsResponse.settings:type_name -> dinkurapi.v1.AfkSettings
+	15, // 8: dinkurapi.v1.UpdateAfkSettingsRequest.settings:type_name -> dinkurapi.v1.AfkSettings
+	15, // 9: dinkurapi.v1.UpdateAfkSettingsResponse.settings:type_name -> dinkurapi.v1.AfkSettings
+	24, // 10: dinkurapi.v1.AfkSettings.threshold:type_name -> google.protobuf.Duration
+	24, // 11: dinkurapi.v1.AfkSettings.poll_interval:type_name -> google.protobuf.Duration
+	23, // 12: dinkurapi.v1.GetAfkPeriodListRequest.start:type_name -> google.protobuf.Timestamp
+	23, // 13: dinkurapi.v1.GetAfkPeriodListRequest.end:type_name -> google.protobuf.Timestamp
+	25, // 14: dinkurapi.v1.GetAfkPeriodListRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
+	21, // 15: dinkurapi.v1.GetAfkPeriodListResponse.afk_periods:type_name -> dinkurapi.v1.AfkPeriod
+	0,  // 16: dinkurapi.v1.ResolveAfkRequest.resolution:type_name -> dinkurapi.v1.AfkResolution
+	19, // 17: dinkurapi.v1.ResolveAfkRequest.new_entries:type_name -> dinkurapi.v1.AfkNewEntry
+	23, // 18: dinkurapi.v1.AfkNewEntry.start:type_name -> google.protobuf.Timestamp
+	23, // 19: dinkurapi.v1.AfkNewEntry.end:type_name -> google.protobuf.Timestamp
+	26, // 20: dinkurapi.v1.ResolveAfkResponse.edited_before:type_name -> dinkurapi.v1.Entry
+	26, // 21: dinkurapi.v1.ResolveAfkResponse.edited_after:type_name -> dinkurapi.v1.Entry
+	26, // 22: dinkurapi.v1.ResolveAfkResponse.created:type_name -> dinkurapi.v1.Entry
+	23, // 23: dinkurapi.v1.AfkPeriod.created:type_name -> google.protobuf.Timestamp
+	23, // 24: dinkurapi.v1.AfkPeriod.updated:type_name -> google.protobuf.Timestamp
+	23, // 25: dinkurapi.v1.AfkPeriod.start:type_name -> google.protobuf.Timestamp
+	23, // 26: dinkurapi.v1.AfkPeriod.end:type_name -> google.protobuf.Timestamp
+	0,  // 27: dinkurapi.v1.AfkPeriod.resolution:type_name -> dinkurapi.v1.AfkResolution
+	23, // 28: dinkurapi.v1.Status.created:type_name -> google.protobuf.Timestamp
+	23, // 29: dinkurapi.v1.Status.updated:type_name -> google.protobuf.Timestamp
+	23, // 30: dinkurapi.v1.Status.afk_since:type_name -> google.protobuf.Timestamp
+	23, // 31: dinkurapi.v1.Status.back_since:type_name -> google.protobuf.Timestamp
+	23, // 32: dinkurapi.v1.Status.heartbeat:type_name -> google.protobuf.Timestamp
+	23, // 33: dinkurapi.v1.Status.not_tracking_since:type_name -> google.protobuf.Timestamp
+	1,  // 34: dinkurapi.v1.Statuses.StreamStatus:input_type -> dinkurapi.v1.StreamStatusRequest
+	3,  // 35: dinkurapi.v1.Statuses.SetStatus:input_type -> dinkurapi.v1.SetStatusRequest
+	5,  // 36: dinkurapi.v1.Statuses.GetStatus:input_type -> dinkurapi.v1.GetStatusRequest
+	7,  // 37: dinkurapi.v1.Statuses.SetHeartbeat:input_type -> dinkurapi.v1.SetHeartbeatRequest
+	9,  // 38: dinkurapi.v1.Statuses.SetNotTracking:input_type -> dinkurapi.v1.SetNotTrackingRequest
+	11, // 39: dinkurapi.v1.Statuses.GetAfkSettings:input_type -> dinkurapi.v1.GetAfkSettingsRequest
+	13, // 40: dinkurapi.v1.Statuses.UpdateAfkSettings:input_type -> dinkurapi.v1.UpdateAfkSettingsRequest
+	16, // 41: dinkurapi.v1.Statuses.GetAfkPeriodList:input_type -> dinkurapi.v1.GetAfkPeriodListRequest
+	18, // 42: dinkurapi.v1.Statuses.ResolveAfk:input_type -> dinkurapi.v1.ResolveAfkRequest
+	2,  // 43: dinkurapi.v1.Statuses.StreamStatus:output_type -> dinkurapi.v1.StreamStatusResponse
+	4,  // 44: dinkurapi.v1.Statuses.SetStatus:output_type -> dinkurapi.v1.SetStatusResponse
+	6,  // 45: dinkurapi.v1.Statuses.GetStatus:output_type -> dinkurapi.v1.GetStatusResponse
+	8,  // 46: dinkurapi.v1.Statuses.SetHeartbeat:output_type -> dinkurapi.v1.SetHeartbeatResponse
+	10, // 47: dinkurapi.v1.Statuses.SetNotTracking:output_type -> dinkurapi.v1.SetNotTrackingResponse
+	12, // 48: dinkurapi.v1.Statuses.GetAfkSettings:output_type -> dinkurapi.v1.GetAfkSettingsResponse
+	14, // 49: dinkurapi.v1.Statuses.UpdateAfkSettings:output_type -> dinkurapi.v1.UpdateAfkSettingsResponse
+	17, // 50: dinkurapi.v1.Statuses.GetAfkPeriodList:output_type -> dinkurapi.v1.GetAfkPeriodListResponse
+	20, // 51: dinkurapi.v1.Statuses.ResolveAfk:output_type -> dinkurapi.v1.ResolveAfkResponse
+	43, // [43:52] is the sub-list for method output_type
+	34, // [34:43] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_api_dinkurapi_v1_statuses_proto_init() }
+func file_api_dinkurapi_v1_statuses_proto_init() {
+	if File_api_dinkurapi_v1_statuses_proto != nil {
+		return
+	}
+	file_api_dinkurapi_v1_entries_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStatusResponse); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -588,6 +1919,186 @@ func file_api_dinkurapi_v1_statuses_proto_init() {
 			}
 		}
 		file_api_dinkurapi_v1_statuses_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetHeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetHeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotTrackingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotTrackingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAfkSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAfkSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAfkSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAfkSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AfkSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAfkPeriodListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAfkPeriodListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveAfkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AfkNewEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveAfkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AfkPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_statuses_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -605,13 +2116,14 @@ func file_api_dinkurapi_v1_statuses_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_dinkurapi_v1_statuses_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_dinkurapi_v1_statuses_proto_goTypes,
 		DependencyIndexes: file_api_dinkurapi_v1_statuses_proto_depIdxs,
+		EnumInfos:         file_api_dinkurapi_v1_statuses_proto_enumTypes,
 		MessageInfos:      file_api_dinkurapi_v1_statuses_proto_msgTypes,
 	}.Build()
 	File_api_dinkurapi_v1_statuses_proto = out.File
@@ -24,6 +24,28 @@ type StatusesClient interface {
 	SetStatus(ctx context.Context, in *SetStatusRequest, opts ...grpc.CallOption) (*SetStatusResponse, error)
 	// GetStatus gets the current status.
 	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	// SetHeartbeat updates the timestamp of when the Dinkur daemon was last
+	// known to be running. This does not emit any status change events.
+	SetHeartbeat(ctx context.Context, in *SetHeartbeatRequest, opts ...grpc.CallOption) (*SetHeartbeatResponse, error)
+	// SetNotTracking updates whether the user is at the keyboard without
+	// tracking any entry. A status change event is emitted if the status
+	// changed.
+	SetNotTracking(ctx context.Context, in *SetNotTrackingRequest, opts ...grpc.CallOption) (*SetNotTrackingResponse, error)
+	// GetAfkSettings gets the settings used by the Dinkur daemon's AFK
+	// detection.
+	GetAfkSettings(ctx context.Context, in *GetAfkSettingsRequest, opts ...grpc.CallOption) (*GetAfkSettingsResponse, error)
+	// UpdateAfkSettings changes the settings used by the Dinkur daemon's AFK
+	// detection. The changes are applied immediately, but are not persisted and
+	// will be reset when the daemon restarts. Status 3 "INVALID_ARGUMENT" is
+	// reported if any of the settings are invalid.
+	UpdateAfkSettings(ctx context.Context, in *UpdateAfkSettingsRequest, opts ...grpc.CallOption) (*UpdateAfkSettingsResponse, error)
+	// GetAfkPeriodList queries for a list of historical AFK periods.
+	GetAfkPeriodList(ctx context.Context, in *GetAfkPeriodListRequest, opts ...grpc.CallOption) (*GetAfkPeriodListResponse, error)
+	// ResolveAfk resolves the current AFK status by atomically ending the active
+	// entry when the user went AFK, creating any new entries for the time spent
+	// away, and clearing the AFK status. Status 9 "FAILED_PRECONDITION" is
+	// reported if the user is not AFK.
+	ResolveAfk(ctx context.Context, in *ResolveAfkRequest, opts ...grpc.CallOption) (*ResolveAfkResponse, error)
 }
 
 type statusesClient struct {
@@ -84,6 +106,60 @@ func (c *statusesClient) GetStatus(ctx context.Context, in *GetStatusRequest, op
 	return out, nil
 }
 
+func (c *statusesClient) SetHeartbeat(ctx context.Context, in *SetHeartbeatRequest, opts ...grpc.CallOption) (*SetHeartbeatResponse, error) {
+	out := new(SetHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/SetHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusesClient) SetNotTracking(ctx context.Context, in *SetNotTrackingRequest, opts ...grpc.CallOption) (*SetNotTrackingResponse, error) {
+	out := new(SetNotTrackingResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/SetNotTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusesClient) GetAfkSettings(ctx context.Context, in *GetAfkSettingsRequest, opts ...grpc.CallOption) (*GetAfkSettingsResponse, error) {
+	out := new(GetAfkSettingsResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/GetAfkSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusesClient) UpdateAfkSettings(ctx context.Context, in *UpdateAfkSettingsRequest, opts ...grpc.CallOption) (*UpdateAfkSettingsResponse, error) {
+	out := new(UpdateAfkSettingsResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/UpdateAfkSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusesClient) GetAfkPeriodList(ctx context.Context, in *GetAfkPeriodListRequest, opts ...grpc.CallOption) (*GetAfkPeriodListResponse, error) {
+	out := new(GetAfkPeriodListResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/GetAfkPeriodList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusesClient) ResolveAfk(ctx context.Context, in *ResolveAfkRequest, opts ...grpc.CallOption) (*ResolveAfkResponse, error) {
+	out := new(ResolveAfkResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Statuses/ResolveAfk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatusesServer is the server API for Statuses service.
 // All implementations must embed UnimplementedStatusesServer
 // for forward compatibility
@@ -94,6 +170,28 @@ type StatusesServer interface {
 	SetStatus(context.Context, *SetStatusRequest) (*SetStatusResponse, error)
 	// GetStatus gets the current status.
 	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	// SetHeartbeat updates the timestamp of when the Dinkur daemon was last
+	// known to be running. This does not emit any status change events.
+	SetHeartbeat(context.Context, *SetHeartbeatRequest) (*SetHeartbeatResponse, error)
+	// SetNotTracking updates whether the user is at the keyboard without
+	// tracking any entry. A status change event is emitted if the status
+	// changed.
+	SetNotTracking(context.Context, *SetNotTrackingRequest) (*SetNotTrackingResponse, error)
+	// GetAfkSettings gets the settings used by the Dinkur daemon's AFK
+	// detection.
+	GetAfkSettings(context.Context, *GetAfkSettingsRequest) (*GetAfkSettingsResponse, error)
+	// UpdateAfkSettings changes the settings used by the Dinkur daemon's AFK
+	// detection. The changes are applied immediately, but are not persisted and
+	// will be reset when the daemon restarts. Status 3 "INVALID_ARGUMENT" is
+	// reported if any of the settings are invalid.
+	UpdateAfkSettings(context.Context, *UpdateAfkSettingsRequest) (*UpdateAfkSettingsResponse, error)
+	// GetAfkPeriodList queries for a list of historical AFK periods.
+	GetAfkPeriodList(context.Context, *GetAfkPeriodListRequest) (*GetAfkPeriodListResponse, error)
+	// ResolveAfk resolves the current AFK status by atomically ending the active
+	// entry when the user went AFK, creating any new entries for the time spent
+	// away, and clearing the AFK status. Status 9 "FAILED_PRECONDITION" is
+	// reported if the user is not AFK.
+	ResolveAfk(context.Context, *ResolveAfkRequest) (*ResolveAfkResponse, error)
 	mustEmbedUnimplementedStatusesServer()
 }
 
@@ -110,6 +208,24 @@ func (UnimplementedStatusesServer) SetStatus(context.Context, *SetStatusRequest)
 func (UnimplementedStatusesServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
+func (UnimplementedStatusesServer) SetHeartbeat(context.Context, *SetHeartbeatRequest) (*SetHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHeartbeat not implemented")
+}
+func (UnimplementedStatusesServer) SetNotTracking(context.Context, *SetNotTrackingRequest) (*SetNotTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotTracking not implemented")
+}
+func (UnimplementedStatusesServer) GetAfkSettings(context.Context, *GetAfkSettingsRequest) (*GetAfkSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAfkSettings not implemented")
+}
+func (UnimplementedStatusesServer) UpdateAfkSettings(context.Context, *UpdateAfkSettingsRequest) (*UpdateAfkSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAfkSettings not implemented")
+}
+func (UnimplementedStatusesServer) GetAfkPeriodList(context.Context, *GetAfkPeriodListRequest) (*GetAfkPeriodListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAfkPeriodList not implemented")
+}
+func (UnimplementedStatusesServer) ResolveAfk(context.Context, *ResolveAfkRequest) (*ResolveAfkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAfk not implemented")
+}
 func (UnimplementedStatusesServer) mustEmbedUnimplementedStatusesServer() {}
 
 // UnsafeStatusesServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +296,114 @@ func _Statuses_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Statuses_SetHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).SetHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/SetHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).SetHeartbeat(ctx, req.(*SetHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statuses_SetNotTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).SetNotTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/SetNotTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).SetNotTracking(ctx, req.(*SetNotTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statuses_GetAfkSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAfkSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).GetAfkSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/GetAfkSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).GetAfkSettings(ctx, req.(*GetAfkSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statuses_UpdateAfkSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAfkSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).UpdateAfkSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/UpdateAfkSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).UpdateAfkSettings(ctx, req.(*UpdateAfkSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statuses_GetAfkPeriodList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAfkPeriodListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).GetAfkPeriodList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/GetAfkPeriodList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).GetAfkPeriodList(ctx, req.(*GetAfkPeriodListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Statuses_ResolveAfk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAfkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusesServer).ResolveAfk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Statuses/ResolveAfk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusesServer).ResolveAfk(ctx, req.(*ResolveAfkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Statuses_ServiceDesc is the grpc.ServiceDesc for Statuses service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +419,30 @@ var Statuses_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _Statuses_GetStatus_Handler,
 		},
+		{
+			MethodName: "SetHeartbeat",
+			Handler:    _Statuses_SetHeartbeat_Handler,
+		},
+		{
+			MethodName: "SetNotTracking",
+			Handler:    _Statuses_SetNotTracking_Handler,
+		},
+		{
+			MethodName: "GetAfkSettings",
+			Handler:    _Statuses_GetAfkSettings_Handler,
+		},
+		{
+			MethodName: "UpdateAfkSettings",
+			Handler:    _Statuses_UpdateAfkSettings_Handler,
+		},
+		{
+			MethodName: "GetAfkPeriodList",
+			Handler:    _Statuses_GetAfkPeriodList_Handler,
+		},
+		{
+			MethodName: "ResolveAfk",
+			Handler:    _Statuses_ResolveAfk_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,759 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.21.2
+// source: api/dinkurapi/v1/sync.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntryChangeField is an enumeration of which entry field a change applies to.
+type EntryChangeField int32
+
+const (
+	// ENTRY_CHANGE_FIELD_UNSPECIFIED means the field is unknown.
+	EntryChangeField_ENTRY_CHANGE_FIELD_UNSPECIFIED EntryChangeField = 0
+	// ENTRY_CHANGE_FIELD_NAME means the change sets the entry's name.
+	EntryChangeField_ENTRY_CHANGE_FIELD_NAME EntryChangeField = 1
+	// ENTRY_CHANGE_FIELD_START means the change sets the entry's start time.
+	EntryChangeField_ENTRY_CHANGE_FIELD_START EntryChangeField = 2
+	// ENTRY_CHANGE_FIELD_END means the change sets the entry's end time.
+	EntryChangeField_ENTRY_CHANGE_FIELD_END EntryChangeField = 3
+	// ENTRY_CHANGE_FIELD_DELETED means the change deletes the entry.
+	EntryChangeField_ENTRY_CHANGE_FIELD_DELETED EntryChangeField = 4
+	// ENTRY_CHANGE_FIELD_CONTINUES means the change sets the UUID of the entry
+	// that the entry continues.
+	EntryChangeField_ENTRY_CHANGE_FIELD_CONTINUES EntryChangeField = 5
+)
+
+// Enum value maps for EntryChangeField.
+var (
+	EntryChangeField_name = map[int32]string{
+		0: "ENTRY_CHANGE_FIELD_UNSPECIFIED",
+		1: "ENTRY_CHANGE_FIELD_NAME",
+		2: "ENTRY_CHANGE_FIELD_START",
+		3: "ENTRY_CHANGE_FIELD_END",
+		4: "ENTRY_CHANGE_FIELD_DELETED",
+		5: "ENTRY_CHANGE_FIELD_CONTINUES",
+	}
+	EntryChangeField_value = map[string]int32{
+		"ENTRY_CHANGE_FIELD_UNSPECIFIED": 0,
+		"ENTRY_CHANGE_FIELD_NAME":        1,
+		"ENTRY_CHANGE_FIELD_START":       2,
+		"ENTRY_CHANGE_FIELD_END":         3,
+		"ENTRY_CHANGE_FIELD_DELETED":     4,
+		"ENTRY_CHANGE_FIELD_CONTINUES":   5,
+	}
+)
+
+func (x EntryChangeField) Enum() *EntryChangeField {
+	p := new(EntryChangeField)
+	*p = x
+	return p
+}
+
+func (x EntryChangeField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntryChangeField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_sync_proto_enumTypes[0].Descriptor()
+}
+
+func (EntryChangeField) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_sync_proto_enumTypes[0]
+}
+
+func (x EntryChangeField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntryChangeField.Descriptor instead.
+func (EntryChangeField) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+// GetSyncNodeRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type GetSyncNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSyncNodeRequest) Reset() {
+	*x = GetSyncNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncNodeRequest) ProtoMessage() {}
+
+func (x *GetSyncNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncNodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+// GetSyncNodeResponse holds the sync identity of the database.
+type GetSyncNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NodeId is the globally unique identifier of the database.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Clock holds the sequence number of the latest change known by the
+	// database from each node, keyed by node ID.
+	Clock map[string]uint64 `protobuf:"bytes,2,rep,name=clock,proto3" json:"clock,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetSyncNodeResponse) Reset() {
+	*x = GetSyncNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncNodeResponse) ProtoMessage() {}
+
+func (x *GetSyncNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncNodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSyncNodeResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetSyncNodeResponse) GetClock() map[string]uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+// GetSyncChangesRequest holds the vector clock to get changes since.
+type GetSyncChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Since holds the sequence number of the latest change known from each
+	// node, keyed by node ID. Changes from nodes not in this map are all
+	// included.
+	Since map[string]uint64 `protobuf:"bytes,1,rep,name=since,proto3" json:"since,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Limit is the number of changes to include in the results. A value of zero
+	// means no limit is applied. The limit is applied at the start of the
+	// results, so the next page of changes can be gotten by advancing the since
+	// vector clock using the sequence numbers of the returned changes.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetSyncChangesRequest) Reset() {
+	*x = GetSyncChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncChangesRequest) ProtoMessage() {}
+
+func (x *GetSyncChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncChangesRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSyncChangesRequest) GetSince() map[string]uint64 {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetSyncChangesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetSyncChangesResponse holds the changes newer than the requested vector
+// clock.
+type GetSyncChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Changes are the entry changes, ordered by node ID and sequence number.
+	Changes []*EntryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetSyncChangesResponse) Reset() {
+	*x = GetSyncChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncChangesResponse) ProtoMessage() {}
+
+func (x *GetSyncChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncChangesResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSyncChangesResponse) GetChanges() []*EntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ApplySyncChangesRequest holds the changes to add.
+type ApplySyncChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Changes are the entry changes to add.
+	Changes []*EntryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ApplySyncChangesRequest) Reset() {
+	*x = ApplySyncChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySyncChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySyncChangesRequest) ProtoMessage() {}
+
+func (x *ApplySyncChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySyncChangesRequest.ProtoReflect.Descriptor instead.
+func (*ApplySyncChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApplySyncChangesRequest) GetChanges() []*EntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ApplySyncChangesResponse is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type ApplySyncChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplySyncChangesResponse) Reset() {
+	*x = ApplySyncChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySyncChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySyncChangesResponse) ProtoMessage() {}
+
+func (x *ApplySyncChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySyncChangesResponse.ProtoReflect.Descriptor instead.
+func (*ApplySyncChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{5}
+}
+
+// EntryChange is a single field change to an entry.
+type EntryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntryUuid is the globally unique identifier of the entry that was changed.
+	EntryUuid string `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// NodeId is the ID of the database that made the change.
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Seq is the sequence number of the change, incremented per node.
+	Seq uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Timestamp is when the change was made, and is used to find the last
+	// write of each field.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Field is the entry field that was changed.
+	Field EntryChangeField `protobuf:"varint,5,opt,name=field,proto3,enum=dinkurapi.v1.EntryChangeField" json:"field,omitempty"`
+	// Value is the new value of the field. Times are encoded using RFC 3339
+	// with nanoseconds, where an empty string means unset. Deletions hold the
+	// JSON-encoded vector clock of the entry's changes that were observed when
+	// the entry was deleted.
+	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EntryChange) Reset() {
+	*x = EntryChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryChange) ProtoMessage() {}
+
+func (x *EntryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_sync_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryChange.ProtoReflect.Descriptor instead.
+func (*EntryChange) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_sync_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EntryChange) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *EntryChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *EntryChange) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *EntryChange) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EntryChange) GetField() EntryChangeField {
+	if x != nil {
+		return x.Field
+	}
+	return EntryChangeField_ENTRY_CHANGE_FIELD_UNSPECIFIED
+}
+
+func (x *EntryChange) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_dinkurapi_v1_sync_proto protoreflect.FileDescriptor
+
+var file_api_dinkurapi_v1_sync_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x4e, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x1a, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x01, 0x0a,
+	0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x34, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xcf, 0x01, 0x0a,
+	0x10, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a,
+	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c,
+	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x53, 0x10, 0x05, 0x32, 0x9a,
+	0x02, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_dinkurapi_v1_sync_proto_rawDescOnce sync.Once
+	file_api_dinkurapi_v1_sync_proto_rawDescData = file_api_dinkurapi_v1_sync_proto_rawDesc
+)
+
+func file_api_dinkurapi_v1_sync_proto_rawDescGZIP() []byte {
+	file_api_dinkurapi_v1_sync_proto_rawDescOnce.Do(func() {
+		file_api_dinkurapi_v1_sync_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_dinkurapi_v1_sync_proto_rawDescData)
+	})
+	return file_api_dinkurapi_v1_sync_proto_rawDescData
+}
+
+var file_api_dinkurapi_v1_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_dinkurapi_v1_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_dinkurapi_v1_sync_proto_goTypes = []interface{}{
+	(EntryChangeField)(0),            // 0: dinkurapi.v1.EntryChangeField
+	(*GetSyncNodeRequest)(nil),       // 1: dinkurapi.v1.GetSyncNodeRequest
+	(*GetSyncNodeResponse)(nil),      // 2: dinkurapi.v1.GetSyncNodeResponse
+	(*GetSyncChangesRequest)(nil),    // 3: dinkurapi.v1.GetSyncChangesRequest
+	(*GetSyncChangesResponse)(nil),   // 4: dinkurapi.v1.GetSyncChangesResponse
+	(*ApplySyncChangesRequest)(nil),  // 5: dinkurapi.v1.ApplySyncChangesRequest
+	(*ApplySyncChangesResponse)(nil), // 6: dinkurapi.v1.ApplySyncChangesResponse
+	(*EntryChange)(nil),              // 7: dinkurapi.v1.EntryChange
+	nil,                              // 8: dinkurapi.v1.GetSyncNodeResponse.ClockEntry
+	nil,                              // 9: dinkurapi.v1.GetSyncChangesRequest.SinceEntry
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+}
+var file_api_dinkurapi_v1_sync_proto_depIdxs = []int32{
+	8,  // 0: dinkurapi.v1.GetSyncNodeResponse.clock:type_name -> dinkurapi.v1.GetSyncNodeResponse.ClockEntry
+	9,  // 1: dinkurapi.v1.GetSyncChangesRequest.since:type_name -> dinkurapi.v1.GetSyncChangesRequest.SinceEntry
+	7,  // 2: dinkurapi.v1.GetSyncChangesResponse.changes:type_name -> dinkurapi.v1.EntryChange
+	7,  // 3: dinkurapi.v1.ApplySyncChangesRequest.changes:type_name -> dinkurapi.v1.EntryChange
+	10, // 4: dinkurapi.v1.EntryChange.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 5: dinkurapi.v1.EntryChange.field:type_name -> dinkurapi.v1.EntryChangeField
+	1,  // 6: dinkurapi.v1.Sync.GetSyncNode:input_type -> dinkurapi.v1.GetSyncNodeRequest
+	3,  // 7: dinkurapi.v1.Sync.GetSyncChanges:input_type -> dinkurapi.v1.GetSyncChangesRequest
+	5,  // 8: dinkurapi.v1.Sync.ApplySyncChanges:input_type -> dinkurapi.v1.ApplySyncChangesRequest
+	2,  // 9: dinkurapi.v1.Sync.GetSyncNode:output_type -> dinkurapi.v1.GetSyncNodeResponse
+	4,  // 10: dinkurapi.v1.Sync.GetSyncChanges:output_type -> dinkurapi.v1.GetSyncChangesResponse
+	6,  // 11: dinkurapi.v1.Sync.ApplySyncChanges:output_type -> dinkurapi.v1.ApplySyncChangesResponse
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_dinkurapi_v1_sync_proto_init() }
+func file_api_dinkurapi_v1_sync_proto_init() {
+	if File_api_dinkurapi_v1_sync_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_dinkurapi_v1_sync_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncNodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySyncChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySyncChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_sync_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_dinkurapi_v1_sync_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_dinkurapi_v1_sync_proto_goTypes,
+		DependencyIndexes: file_api_dinkurapi_v1_sync_proto_depIdxs,
+		EnumInfos:         file_api_dinkurapi_v1_sync_proto_enumTypes,
+		MessageInfos:      file_api_dinkurapi_v1_sync_proto_msgTypes,
+	}.Build()
+	File_api_dinkurapi_v1_sync_proto = out.File
+	file_api_dinkurapi_v1_sync_proto_rawDesc = nil
+	file_api_dinkurapi_v1_sync_proto_goTypes = nil
+	file_api_dinkurapi_v1_sync_proto_depIdxs = nil
+}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SyncClient is the client API for Sync service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SyncClient interface {
+	// GetSyncNode gets the sync identity of the database, together with its
+	// vector clock of known changes.
+	GetSyncNode(ctx context.Context, in *GetSyncNodeRequest, opts ...grpc.CallOption) (*GetSyncNodeResponse, error)
+	// GetSyncChanges gets the changes that are newer than the given vector
+	// clock. Large sets of changes should be gotten in pages using the limit,
+	// to stay below the gRPC message size limit.
+	GetSyncChanges(ctx context.Context, in *GetSyncChangesRequest, opts ...grpc.CallOption) (*GetSyncChangesResponse, error)
+	// ApplySyncChanges adds changes from another database and updates the
+	// entries accordingly. Already known changes are ignored. Status 3
+	// "INVALID_ARGUMENT" is reported if any of the changes are invalid.
+	ApplySyncChanges(ctx context.Context, in *ApplySyncChangesRequest, opts ...grpc.CallOption) (*ApplySyncChangesResponse, error)
+}
+
+type syncClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncClient(cc grpc.ClientConnInterface) SyncClient {
+	return &syncClient{cc}
+}
+
+func (c *syncClient) GetSyncNode(ctx context.Context, in *GetSyncNodeRequest, opts ...grpc.CallOption) (*GetSyncNodeResponse, error) {
+	out := new(GetSyncNodeResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Sync/GetSyncNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) GetSyncChanges(ctx context.Context, in *GetSyncChangesRequest, opts ...grpc.CallOption) (*GetSyncChangesResponse, error) {
+	out := new(GetSyncChangesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Sync/GetSyncChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncClient) ApplySyncChanges(ctx context.Context, in *ApplySyncChangesRequest, opts ...grpc.CallOption) (*ApplySyncChangesResponse, error) {
+	out := new(ApplySyncChangesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Sync/ApplySyncChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncServer is the server API for Sync service.
+// All implementations must embed UnimplementedSyncServer
+// for forward compatibility
+type SyncServer interface {
+	// GetSyncNode gets the sync identity of the database, together with its
+	// vector clock of known changes.
+	GetSyncNode(context.Context, *GetSyncNodeRequest) (*GetSyncNodeResponse, error)
+	// GetSyncChanges gets the changes that are newer than the given vector
+	// clock. Large sets of changes should be gotten in pages using the limit,
+	// to stay below the gRPC message size limit.
+	GetSyncChanges(context.Context, *GetSyncChangesRequest) (*GetSyncChangesResponse, error)
+	// ApplySyncChanges adds changes from another database and updates the
+	// entries accordingly. Already known changes are ignored. Status 3
+	// "INVALID_ARGUMENT" is reported if any of the changes are invalid.
+	ApplySyncChanges(context.Context, *ApplySyncChangesRequest) (*ApplySyncChangesResponse, error)
+	mustEmbedUnimplementedSyncServer()
+}
+
+// UnimplementedSyncServer must be embedded to have forward compatible implementations.
+type UnimplementedSyncServer struct {
+}
+
+func (UnimplementedSyncServer) GetSyncNode(context.Context, *GetSyncNodeRequest) (*GetSyncNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncNode not implemented")
+}
+func (UnimplementedSyncServer) GetSyncChanges(context.Context, *GetSyncChangesRequest) (*GetSyncChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncChanges not implemented")
+}
+func (UnimplementedSyncServer) ApplySyncChanges(context.Context, *ApplySyncChangesRequest) (*ApplySyncChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySyncChanges not implemented")
+}
+func (UnimplementedSyncServer) mustEmbedUnimplementedSyncServer() {}
+
+// UnsafeSyncServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServer will
+// result in compilation errors.
+type UnsafeSyncServer interface {
+	mustEmbedUnimplementedSyncServer()
+}
+
+func RegisterSyncServer(s grpc.ServiceRegistrar, srv SyncServer) {
+	s.RegisterService(&Sync_ServiceDesc, srv)
+}
+
+func _Sync_GetSyncNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).GetSyncNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Sync/GetSyncNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).GetSyncNode(ctx, req.(*GetSyncNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_GetSyncChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).GetSyncChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Sync/GetSyncChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).GetSyncChanges(ctx, req.(*GetSyncChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sync_ApplySyncChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySyncChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).ApplySyncChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Sync/ApplySyncChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).ApplySyncChanges(ctx, req.(*ApplySyncChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sync_ServiceDesc is the grpc.ServiceDesc for Sync service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sync_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dinkurapi.v1.Sync",
+	HandlerType: (*SyncServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncNode",
+			Handler:    _Sync_GetSyncNode_Handler,
+		},
+		{
+			MethodName: "GetSyncChanges",
+			Handler:    _Sync_GetSyncChanges_Handler,
+		},
+		{
+			MethodName: "ApplySyncChanges",
+			Handler:    _Sync_ApplySyncChanges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/dinkurapi/v1/sync.proto",
+}
@@ -0,0 +1,42 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// awayCmd represents the away command
+var awayCmd = &cobra.Command{
+	Use:     "away",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"afk"},
+	Short:   "Inspect the times you were away from keyboard",
+	Long: `Inspect the times you were away from keyboard (AFK).
+
+The Dinkur daemon detects when you are AFK while having an active entry, and
+stores each such period in the database, together with which AFK detector
+hook that detected it and how you later resolved the time spent away.`,
+}
+
+func init() {
+	RootCmd.AddCommand(awayCmd)
+}
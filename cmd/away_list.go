@@ -0,0 +1,111 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagLimit  uint = 1000
+		flagStart       = &pflagutil.Time{}
+		flagEnd         = &pflagutil.Time{}
+		flagRange       = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
+		flagOutput      = "pretty"
+	)
+
+	var awayListCmd = &cobra.Command{
+		Use:     "list",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls", "l"},
+		Short:   "List the times you were away from keyboard",
+		Long: fmt.Sprintf(`Lists the periods you were away from keyboard (AFK).
+
+By default, this will only list today's AFK periods. You can supply the --range
+flag to declare a different baseline range, using the same values as the
+"%[1]s list" command. The --start and --end flags will always take precedence
+over the baseline range.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			now := time.Now()
+			search := dinkur.SearchAFKPeriod{
+				Limit:          flagLimit,
+				Start:          flagStart.TimePtr(now),
+				End:            flagEnd.TimePtr(now),
+				Shorthand:      flagRange.TimeSpanShorthand(),
+				ShorthandCount: flagRange.Count(),
+			}
+			periods, err := c.GetAFKPeriodList(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting list of AFK periods:", err)
+			}
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintAFKPeriodList(periods)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(periods); err != nil {
+					console.PrintFatal("Error encoding AFK periods as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(periods); err != nil {
+					console.PrintFatal("Error encoding AFK periods as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	awayCmd.AddCommand(awayListCmd)
+
+	awayListCmd.Flags().UintVarP(&flagLimit, "limit", "l", flagLimit, "limit the number of results, relative to the last result; 0 will disable limit")
+	awayListCmd.Flags().VarP(flagStart, "start", "s", "list AFK periods ending after or at date time")
+	awayListCmd.Flags().VarP(flagEnd, "end", "e", "list AFK periods starting before or at date time")
+	awayListCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
+	awayListCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	awayListCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+	awayListCmd.RegisterFlagCompletionFunc("output", awayOutputFormatComplete)
+}
+
+func awayOutputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"pretty\thuman readable and colored table formatting (default)",
+		"json\ta single indented JSON array containing all AFK periods",
+		"yaml\tYAML array of AFK periods",
+	}, cobra.ShellCompDirectiveDefault
+}
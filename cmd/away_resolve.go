@@ -0,0 +1,94 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagKeep     bool
+		flagDiscard  bool
+		flagNewEntry string
+	)
+
+	var awayResolveCmd = &cobra.Command{
+		Use:   "resolve",
+		Args:  cobra.NoArgs,
+		Short: "Resolve your AFK status without prompting",
+		Long: `Resolves your current AFK (away from keyboard) status without prompting,
+which is useful from scripts and other non-interactive environments.
+
+Exactly one of the flags must be given:
+
+	--keep             keep the time spent away in the active entry.
+	--discard          discard the time spent away, by ending the active entry
+	                   when you went AFK.
+	--new-entry NAME   save the time spent away as a new active entry, and end
+	                   the previously active entry when you went AFK.
+
+All changes are applied atomically.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			resolve := dinkur.ResolveAFK{}
+			switch {
+			case flagKeep:
+				resolve.Resolution = dinkur.AFKResolutionKeep
+			case flagDiscard:
+				resolve.Resolution = dinkur.AFKResolutionDiscard
+			case cmd.Flags().Changed("new-entry"):
+				resolve.Resolution = dinkur.AFKResolutionNewEntry
+				resolve.NewEntries = []dinkur.NewEntry{{Name: flagNewEntry}}
+			default:
+				console.PrintFatal("Error parsing flags:", "one of --keep, --discard, or --new-entry must be set")
+			}
+			connectClientOrExitNoAFKCheck()
+			resolved, err := c.ResolveAFK(rootCtx, resolve)
+			if err != nil {
+				console.PrintFatal("Error resolving AFK status:", err)
+			}
+			printResolvedAFK(resolved)
+			fmt.Println("Resolved AFK status.")
+		},
+	}
+
+	awayCmd.AddCommand(awayResolveCmd)
+
+	awayResolveCmd.Flags().BoolVar(&flagKeep, "keep", false, "keep the time spent away in the active entry")
+	awayResolveCmd.Flags().BoolVar(&flagDiscard, "discard", false, "discard the time spent away from the active entry")
+	awayResolveCmd.Flags().StringVar(&flagNewEntry, "new-entry", "", "save the time spent away as a new entry with this name")
+	awayResolveCmd.MarkFlagsMutuallyExclusive("keep", "discard", "new-entry")
+}
+
+func printResolvedAFK(resolved dinkur.ResolvedAFK) {
+	if resolved.Edited != nil {
+		console.PrintEntryEdit(*resolved.Edited)
+		fmt.Println()
+	}
+	for _, entry := range resolved.Created {
+		printStartedEntry(dinkur.StartedEntry{Started: entry})
+		fmt.Println()
+	}
+}
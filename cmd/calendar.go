@@ -0,0 +1,114 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/viper"
+)
+
+// calendar is the calendar loaded from the config file, and is used when
+// resolving time ranges and when reporting workdays.
+var calendar = timeutil.DefaultCalendar
+
+func init() {
+	viper.SetDefault("calendar.firstWeekday", timeutil.DefaultCalendar.FirstWeekday.String())
+	defaultWorkdays := make([]string, len(timeutil.DefaultCalendar.Workdays))
+	for i, wd := range timeutil.DefaultCalendar.Workdays {
+		defaultWorkdays[i] = wd.String()
+	}
+	viper.SetDefault("calendar.workdays", defaultWorkdays)
+}
+
+// calendarFromConfig reads the calendar config, such as:
+//
+//	calendar:
+//	  firstWeekday: sunday
+//	  workdays: [monday, tuesday, wednesday, thursday, friday]
+//	  holidaysFile: holidays.txt
+//
+// The holidays file path is relative to the config file's directory. If no
+// workdays are configured, then the workdays of timeutil.DefaultCalendar are
+// used.
+func calendarFromConfig() (timeutil.Calendar, error) {
+	var cal timeutil.Calendar
+	var err error
+	cal.FirstWeekday, err = timeutil.ParseWeekday(viper.GetString("calendar.firstWeekday"))
+	if err != nil {
+		return timeutil.Calendar{}, fmt.Errorf("calendar.firstWeekday: %w", err)
+	}
+	for _, name := range viper.GetStringSlice("calendar.workdays") {
+		wd, err := timeutil.ParseWeekday(name)
+		if err != nil {
+			return timeutil.Calendar{}, fmt.Errorf("calendar.workdays: %w", err)
+		}
+		cal.Workdays = append(cal.Workdays, wd)
+	}
+	if len(cal.Workdays) == 0 {
+		// same fallback as the daemon uses, instead of treating every day
+		// as a day off
+		cal.Workdays = timeutil.DefaultCalendar.Workdays
+	}
+	if path := viper.GetString("calendar.holidaysFile"); path != "" {
+		cal.Holidays, err = readHolidaysFile(path)
+		if err != nil {
+			return timeutil.Calendar{}, fmt.Errorf("calendar.holidaysFile: %w", err)
+		}
+	}
+	return cal, nil
+}
+
+func readHolidaysFile(path string) ([]timeutil.Holiday, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(cfgFile), path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	holidays, err := timeutil.ReadHolidays(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return holidays, nil
+}
+
+// dayOffDescription returns "weekend" or the name of the holiday if the date
+// of the time is not a workday according to the calendar, or empty string if
+// it is a workday.
+func dayOffDescription(t time.Time) string {
+	if holiday, ok := calendar.Holiday(t); ok {
+		if holiday.Name == "" {
+			return "holiday"
+		}
+		return holiday.Name
+	}
+	if !calendar.IsWorkingWeekday(t.Weekday()) {
+		return "weekend"
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	flagColumns    = ""
+	flagTimeFormat = ""
+)
+
+// addEntryTableFlags adds the flags that changes how tables of entries are
+// printed. The flags take precedence over the "columns" and "timeFormat"
+// config keys, which are applied by initEntryTableConfig.
+func addEntryTableFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagColumns, "columns", flagColumns, fmt.Sprintf(`comma-separated list of columns to show, out of: %s`, entryColumnsString()))
+	cmd.RegisterFlagCompletionFunc("columns", entryColumnsComplete)
+	cmd.Flags().StringVar(&flagTimeFormat, "time-format", flagTimeFormat, `Go time layout used for times, such as "15:04:05" or "2006-01-02 15:04"`)
+}
+
+func initEntryTableConfig() error {
+	columns := flagColumns
+	if columns == "" {
+		// supports both YAML lists and comma-separated strings
+		columns = strings.Join(viper.GetStringSlice("columns"), ",")
+	}
+	if columns != "" {
+		cols, err := console.ParseEntryColumns(columns)
+		if err != nil {
+			return fmt.Errorf("columns: %w", err)
+		}
+		console.SetEntryColumns(cols)
+	}
+	timeFormat := flagTimeFormat
+	if timeFormat == "" {
+		timeFormat = viper.GetString("timeFormat")
+	}
+	console.SetTimeFormat(timeFormat)
+	return nil
+}
+
+func entryColumnsString() string {
+	names := make([]string, len(console.EntryColumns))
+	for i, col := range console.EntryColumns {
+		names[i] = string(col)
+	}
+	return strings.Join(names, ",")
+}
+
+func entryColumnsComplete(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var prefix string
+	if idx := strings.LastIndexByte(toComplete, ','); idx != -1 {
+		prefix = toComplete[:idx+1]
+	}
+	used := map[string]bool{}
+	for _, s := range strings.Split(prefix, ",") {
+		used[strings.TrimSpace(s)] = true
+	}
+	var completions []string
+	for _, col := range console.EntryColumns {
+		if !used[string(col)] {
+			completions = append(completions, prefix+string(col))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoSpace
+}
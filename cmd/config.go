@@ -0,0 +1,269 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/cfgedit"
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// configKeys are the known config keys, in the casing used in the docs.
+// Viper on the other hand matches keys case-insensitively.
+var configKeys = []struct {
+	key, description string
+}{
+	{"client", `Dinkur client: "db" or "grpc"`},
+	{"color", `colored output: "auto", "always", or "never"`},
+	{"data", "database file"},
+	{"dataMkdir", "create directory for data if it doesn't exist"},
+	{"grpcAddress", "address of Dinkur daemon gRPC API"},
+	{"grpcTLS", "use TLS when connecting to the Dinkur daemon gRPC API"},
+	{"grpcTLSCAFile", "certificate authority file used to verify the daemon's TLS certificate"},
+	{"locale", "locale used when parsing fuzzy times"},
+	{"verbose", "enables debug logging"},
+	{"profile", "named profile to use"},
+	{"profiles", "named profiles, overriding any other config keys"},
+	{"columns", "columns to show in tables of entries"},
+	{"timeFormat", "Go time layout used for times in tables"},
+	{"calendar.firstWeekday", "first day of the week"},
+	{"calendar.workdays", "days of the week that are workdays"},
+	{"calendar.holidaysFile", "file of holidays, one per line"},
+	{"pomodoro.work", "duration of each Pomodoro work period"},
+	{"pomodoro.break", "duration of the short Pomodoro breaks"},
+	{"pomodoro.longBreak", "duration of the long Pomodoro breaks"},
+	{"pomodoro.longBreakEvery", "number of Pomodoro work periods between each long break"},
+	{"pomodoro.breakEntry", "name of entry to track during Pomodoro breaks"},
+	{"sync.git.dir", "directory of text files used by the sync git command"},
+	{"daemon.host", "hostname to bind the daemon gRPC API to"},
+	{"daemon.port", "port to bind the daemon gRPC API to"},
+	{"daemon.tls.certFile", "PEM-encoded certificate file, to enable TLS"},
+	{"daemon.tls.keyFile", "PEM-encoded private key file, to enable TLS"},
+	{"daemon.mdns.enabled", "advertise the daemon on the local network via mDNS"},
+	{"daemon.mdns.interface", "network interface to advertise the daemon on"},
+	{"daemon.pairing.requireToken", "only allow paired daemons to connect from other computers, and only to sync"},
+	{"daemon.notifications.enabled", "send desktop notifications"},
+	{"daemon.notifications.longEntry", "notify when an entry has been active for this long"},
+	{"daemon.notifications.untracked", "notify when nothing has been tracked for this long"},
+	{"daemon.notifications.workHours.from", "start of working hours, used for untracked notifications"},
+	{"daemon.notifications.workHours.to", "end of working hours, used for untracked notifications"},
+	{"daemon.afk.threshold", "idle duration until considered AFK"},
+	{"daemon.afk.pollInterval", "how often to check for idle time"},
+	{"daemon.afk.allowHooks", "only use these AFK hooks"},
+	{"daemon.afk.denyHooks", "never use these AFK hooks"},
+	{"daemon.afk.rules", "rules to automatically resolve AFK periods"},
+	{"daemon.notTracking.threshold", "duration at the keyboard without an active entry until status is \"not tracking\""},
+}
+
+func init() {
+	var configCmd = &cobra.Command{
+		Use:   "config",
+		Short: "Read and edit the config file",
+		Long: fmt.Sprintf(`Read and edit the config file.
+
+Every global flag, such as --client and --grpc-address, can also be set in the
+config file, using camelCase keys such as "client" and "grpcAddress". Nested
+keys are separated with dots, such as "daemon.afk.threshold".
+
+Settings can also be set via environment variables, prefixed with DINKUR_ and
+using underscores instead of dots, such as:
+
+	DINKUR_CLIENT=grpc %[1]s status
+	DINKUR_DAEMON_AFK_THRESHOLD=10m %[1]s daemon
+
+The config file path can be changed using the --config flag or the
+DINKUR_CONFIG environment variable.
+
+Flags take precedence over environment variables, which take precedence over
+the config file.`, RootCmd.Name()),
+	}
+
+	var configPathCmd = &cobra.Command{
+		Use:   "path",
+		Args:  cobra.NoArgs,
+		Short: "Print the path of the config file",
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Println(cfgFile)
+		},
+	}
+
+	var flagFile bool
+
+	var configGetCmd = &cobra.Command{
+		Use:               "get <key>",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Print the value of a config key",
+		Long:              `Prints the value of a config key, including values from flags, environment variables, and defaults, unless the --file flag is set.`,
+		ValidArgsFunction: configKeyComplete,
+		Run: func(cmd *cobra.Command, args []string) {
+			if flagFile {
+				doc := loadConfigDocOrExit()
+				value, ok, err := doc.Get(args[0])
+				if err != nil {
+					console.PrintFatal("Error reading config value:", err)
+				}
+				if !ok {
+					console.PrintFatal("Error reading config value:", fmt.Sprintf("key %q is not set in %s", args[0], cfgFile))
+				}
+				fmt.Println(value)
+				return
+			}
+			if !viper.IsSet(args[0]) && !isKnownConfigKey(args[0]) {
+				console.PrintFatal("Error reading config value:", fmt.Sprintf("key %q is not set", args[0]))
+			}
+			fmt.Println(formatConfigValue(viper.Get(args[0])))
+		},
+	}
+	configGetCmd.Flags().BoolVar(&flagFile, "file", false, "only read values from the config file")
+
+	var configListCmd = &cobra.Command{
+		Use:     "list",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls", "l"},
+		Short:   "List all config keys and their values",
+		Long:    `Lists all config keys and their values, including values from flags, environment variables, and defaults, unless the --file flag is set.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if flagFile {
+				list, err := loadConfigDocOrExit().List()
+				if err != nil {
+					console.PrintFatal("Error reading config values:", err)
+				}
+				for _, kv := range list {
+					fmt.Printf("%s: %s\n", kv.Key, kv.Value)
+				}
+				return
+			}
+			for _, key := range allConfigKeys() {
+				fmt.Printf("%s: %s\n", key, formatConfigValue(viper.Get(key)))
+			}
+		},
+	}
+	configListCmd.Flags().BoolVar(&flagFile, "file", false, "only read values from the config file")
+
+	var configSetCmd = &cobra.Command{
+		Use:   "set <key> <value>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the value of a config key in the config file",
+		Long: fmt.Sprintf(`Sets the value of a config key in the config file, creating the file if it
+does not exist. Comments and ordering of the existing config file are kept.
+
+The value is parsed as YAML, so lists can be set using square brackets:
+
+	%[1]s config set client grpc
+	%[1]s config set daemon.afk.threshold 10m
+	%[1]s config set calendar.workdays "[mon, tue, wed, thu]"`, RootCmd.Name()),
+		ValidArgsFunction: configKeyComplete,
+		Run: func(cmd *cobra.Command, args []string) {
+			key, value := args[0], args[1]
+			if !isKnownConfigKey(key) {
+				log.Warn().WithString("key", key).Message("Setting unknown config key.")
+			}
+			doc := loadConfigDocOrExit()
+			if err := doc.Set(key, value); err != nil {
+				console.PrintFatal("Error setting config value:", err)
+			}
+			if err := doc.Save(cfgFile); err != nil {
+				console.PrintFatal("Error writing config file:", err)
+			}
+		},
+	}
+
+	RootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configPathCmd, configGetCmd, configListCmd, configSetCmd)
+}
+
+func loadConfigDocOrExit() *cfgedit.Document {
+	doc, err := cfgedit.Load(cfgFile)
+	if err != nil {
+		console.PrintFatal("Error reading config file:", err)
+	}
+	return doc
+}
+
+// allConfigKeys returns the known config keys, as well as any other keys found
+// in the config file, sorted.
+func allConfigKeys() []string {
+	keys := make([]string, 0, len(configKeys))
+	for _, k := range configKeys {
+		keys = append(keys, k.key)
+	}
+	for _, key := range viper.AllKeys() {
+		if !isKnownConfigKey(key) && !isNestedConfigKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isKnownConfigKey(key string) bool {
+	for _, k := range configKeys {
+		if strings.EqualFold(k.key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNestedConfigKey returns true for keys within known keys that have list or
+// mapping values, such as "daemon.afk.rules".
+func isNestedConfigKey(key string) bool {
+	for _, k := range configKeys {
+		if len(key) > len(k.key) && strings.EqualFold(key[:len(k.key)+1], k.key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func formatConfigValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []any, []string, map[string]any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func configKeyComplete(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	completions := make([]string, len(configKeys))
+	for i, k := range configKeys {
+		completions[i] = fmt.Sprintf("%s\t%s", k.key, k.description)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
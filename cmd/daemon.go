@@ -23,13 +23,19 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/dinkur/dinkur/internal/cfgpath"
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/afkdetect"
 	"github.com/dinkur/dinkur/pkg/dinkurd"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // daemonCmd represents the daemon command
@@ -42,13 +48,115 @@ This daemon is used by Dinkur clients, and allows more features such as the
 "away detection", which is not available when only using the Dinkur CLI.
 
 Information about the daemon, such as which port was selected and what
-authentication token can be used, is outputted to the console.`,
+authentication token can be used, is outputted to the console.
+
+AFK periods can be resolved automatically when you return, without prompting,
+by configuring AFK rules in the config file. The first matching rule is used,
+and AFK periods not matched by any rule are left for you to resolve:
+
+	daemon:
+	  afk:
+	    rules:
+	      # away under 10 minutes: keep the time in the active entry
+	      - maxAway: 10m
+	        action: keep
+	      # away over 30 minutes during lunch: discard the time
+	      - minAway: 30m
+	        from: "11:00"
+	        to: "13:30"
+	        action: discard
+
+Valid actions are "keep", "discard", and "prompt".
+
+All flags can also be set in the config file, under the "daemon" key, such as:
+
+	daemon:
+	  host: 0.0.0.0
+	  port: 59122
+	  tls:
+	    certFile: cert.pem
+	    keyFile: key.pem
+	  mdns:
+	    enabled: true
+	  pairing:
+	    requireToken: true
+	  notifications:
+	    enabled: true
+	    longEntry: 8h
+	    untracked: 30m
+	    workHours:
+	      from: "08:00"
+	      to: "17:00"
+	  afk:
+	    threshold: 5m
+	    pollInterval: 10s
+	  notTracking:
+	    threshold: 15m
+
+Clients then need to use the --grpc-tls flag, or the "grpcTLS" config key.
+
+The --mdns flag advertises the daemon on the local network, together with the
+fingerprint of its TLS certificate, so other computers can find it using
+"dinkur daemon discover".
+
+Daemons with TLS enabled can be paired with each other using "dinkur pair".
+By default, only clients on the same computer are allowed to connect, and
+paired daemons are only allowed to sync. Set --pairing-require-token=false to
+also allow any other computer on the network to read and edit your entries
+without a token.
+
+The --notifications flag sends desktop notifications via the session D-Bus when
+you return from being AFK and the time spent away is left for you to resolve,
+when an entry has been active for longer than --notify-long-entry, and when
+nothing has been tracked for --notify-untracked during working hours on
+workdays, as configured by the "calendar.workdays" config key.
+
+When you have been at the keyboard for --not-tracking-threshold without any
+active entry, your status is set to "not tracking", together with a suggested
+entry name based on your most recent entries. This is shown by
+"dinkur status" and can be used by status bars to remind you to start tracking.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		dbClient, err := connectToDBClient(false)
 		if err != nil {
 			console.PrintFatal("Error connecting to database for daemon:", err)
 		}
 		opt := dinkurd.DefaultOptions
+		if host := viper.GetString("daemon.host"); host != "" {
+			opt.Host = host
+		}
+		if port := viper.GetUint("daemon.port"); port > math.MaxUint16 {
+			console.PrintFatal("Error parsing daemon port:", fmt.Errorf("port %d is too large", port))
+		} else if port != 0 {
+			opt.Port = uint16(port)
+		}
+		opt.TLSCertFile = viper.GetString("daemon.tls.certFile")
+		opt.TLSKeyFile = viper.GetString("daemon.tls.keyFile")
+		if (opt.TLSCertFile == "") != (opt.TLSKeyFile == "") {
+			console.PrintFatal("Error parsing daemon TLS config:", "both a certificate and key file must be set")
+		}
+		opt.MDNS = viper.GetBool("daemon.mdns.enabled")
+		opt.MDNSInterface = viper.GetString("daemon.mdns.interface")
+		opt.PeersFile = cfgpath.PeersPath
+		opt.AllowRemoteWithoutPeerToken = !viper.GetBool("daemon.pairing.requireToken")
+		opt.AFK = afkdetect.Options{
+			Threshold:    viper.GetDuration("daemon.afk.threshold"),
+			PollInterval: viper.GetDuration("daemon.afk.pollInterval"),
+			AllowHooks:   viper.GetStringSlice("daemon.afk.allowHooks"),
+			DenyHooks:    viper.GetStringSlice("daemon.afk.denyHooks"),
+		}
+		opt.NotTrackingThreshold = viper.GetDuration("daemon.notTracking.threshold")
+		opt.AFKRules, err = afkRulesFromConfig()
+		if err != nil {
+			console.PrintFatal("Error parsing daemon.afk.rules config:", err)
+		}
+		opt.Notifications, err = notificationOptionsFromConfig()
+		if err != nil {
+			console.PrintFatal("Error parsing daemon.notifications config:", err)
+		}
+		opt.Profiles, err = connectToProfileDBClients(dbClient)
+		if err != nil {
+			console.PrintFatal("Error connecting to profile databases for daemon:", err)
+		}
 		d := dinkurd.NewDaemon(dbClient, opt)
 		defer d.Close()
 		enc := json.NewEncoder(os.Stdout)
@@ -68,19 +176,131 @@ authentication token can be used, is outputted to the console.`,
 func init() {
 	RootCmd.AddCommand(daemonCmd)
 
-	// Here you will define your flags and configuration settings.
+	daemonCmd.Flags().String("host", dinkurd.DefaultOptions.Host, "hostname to bind the gRPC API to; use 0.0.0.0 to allow any IP address")
+	daemonCmd.Flags().Uint16("port", dinkurd.DefaultOptions.Port, "port to bind the gRPC API to")
+	daemonCmd.Flags().String("tls-cert-file", "", "PEM-encoded certificate file, to enable TLS")
+	daemonCmd.Flags().String("tls-key-file", "", "PEM-encoded private key file, to enable TLS")
+	daemonCmd.Flags().Bool("mdns", false, "advertise the daemon on the local network via mDNS")
+	daemonCmd.Flags().String("mdns-interface", "", "network interface to advertise the daemon on (default is the system's default multicast interface)")
+	daemonCmd.RegisterFlagCompletionFunc("mdns-interface", networkInterfaceComplete)
+	daemonCmd.Flags().Bool("pairing-require-token", true, "only allow daemons paired using \"dinkur pair\" to connect from other computers, and only to sync")
+	daemonCmd.Flags().Bool("notifications", false, "send desktop notifications, such as when returning from AFK")
+	daemonCmd.Flags().Duration("notify-long-entry", 8*time.Hour, "notify when an entry has been active for this long; 0 disables it")
+	daemonCmd.Flags().Duration("notify-untracked", 30*time.Minute, "notify when nothing has been tracked for this long during working hours; 0 disables it")
+	daemonCmd.Flags().String("notify-work-hours-from", "08:00", "start of working hours, used by --notify-untracked")
+	daemonCmd.Flags().String("notify-work-hours-to", "17:00", "end of working hours, used by --notify-untracked")
+	daemonCmd.Flags().Duration("afk-threshold", afkdetect.DefaultOptions.Threshold, "idle duration until considered AFK")
+	daemonCmd.Flags().Duration("afk-poll-interval", afkdetect.DefaultOptions.PollInterval, "how often to check for idle time")
+	daemonCmd.Flags().StringSlice("afk-allow-hooks", nil, "only use these AFK hooks (default is all available hooks)")
+	daemonCmd.RegisterFlagCompletionFunc("afk-allow-hooks", afkHookComplete)
+	daemonCmd.Flags().StringSlice("afk-deny-hooks", nil, "never use these AFK hooks")
+	daemonCmd.RegisterFlagCompletionFunc("afk-deny-hooks", afkHookComplete)
+	daemonCmd.Flags().Duration("not-tracking-threshold", 15*time.Minute, "duration at the keyboard without an active entry until status is \"not tracking\"; 0 disables it")
+
+	viper.BindPFlag("daemon.host", daemonCmd.Flags().Lookup("host"))
+	viper.BindPFlag("daemon.port", daemonCmd.Flags().Lookup("port"))
+	viper.BindPFlag("daemon.tls.certFile", daemonCmd.Flags().Lookup("tls-cert-file"))
+	viper.BindPFlag("daemon.tls.keyFile", daemonCmd.Flags().Lookup("tls-key-file"))
+	viper.BindPFlag("daemon.mdns.enabled", daemonCmd.Flags().Lookup("mdns"))
+	viper.BindPFlag("daemon.mdns.interface", daemonCmd.Flags().Lookup("mdns-interface"))
+	viper.BindPFlag("daemon.pairing.requireToken", daemonCmd.Flags().Lookup("pairing-require-token"))
+	viper.BindPFlag("daemon.notifications.enabled", daemonCmd.Flags().Lookup("notifications"))
+	viper.BindPFlag("daemon.notifications.longEntry", daemonCmd.Flags().Lookup("notify-long-entry"))
+	viper.BindPFlag("daemon.notifications.untracked", daemonCmd.Flags().Lookup("notify-untracked"))
+	viper.BindPFlag("daemon.notifications.workHours.from", daemonCmd.Flags().Lookup("notify-work-hours-from"))
+	viper.BindPFlag("daemon.notifications.workHours.to", daemonCmd.Flags().Lookup("notify-work-hours-to"))
+	viper.BindPFlag("daemon.afk.threshold", daemonCmd.Flags().Lookup("afk-threshold"))
+	viper.BindPFlag("daemon.afk.pollInterval", daemonCmd.Flags().Lookup("afk-poll-interval"))
+	viper.BindPFlag("daemon.afk.allowHooks", daemonCmd.Flags().Lookup("afk-allow-hooks"))
+	viper.BindPFlag("daemon.afk.denyHooks", daemonCmd.Flags().Lookup("afk-deny-hooks"))
+	viper.BindPFlag("daemon.notTracking.threshold", daemonCmd.Flags().Lookup("not-tracking-threshold"))
+}
+
+func afkHookComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return afkdetect.HookNames(), cobra.ShellCompDirectiveDefault
+}
+
+// afkRuleConfig is the config file representation of a dinkurd.AFKRule.
+type afkRuleConfig struct {
+	MinAway time.Duration `mapstructure:"minAway"`
+	MaxAway time.Duration `mapstructure:"maxAway"`
+	From    string        `mapstructure:"from"`
+	To      string        `mapstructure:"to"`
+	Action  string        `mapstructure:"action"`
+}
+
+func afkRulesFromConfig() ([]dinkurd.AFKRule, error) {
+	var configs []afkRuleConfig
+	if err := viper.UnmarshalKey("daemon.afk.rules", &configs); err != nil {
+		return nil, err
+	}
+	rules := make([]dinkurd.AFKRule, len(configs))
+	for i, cfg := range configs {
+		rule, err := cfg.afkRule()
+		if err != nil {
+			return nil, fmt.Errorf("rule #%d: %w", i+1, err)
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+func (cfg afkRuleConfig) afkRule() (dinkurd.AFKRule, error) {
+	action, err := dinkurd.ParseAFKRuleAction(cfg.Action)
+	if err != nil {
+		return dinkurd.AFKRule{}, err
+	}
+	from, err := parseTimeOfDay(cfg.From)
+	if err != nil {
+		return dinkurd.AFKRule{}, fmt.Errorf("from: %w", err)
+	}
+	to, err := parseTimeOfDay(cfg.To)
+	if err != nil {
+		return dinkurd.AFKRule{}, fmt.Errorf("to: %w", err)
+	}
+	return dinkurd.AFKRule{
+		MinAway: cfg.MinAway,
+		MaxAway: cfg.MaxAway,
+		From:    from,
+		To:      to,
+		Action:  action,
+	}, nil
+}
 
-	// Cobra supports Persistent Flags which will work for this command
-	// and all subcommands, e.g.:
-	// daemonCmd.PersistentFlags().String("foo", "", "A help for foo")
+func notificationOptionsFromConfig() (dinkurd.NotificationOptions, error) {
+	from, err := parseTimeOfDay(viper.GetString("daemon.notifications.workHours.from"))
+	if err != nil {
+		return dinkurd.NotificationOptions{}, fmt.Errorf("workHours.from: %w", err)
+	}
+	to, err := parseTimeOfDay(viper.GetString("daemon.notifications.workHours.to"))
+	if err != nil {
+		return dinkurd.NotificationOptions{}, fmt.Errorf("workHours.to: %w", err)
+	}
+	return dinkurd.NotificationOptions{
+		Enabled:       viper.GetBool("daemon.notifications.enabled"),
+		LongEntry:     viper.GetDuration("daemon.notifications.longEntry"),
+		Untracked:     viper.GetDuration("daemon.notifications.untracked"),
+		WorkHoursFrom: from,
+		WorkHoursTo:   to,
+		Calendar:      calendar,
+	}, nil
+}
 
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// daemonCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+// parseTimeOfDay parses a "15:04" formatted time of day into the duration
+// since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
 }
 
 func contextWithOSInterrupt(ctx context.Context) context.Context {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	newCtx, done := context.WithCancel(ctx)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
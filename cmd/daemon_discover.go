@@ -0,0 +1,105 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/discovery"
+	"github.com/spf13/cobra"
+)
+
+// daemonAddressCompleteTimeout is kept short, as the shell waits for the
+// completion to finish.
+const daemonAddressCompleteTimeout = 500 * time.Millisecond
+
+func init() {
+	var (
+		flagTimeout   = 2 * time.Second
+		flagInterface = ""
+	)
+
+	var daemonDiscoverCmd = &cobra.Command{
+		Use:     "discover",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"find", "ls"},
+		Short:   "List Dinkur daemons on the local network",
+		Long: fmt.Sprintf(`Lists the Dinkur daemons found on the local network, by querying for the
+%[2]q mDNS/DNS-SD service. Daemons are only advertised when started with
+the --mdns flag, or with the "daemon.mdns.enabled" config key.
+
+The listed addresses can be used with the --grpc-address flag, or with the
+"%[1]s sync" command. The TLS fingerprint can be compared to the output of
+the following command on the computer running the daemon:
+
+	openssl x509 -noout -fingerprint -sha256 -in cert.pem`, RootCmd.Name(), discovery.ServiceType),
+		Run: func(cmd *cobra.Command, args []string) {
+			iface, err := discovery.InterfaceByName(flagInterface)
+			if err != nil {
+				console.PrintFatal("Error getting network interface:", err)
+			}
+			ctx, cancel := context.WithTimeout(rootCtx, flagTimeout)
+			defer cancel()
+			services, err := discovery.Browse(ctx, discovery.Options{Interface: iface})
+			if err != nil {
+				console.PrintFatal("Error discovering daemons:", err)
+			}
+			console.PrintDiscoveredDaemonList(services)
+		},
+	}
+
+	daemonCmd.AddCommand(daemonDiscoverCmd)
+	daemonDiscoverCmd.Flags().DurationVar(&flagTimeout, "timeout", flagTimeout, "how long to wait for daemons to respond")
+	daemonDiscoverCmd.Flags().StringVar(&flagInterface, "interface", flagInterface, "network interface to search on (default is the system's default multicast interface)")
+	daemonDiscoverCmd.RegisterFlagCompletionFunc("interface", networkInterfaceComplete)
+}
+
+func daemonAddressComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(context.Background(), daemonAddressCompleteTimeout)
+	defer cancel()
+	services, err := discovery.Browse(ctx, discovery.Options{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var addrs []string
+	for _, svc := range services {
+		addrs = append(addrs, fmt.Sprintf("%s\tDinkur daemon on %s", svc.Address(), svc.Instance))
+	}
+	return addrs, cobra.ShellCompDirectiveNoFileComp
+}
+
+func networkInterfaceComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagMulticast != 0 {
+			names = append(names, iface.Name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
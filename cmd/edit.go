@@ -87,4 +87,5 @@ a specific entry using the --id or -i flag.`,
 	editCmd.Flags().BoolVarP(&flagAfterLast, "after-last", "L", false, `sets --start time to the end time of latest entry`)
 	editCmd.Flags().UintVarP(&flagBeforeID, "before-id", "b", 0, `sets --end time to the start time of entry with ID`)
 	editCmd.RegisterFlagCompletionFunc("before-id", entryIDComplete)
+	addEntryTableFlags(editCmd)
 }
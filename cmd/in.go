@@ -26,9 +26,11 @@ import (
 	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/fuzzytime"
 	"github.com/dinkur/dinkur/internal/pflagutil"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/spf13/cobra"
+	"gopkg.in/typ.v4"
 )
 
 func init() {
@@ -38,6 +40,8 @@ func init() {
 		flagAfterID   uint
 		flagAfterLast bool
 		flagBeforeID  uint
+		flagWhen      string
+		flagFor       string
 	)
 
 	var inCmd = &cobra.Command{
@@ -45,18 +49,47 @@ func init() {
 		Args:    cobra.ArbitraryArgs,
 		Aliases: []string{"i", "start", "new"},
 		Short:   "Check in/start tracking a new entry",
-		Long:    ``,
+		Long: `Starts tracking a new entry, or adds a closed entry if an end time
+is given.
+
+The --when flag accepts a time range, which makes retroactive logging
+a single short command. Examples:
+
+  dinkur in --when 9-11:30 Standup and planning
+  dinkur in --when "yesterday 13:00 for 2h" Code review
+  dinkur in --when "2h ago for 45m" Lunch
+  dinkur in --for 1h30m Meeting`,
 		Run: func(cmd *cobra.Command, args []string) {
+			now := time.Now()
+			start := flagStart.TimePtr(now)
+			end := flagEnd.TimePtr(now)
+			if flagWhen != "" {
+				r, err := fuzzytime.ParseRange(flagWhen, now)
+				if err != nil {
+					console.PrintFatal("Error parsing --when:", err)
+				}
+				start, end = &r.Start, r.End
+			}
+			if flagFor != "" {
+				d, err := fuzzytime.ParseDuration(flagFor)
+				if err != nil {
+					console.PrintFatal("Error parsing --for:", err)
+				}
+				if cmd.Flags().Changed("start") {
+					end = typ.Ref(start.Add(d))
+				} else {
+					start, end = typ.Ref(now.Add(-d)), &now
+				}
+			}
 			connectClientOrExit()
 			newName := strings.Join(args, " ")
 			if checkIfDuplicateEntry(newName) {
 				return
 			}
-			now := time.Now()
 			newEntry := dinkur.NewEntry{
 				Name:               newName,
-				Start:              flagStart.TimePtr(now),
-				End:                flagEnd.TimePtr(now),
+				Start:              start,
+				End:                end,
 				StartAfterIDOrZero: flagAfterID,
 				EndBeforeIDOrZero:  flagBeforeID,
 				StartAfterLast:     flagAfterLast,
@@ -77,6 +110,19 @@ func init() {
 	inCmd.Flags().BoolVarP(&flagAfterLast, "after-last", "L", false, `sets --start time to the end time of latest entry`)
 	inCmd.Flags().UintVarP(&flagBeforeID, "before-id", "b", 0, `sets --end time to the start time of entry with ID`)
 	inCmd.RegisterFlagCompletionFunc("before-id", entryIDComplete)
+	inCmd.Flags().StringVarP(&flagWhen, "when", "w", "", `time range of entry, such as "9-11:30", "yesterday 13:00 for 2h", or "2h ago for 45m"`)
+	inCmd.Flags().StringVarP(&flagFor, "for", "f", "", `duration of entry, such as "1h30m"; entry will end now, or after the duration from --start if set`)
+	inCmd.MarkFlagsMutuallyExclusive("when", "start")
+	inCmd.MarkFlagsMutuallyExclusive("when", "end")
+	inCmd.MarkFlagsMutuallyExclusive("when", "for")
+	inCmd.MarkFlagsMutuallyExclusive("for", "end")
+	inCmd.MarkFlagsMutuallyExclusive("when", "after-id")
+	inCmd.MarkFlagsMutuallyExclusive("when", "after-last")
+	inCmd.MarkFlagsMutuallyExclusive("when", "before-id")
+	inCmd.MarkFlagsMutuallyExclusive("for", "after-id")
+	inCmd.MarkFlagsMutuallyExclusive("for", "after-last")
+	inCmd.MarkFlagsMutuallyExclusive("for", "before-id")
+	addEntryTableFlags(inCmd)
 }
 
 func checkIfDuplicateEntry(newName string) bool {
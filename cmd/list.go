@@ -41,12 +41,14 @@ import (
 
 func init() {
 	var (
-		flagLimit       uint = 1000
-		flagStart            = &pflagutil.Time{}
-		flagEnd              = &pflagutil.Time{}
-		flagRange            = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
-		flagOutput           = "pretty"
-		flagNoHighlight      = false
+		flagLimit        uint = 1000
+		flagStart             = &pflagutil.Time{}
+		flagEnd               = &pflagutil.Time{}
+		flagRange             = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
+		flagOutput            = "pretty"
+		flagNoHighlight       = false
+		flagTemplate          = ""
+		flagTemplateFile      = ""
 	)
 
 	var listCmd = &cobra.Command{
@@ -75,17 +77,43 @@ take precedence over the baseline range.
 
 Day baselines sets the range 00:00:00 - 24:59:59.
 Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
-`, RootCmd.Name()),
+
+Custom output can be produced using a Go text/template via the --template or
+--template-file flags, which implies --output=template. The template is
+executed once per entry, and is given the entry fields, such as .ID, .Name,
+.Start, .End, .Elapsed, .CreatedAt, and .UpdatedAt.
+
+	%[1]s list --template '{{.ID}}\t{{.Name}}\t{{.Elapsed}}'
+	%[1]s list --template '{{padLeft 4 .ID}} {{pad 30 .Name}} {{time "15:04" .Start}}-{{time "15:04" .End}} {{duration .Elapsed}}'
+
+%[2]s
+`, RootCmd.Name(), templateFuncsHelp),
 		Run: func(cmd *cobra.Command, args []string) {
+			if (cmd.Flags().Changed("template") || cmd.Flags().Changed("template-file")) &&
+				!cmd.Flags().Changed("output") {
+				flagOutput = "template"
+			}
+			var tmpl outputTemplate
+			if strings.EqualFold(flagOutput, "template") {
+				if flagTemplate == "" && flagTemplateFile == "" {
+					console.PrintFatal("Error parsing --output:", "--template or --template-file is required when using --output=template")
+				}
+				var err error
+				tmpl, err = parseOutputTemplate(flagTemplate, flagTemplateFile)
+				if err != nil {
+					console.PrintFatal("Error parsing --template:", err)
+				}
+			}
 			connectClientOrExit()
 			rand.Seed(time.Now().UnixMicro())
 			now := time.Now()
 			search := dinkur.SearchEntry{
-				Limit:     flagLimit,
-				Start:     flagStart.TimePtr(now),
-				End:       flagEnd.TimePtr(now),
-				Shorthand: flagRange.TimeSpanShorthand(),
-				NameFuzzy: strings.Join(args, " "),
+				Limit:          flagLimit,
+				Start:          flagStart.TimePtr(now),
+				End:            flagEnd.TimePtr(now),
+				Shorthand:      flagRange.TimeSpanShorthand(),
+				ShorthandCount: flagRange.Count(),
+				NameFuzzy:      strings.Join(args, " "),
 			}
 			if strings.EqualFold(flagOutput, "pretty") && !flagNoHighlight {
 				search.NameHighlightStart = fmt.Sprintf(">!@%d#>", rand.Intn(255))
@@ -160,6 +188,12 @@ Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
 				if err := w.WriteAll(records); err != nil {
 					console.PrintFatal("Error encoding entries as CSV:", err)
 				}
+			case "template":
+				for _, t := range entries {
+					if err := tmpl.execute(os.Stdout, t); err != nil {
+						console.PrintFatal(fmt.Sprintf("Error executing template for entry #%d:", t.ID), err)
+					}
+				}
 			default:
 				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
 			}
@@ -173,9 +207,13 @@ Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
 	listCmd.Flags().VarP(flagEnd, "end", "e", "list entries ending before or at date time")
 	listCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
 	listCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
-	listCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "json-line", "yaml", "xml", "xml-line", "csv", "csv-header"`)
+	listCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "json-line", "yaml", "xml", "xml-line", "csv", "csv-header", "template"`)
 	listCmd.RegisterFlagCompletionFunc("output", outputFormatComplete)
+	listCmd.Flags().StringVarP(&flagTemplate, "template", "t", "", `Go text/template used by --output=template, executed once per entry`)
+	listCmd.Flags().StringVar(&flagTemplateFile, "template-file", "", `read Go text/template used by --output=template from file`)
+	listCmd.MarkFlagsMutuallyExclusive("template", "template-file")
 	listCmd.Flags().BoolVar(&flagNoHighlight, "no-highlight", false, `disables search highlighting in "pretty" output`)
+	addEntryTableFlags(listCmd)
 }
 
 func outputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
@@ -188,6 +226,7 @@ func outputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.She
 		"xml-line\teach entry XML element on a separate line",
 		"csv\teach entry on a separate line with field as comma-separated-values",
 		"csv-header\tsame as --output=csv, but with additional header row",
+		"template\teach entry formatted using the Go text/template given by --template",
 	}, cobra.ShellCompDirectiveDefault
 }
 
@@ -206,7 +245,7 @@ func convEntryCSVRecord(entry dinkur.Entry) []string {
 	const timeLayout = time.RFC3339Nano
 	endStr := ""
 	if entry.End != nil {
-		endStr = entry.End.String()
+		endStr = entry.End.Format(timeLayout)
 	}
 	return []string{
 		strconv.FormatUint(uint64(entry.ID), 10),
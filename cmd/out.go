@@ -56,6 +56,7 @@ var outCmd = &cobra.Command{
 
 func init() {
 	RootCmd.AddCommand(outCmd)
+	addEntryTableFlags(outCmd)
 
 	// Here you will define your flags and configuration settings.
 
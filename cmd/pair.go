@@ -0,0 +1,175 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/cfgpath"
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/pairing"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagCode = ""
+	)
+
+	var pairCmd = &cobra.Command{
+		Use:               "pair [address]",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: daemonAddressComplete,
+		Short:             "Pair the Dinkur daemon with another daemon, to sync entries",
+		Long: fmt.Sprintf(`Sets up a trust relationship between two Dinkur daemons, such as between the
+daemons on your desktop and laptop, so they can sync entries with each other.
+
+Both daemons must be running with TLS enabled. On the first computer, run the
+command without arguments to show a short pairing code:
+
+	%[1]s pair
+
+Then on the second computer, pass the first computer's address, and enter the
+pairing code when asked:
+
+	%[1]s pair desktop.local:59122
+
+Both daemons prove to each other that they know the pairing code, without
+sending it, so a third computer intercepting the connection cannot pair with
+either of them. The daemons then pin each other's TLS certificate, so
+self-signed certificates can be used, and exchange a token that is used when
+syncing. The pairing code is only valid for 5 minutes, and can only be used
+once.
+
+Paired daemons can be synced by name, such as "%[1]s sync desktop". The
+credentials are stored in:
+
+	%[2]s
+
+The command always talks to the daemon at --grpc-address, regardless of the
+--client flag.`, RootCmd.Name(), cfgpath.PeersPath),
+		Run: func(cmd *cobra.Command, args []string) {
+			pairer := connectPairerOrExit()
+			if len(args) == 0 {
+				startPairing(pairer)
+				return
+			}
+			code := flagCode
+			if code == "" {
+				var err error
+				code, err = console.PromptPairingCode()
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			peer, err := pairer.RequestPairing(rootCtx, args[0], code)
+			if err != nil {
+				console.PrintFatal("Error pairing with daemon:", err)
+			}
+			console.PrintPeerLabel("Paired with:", peer)
+		},
+	}
+
+	var pairListCmd = &cobra.Command{
+		Use:     "list",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls", "l"},
+		Short:   "List paired Dinkur daemons",
+		Run: func(cmd *cobra.Command, args []string) {
+			pairer := connectPairerOrExit()
+			peers, err := pairer.GetPeerList(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error getting list of paired daemons:", err)
+			}
+			console.PrintPeerList(peers)
+		},
+	}
+
+	var pairRevokeCmd = &cobra.Command{
+		Use:               "revoke <name>",
+		Args:              cobra.ExactArgs(1),
+		Aliases:           []string{"remove", "rm", "unpair"},
+		Short:             "Revoke the pairing with a Dinkur daemon",
+		Long:              `Removes the paired daemon, so its token is no longer accepted when syncing.`,
+		ValidArgsFunction: peerNameComplete,
+		Run: func(cmd *cobra.Command, args []string) {
+			pairer := connectPairerOrExit()
+			peer, err := pairer.DeletePeer(rootCtx, args[0])
+			if err != nil {
+				console.PrintFatal("Error revoking paired daemon:", err)
+			}
+			console.PrintPeerLabel("Revoked:", peer)
+		},
+	}
+
+	RootCmd.AddCommand(pairCmd)
+	pairCmd.AddCommand(pairListCmd, pairRevokeCmd)
+	pairCmd.Flags().StringVar(&flagCode, "code", flagCode, "pairing code shown by the other daemon (default is to prompt for it)")
+}
+
+func startPairing(pairer dinkur.Pairer) {
+	code, err := pairer.StartPairing(rootCtx)
+	if err != nil {
+		console.PrintFatal("Error starting pairing:", err)
+	}
+	console.PrintPairingCode(code)
+	fmt.Println()
+	fmt.Printf("Enter the code on the other computer by running: %s pair <address>\n", RootCmd.Name())
+	fmt.Println("Waiting for the other daemon...")
+	fmt.Println()
+	ctx, cancel := context.WithDeadline(rootCtx, code.Expires.Add(time.Second))
+	defer cancel()
+	peer, err := pairer.WaitForPairing(ctx, code.Code)
+	if err != nil {
+		console.PrintFatal("Error waiting for pairing:", err)
+	}
+	console.PrintPeerLabel("Paired with:", peer)
+}
+
+// connectPairerOrExit connects to the Dinkur daemon regardless of the
+// --client flag, as only daemons can be paired.
+func connectPairerOrExit() dinkur.Pairer {
+	client, err := connectToGRPCClient()
+	if err != nil {
+		console.PrintFatal("Error connecting to daemon:", err)
+	}
+	c = client
+	pairer, ok := client.(dinkur.Pairer)
+	if !ok {
+		console.PrintFatal("Error connecting to daemon:", "client does not support pairing")
+	}
+	return pairer
+}
+
+func peerNameComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	peers, err := pairing.NewStore(cfgpath.PeersPath).Peers()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, len(peers))
+	for i, p := range peers {
+		names[i] = fmt.Sprintf("%s\tpaired daemon at %s", p.Name, p.Address)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
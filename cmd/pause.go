@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var pauseCmd = &cobra.Command{
+		Use:   "pause",
+		Args:  cobra.NoArgs,
+		Short: "Pause the currently active entry, to later resume it",
+		Long: fmt.Sprintf(`Stops the currently active entry, the same as "%[1]s out", but also
+remembers it as paused.
+
+The paused entry can later be resumed using "%[1]s resume", which starts a new
+entry with the same name that continues the paused entry. Entries continued
+this way are segments of the same task, and can be merged in reports using
+"%[1]s report --tasks".`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			pausedEntry, err := c.PauseActiveEntry(rootCtx, time.Now())
+			if err != nil {
+				console.PrintFatal("Error pausing entry:", err)
+			}
+			if pausedEntry == nil {
+				fmt.Println("No active entry to pause.")
+				os.Exit(1)
+			}
+			console.PrintEntryLabel(console.LabelledEntry{
+				Label: "Paused entry:",
+				Entry: *pausedEntry,
+			})
+		},
+	}
+
+	RootCmd.AddCommand(pauseCmd)
+	addEntryTableFlags(pauseCmd)
+}
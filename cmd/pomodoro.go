@@ -0,0 +1,195 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	var (
+		flagFollow = false
+	)
+
+	var pomodoroCmd = &cobra.Command{
+		Use:     "pomodoro",
+		Aliases: []string{"pomo"},
+		Short:   "Track entries using a Pomodoro timer",
+		Long: fmt.Sprintf(`Tracks entries using a Pomodoro timer, kept by the Dinkur daemon.
+
+The timer starts a new entry for each work period, and when a work period ends
+it either stops the entry or starts a break entry, and then starts a new entry
+when the break ends. Every few work periods, the break is a long break.
+
+	%[1]s pomodoro start Write report
+	%[1]s pomodoro status --follow
+	%[1]s pomodoro stop
+
+The timer stops on its own if you start or stop any entry yourself.
+
+Completed work periods are stored, and can be counted per entry using
+"%[1]s report --pomodoro". The durations can be set in the config file:
+
+	pomodoro:
+	  work: 25m
+	  break: 5m
+	  longBreak: 15m
+	  longBreakEvery: 4
+	  breakEntry: Break
+
+The command always talks to the daemon at --grpc-address, regardless of the
+--client flag.`, RootCmd.Name()),
+	}
+
+	var pomodoroStartCmd = &cobra.Command{
+		Use:   "start <entry name>",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Start a Pomodoro timer, and an entry for its first work period",
+		Run: func(cmd *cobra.Command, args []string) {
+			timer := connectPomodoroTimerOrExit()
+			pomodoro, err := timer.StartPomodoro(rootCtx, dinkur.StartPomodoro{
+				EntryName: strings.Join(args, " "),
+				Settings: dinkur.PomodoroSettings{
+					Work:           viper.GetDuration("pomodoro.work"),
+					Break:          viper.GetDuration("pomodoro.break"),
+					LongBreak:      viper.GetDuration("pomodoro.longBreak"),
+					LongBreakEvery: viper.GetUint("pomodoro.longBreakEvery"),
+					BreakEntryName: viper.GetString("pomodoro.breakEntry"),
+				},
+			})
+			if err != nil {
+				console.PrintFatal("Error starting Pomodoro timer:", err)
+			}
+			console.PrintPomodoro("Started timer:", pomodoro)
+		},
+	}
+
+	var pomodoroStopCmd = &cobra.Command{
+		Use:   "stop",
+		Args:  cobra.NoArgs,
+		Short: "Stop the Pomodoro timer, and the entry it started",
+		Run: func(cmd *cobra.Command, args []string) {
+			timer := connectPomodoroTimerOrExit()
+			pomodoro, err := timer.StopPomodoro(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error stopping Pomodoro timer:", err)
+			}
+			if pomodoro == nil {
+				fmt.Println("You have no running Pomodoro timer.")
+				return
+			}
+			console.PrintPomodoro("Stopped timer:", *pomodoro)
+		},
+	}
+
+	var pomodoroStatusCmd = &cobra.Command{
+		Use:   "status",
+		Args:  cobra.NoArgs,
+		Short: "Show the Pomodoro timer",
+		Run: func(cmd *cobra.Command, args []string) {
+			timer := connectPomodoroTimerOrExit()
+			if flagFollow {
+				followPomodoro(timer)
+				return
+			}
+			pomodoro, err := timer.GetPomodoro(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error getting Pomodoro timer:", err)
+			}
+			if pomodoro == nil {
+				fmt.Println("You have no running Pomodoro timer.")
+				return
+			}
+			console.PrintPomodoro("Current timer:", *pomodoro)
+		},
+	}
+
+	RootCmd.AddCommand(pomodoroCmd)
+	pomodoroCmd.AddCommand(pomodoroStartCmd, pomodoroStopCmd, pomodoroStatusCmd)
+
+	pomodoroStartCmd.Flags().Duration("work", dinkur.DefaultPomodoroSettings.Work, "duration of each work period")
+	pomodoroStartCmd.Flags().Duration("break", dinkur.DefaultPomodoroSettings.Break, "duration of the short breaks")
+	pomodoroStartCmd.Flags().Duration("long-break", dinkur.DefaultPomodoroSettings.LongBreak, "duration of the long breaks")
+	pomodoroStartCmd.Flags().Uint("long-break-every", dinkur.DefaultPomodoroSettings.LongBreakEvery, "number of work periods between each long break")
+	pomodoroStartCmd.Flags().String("break-entry", "", "name of entry to track during breaks (default is to not track breaks)")
+	viper.BindPFlag("pomodoro.work", pomodoroStartCmd.Flags().Lookup("work"))
+	viper.BindPFlag("pomodoro.break", pomodoroStartCmd.Flags().Lookup("break"))
+	viper.BindPFlag("pomodoro.longBreak", pomodoroStartCmd.Flags().Lookup("long-break"))
+	viper.BindPFlag("pomodoro.longBreakEvery", pomodoroStartCmd.Flags().Lookup("long-break-every"))
+	viper.BindPFlag("pomodoro.breakEntry", pomodoroStartCmd.Flags().Lookup("break-entry"))
+
+	pomodoroStatusCmd.Flags().BoolVarP(&flagFollow, "follow", "f", flagFollow, "keep printing the timer whenever it changes phase")
+}
+
+func followPomodoro(timer dinkur.PomodoroTimer) {
+	pomodoroChan, err := timer.StreamPomodoro(rootCtx)
+	if err != nil {
+		console.PrintFatal("Error streaming Pomodoro timer:", err)
+	}
+	pomodoro, err := timer.GetPomodoro(rootCtx)
+	if err != nil {
+		console.PrintFatal("Error getting Pomodoro timer:", err)
+	}
+	if pomodoro == nil {
+		fmt.Println("You have no running Pomodoro timer. Waiting for one to start...")
+	} else {
+		console.PrintPomodoro("Current timer:", *pomodoro)
+	}
+	for ev := range pomodoroChan {
+		console.PrintPomodoro(pomodoroEventLabel(ev.Event), ev.Pomodoro)
+	}
+}
+
+func pomodoroEventLabel(event dinkur.PomodoroEvent) string {
+	switch event {
+	case dinkur.PomodoroEventStarted:
+		return "Started timer:"
+	case dinkur.PomodoroEventBreakStarted:
+		return "Break started:"
+	case dinkur.PomodoroEventWorkStarted:
+		return "Work started:"
+	case dinkur.PomodoroEventStopped:
+		return "Stopped timer:"
+	default:
+		return "Updated timer:"
+	}
+}
+
+// connectPomodoroTimerOrExit connects to the Dinkur daemon regardless of the
+// --client flag, as only the daemon can keep the time.
+func connectPomodoroTimerOrExit() dinkur.PomodoroTimer {
+	client, err := connectToGRPCClient()
+	if err != nil {
+		console.PrintFatal("Error connecting to daemon:", err)
+	}
+	c = client
+	timer, ok := client.(dinkur.PomodoroTimer)
+	if !ok {
+		console.PrintFatal("Error connecting to daemon:", "client does not support Pomodoro timers")
+	}
+	return timer
+}
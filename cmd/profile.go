@@ -0,0 +1,160 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/cfgpath"
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var errUnknownProfile = errors.New("unknown profile")
+
+func init() {
+	var profileCmd = &cobra.Command{
+		Use:   "profile",
+		Short: "List and select named profiles",
+		Long: fmt.Sprintf(`Named profiles are defined in the config file, and can override any other
+config key, such as "data" and "grpcAddress":
+
+	profiles:
+	  work:
+	    data: ~/.local/share/dinkur/work.db
+	  personal:
+	    data: ~/.local/share/dinkur/personal.db
+	    calendar:
+	      workdays: [sat, sun]
+
+A profile is selected using the --profile flag, the DINKUR_PROFILE environment
+variable, or the "profile" config key, which is set by "%[1]s profile use".
+
+The daemon serves the databases of all profiles at once, so a single daemon
+covers all your profiles. Clients using --client=grpc select the profile's
+database via the %[2]q gRPC metadata header.
+
+To stop using a profile, run: %[1]s config set profile ""`, RootCmd.Name(), "dinkur-profile"),
+	}
+
+	var profileListCmd = &cobra.Command{
+		Use:     "list",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls", "l"},
+		Short:   "List the profiles in the config file",
+		Run: func(cmd *cobra.Command, args []string) {
+			active := viper.GetString("profile")
+			var profiles []console.Profile
+			for _, name := range profileNames() {
+				profiles = append(profiles, console.Profile{
+					Name:        name,
+					Data:        viper.GetString("profiles." + name + ".data"),
+					GRPCAddress: viper.GetString("profiles." + name + ".grpcAddress"),
+					Active:      strings.EqualFold(name, active),
+				})
+			}
+			console.PrintProfileList(profiles)
+		},
+	}
+
+	var profileUseCmd = &cobra.Command{
+		Use:               "use <name>",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Set the default profile in the config file",
+		ValidArgsFunction: profileComplete,
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			if !viper.IsSet("profiles." + name) {
+				console.PrintFatal("Error selecting profile:", fmt.Errorf("%w: %q", errUnknownProfile, name))
+			}
+			doc := loadConfigDocOrExit()
+			if err := doc.Set("profile", name); err != nil {
+				console.PrintFatal("Error setting config value:", err)
+			}
+			if err := doc.Save(cfgFile); err != nil {
+				console.PrintFatal("Error writing config file:", err)
+			}
+			fmt.Printf("Using profile %q.\n", name)
+		},
+	}
+
+	RootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileListCmd, profileUseCmd)
+}
+
+// applyProfile merges the profile's config keys on top of the config file's
+// keys. Flags and environment variables still take precedence.
+func applyProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+	if !viper.IsSet("profiles." + name) {
+		return fmt.Errorf("%w: %q", errUnknownProfile, name)
+	}
+	return viper.MergeConfigMap(viper.GetStringMap("profiles." + name))
+}
+
+func profileNames() []string {
+	profiles := viper.GetStringMap("profiles")
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// connectToProfileDBClients connects to the databases of all profiles, for
+// the daemon to serve. Profiles using the same database file as the default
+// client reuses that client.
+func connectToProfileDBClients(defaultClient dinkur.Client) (map[string]dinkur.Client, error) {
+	defaultData := filepath.Clean(cfgpath.ExpandHome(viper.GetString("data")))
+	byPath := map[string]dinkur.Client{defaultData: defaultClient}
+	clients := map[string]dinkur.Client{}
+	for _, name := range profileNames() {
+		data := viper.GetString("profiles." + name + ".data")
+		if data == "" {
+			data = defaultData
+		}
+		data = filepath.Clean(cfgpath.ExpandHome(data))
+		client, ok := byPath[data]
+		if !ok {
+			var err error
+			client, err = connectToDBClientPath(data, false)
+			if err != nil {
+				return nil, fmt.Errorf("profile %q: %w", name, err)
+			}
+			byPath[data] = client
+		}
+		clients[name] = client
+	}
+	return clients, nil
+}
+
+func profileComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return profileNames(), cobra.ShellCompDirectiveNoFileComp
+}
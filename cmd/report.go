@@ -0,0 +1,180 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagLimit    uint = 1000
+		flagStart         = &pflagutil.Time{}
+		flagEnd           = &pflagutil.Time{}
+		flagRange         = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
+		flagAway          = false
+		flagWorkdays      = false
+		flagPomodoro      = false
+		flagTasks         = false
+	)
+
+	var reportCmd = &cobra.Command{
+		Use:     `report [name search terms]`,
+		Args:    cobra.ArbitraryArgs,
+		Aliases: []string{"rep"},
+		Short:   "Report the time tracked per entry",
+		Long: fmt.Sprintf(`Reports the time tracked per entry.
+
+Any non-flag arguments are used as entry name search terms, and the --range,
+--start, and --end flags work the same as for the "%[1]s list" command.
+
+With the --away flag, the report also includes how much of each entry's time
+was spent away from keyboard (AFK) and was kept in the entry, as well as the
+tracked time minus that away time.
+
+With the --workdays flag, the report also includes whether each entry was
+tracked on a workday, a weekend, or a holiday, as well as separate totals for
+workdays and days off. Which weekdays are workdays and which dates are holidays
+is set in the config file:
+
+	calendar:
+	  firstWeekday: monday
+	  workdays: [monday, tuesday, wednesday, thursday, friday]
+	  # one date per line, such as "2022-12-24 Christmas Eve"
+	  holidaysFile: holidays.txt
+
+With the --pomodoro flag, the report also includes the number of completed
+Pomodoro work periods per entry, as tracked by "%[1]s pomodoro start".
+
+With the --tasks flag, entries that continue each other, such as after using
+"%[1]s pause" and "%[1]s resume", are merged into one row per task, showing
+the number of segments and the total time across them.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			now := time.Now()
+			search := dinkur.SearchEntry{
+				Limit:          flagLimit,
+				Start:          flagStart.TimePtr(now),
+				End:            flagEnd.TimePtr(now),
+				Shorthand:      flagRange.TimeSpanShorthand(),
+				ShorthandCount: flagRange.Count(),
+				NameFuzzy:      strings.Join(args, " "),
+			}
+			entries, err := c.GetEntryList(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting list of entries:", err)
+			}
+			var periods []dinkur.AFKPeriod
+			if flagAway && len(entries) > 0 {
+				periods, err = c.GetAFKPeriodList(rootCtx, dinkur.SearchAFKPeriod{
+					Start: &entries[0].Start,
+					End:   latestEntryEnd(entries, now),
+				})
+				if err != nil {
+					console.PrintFatal("Error getting list of AFK periods:", err)
+				}
+			}
+			var pomodoros map[string]int
+			if flagPomodoro && len(entries) > 0 {
+				cycles, err := c.GetPomodoroCycleList(rootCtx, dinkur.SearchPomodoroCycle{
+					Start: &entries[0].Start,
+					End:   latestEntryEnd(entries, now),
+				})
+				if err != nil {
+					console.PrintFatal("Error getting list of Pomodoro cycles:", err)
+				}
+				pomodoros = pomodoroCountPerEntry(cycles)
+			}
+			report := make([]console.ReportEntry, 0, len(entries))
+			// index into report per entry UUID, used when merging tasks
+			taskIndex := make(map[string]int)
+			for _, entry := range entries {
+				if i, ok := taskIndex[entry.ContinuesUUID]; ok && flagTasks && entry.ContinuesUUID != "" {
+					report[i].Continued = append(report[i].Continued, entry)
+					report[i].Away += entryAwayDuration(entry, periods)
+					report[i].Pomodoros += pomodoros[entry.UUID]
+					taskIndex[entry.UUID] = i
+					continue
+				}
+				taskIndex[entry.UUID] = len(report)
+				report = append(report, console.ReportEntry{
+					Entry:     entry,
+					Away:      entryAwayDuration(entry, periods),
+					DayOff:    dayOffDescription(entry.Start),
+					Pomodoros: pomodoros[entry.UUID],
+				})
+			}
+			console.PrintEntryReport(report, console.ReportOptions{
+				Away:      flagAway,
+				Workdays:  flagWorkdays,
+				Pomodoros: flagPomodoro,
+				Tasks:     flagTasks,
+			})
+		},
+	}
+
+	RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().UintVarP(&flagLimit, "limit", "l", flagLimit, "limit the number of entries, relative to the last entry; 0 will disable limit")
+	reportCmd.Flags().VarP(flagStart, "start", "s", "report entries starting after or at date time")
+	reportCmd.Flags().VarP(flagEnd, "end", "e", "report entries ending before or at date time")
+	reportCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
+	reportCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	reportCmd.Flags().BoolVarP(&flagAway, "away", "a", flagAway, "include time spent away and the tracked time minus the away time")
+	reportCmd.Flags().BoolVarP(&flagWorkdays, "workdays", "w", flagWorkdays, "include day type per entry and separate totals for workdays and days off")
+	reportCmd.Flags().BoolVarP(&flagPomodoro, "pomodoro", "p", flagPomodoro, "include number of completed Pomodoro work periods per entry")
+	reportCmd.Flags().BoolVarP(&flagTasks, "tasks", "t", flagTasks, "merge paused and resumed entries into one row per task")
+}
+
+func latestEntryEnd(entries []dinkur.Entry, now time.Time) *time.Time {
+	latest := now
+	for _, entry := range entries {
+		if entry.End != nil && entry.End.After(latest) {
+			latest = *entry.End
+		}
+	}
+	return &latest
+}
+
+func entryAwayDuration(entry dinkur.Entry, periods []dinkur.AFKPeriod) time.Duration {
+	var away time.Duration
+	for _, period := range periods {
+		away += period.ElapsedWithin(entry.Start, entry.End)
+	}
+	return away
+}
+
+func pomodoroCountPerEntry(cycles []dinkur.PomodoroCycle) map[string]int {
+	counts := make(map[string]int, len(cycles))
+	for _, cycle := range cycles {
+		counts[cycle.EntryUUID]++
+	}
+	return counts
+}
@@ -0,0 +1,157 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID uint
+	)
+
+	var resumeCmd = &cobra.Command{
+		Use:     "resume [search terms]",
+		Args:    cobra.ArbitraryArgs,
+		Aliases: []string{"continue"},
+		Short:   "Resume the paused entry, or a previous entry",
+		Long: fmt.Sprintf(`Starts a new entry with the same name as the entry paused using
+"%[1]s pause", linked to the paused entry as its continuation.
+
+A previous entry can instead be resumed using the --id or -i flag, or by
+searching for it by name. If several entries with different names match the
+search, then you get to pick one of them. Unless it is the paused entry, the
+new entry only gets the same name, and is not linked to the previous entry.`, RootCmd.Name()),
+		Example: fmt.Sprintf(`  %[1]s resume
+  %[1]s resume --id 42
+  %[1]s resume code review`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			if flagID != 0 && len(args) > 0 {
+				console.PrintFatal("Error parsing flags:", "cannot use --id together with search terms")
+			}
+			status, err := c.GetStatus(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error getting status:", err)
+			}
+			resume := dinkur.ResumeEntry{IDOrZero: flagID}
+			switch {
+			case len(args) > 0:
+				resume.IDOrZero = findEntryToResume(strings.Join(args, " ")).ID
+			case flagID == 0:
+				if status.PausedEntryID == 0 {
+					fmt.Println("No paused entry to resume.")
+					os.Exit(1)
+				}
+				resume.IDOrZero = status.PausedEntryID
+			}
+			entry, err := c.GetEntry(rootCtx, resume.IDOrZero)
+			if err != nil {
+				console.PrintFatal("Error getting entry to resume:", err)
+			}
+			if checkIfDuplicateEntry(entry.Name) {
+				return
+			}
+			if resume.IDOrZero != status.PausedEntryID {
+				// only the paused entry is continued, as linking any other
+				// entry would make them count as the same task in reports
+				startedEntry, err := c.CreateEntry(rootCtx, dinkur.NewEntry{Name: entry.Name})
+				if err != nil {
+					console.PrintFatal("Error starting entry:", err)
+				}
+				printResumedEntry(startedEntry)
+				return
+			}
+			startedEntry, err := c.ResumeEntry(rootCtx, resume)
+			if err != nil {
+				console.PrintFatal("Error resuming entry:", err)
+			}
+			printResumedEntry(startedEntry)
+		},
+	}
+
+	RootCmd.AddCommand(resumeCmd)
+	resumeCmd.Flags().UintVarP(&flagID, "id", "i", 0, `ID of entry to resume (default is the paused entry)`)
+	resumeCmd.RegisterFlagCompletionFunc("id", entryIDComplete)
+	addEntryTableFlags(resumeCmd)
+}
+
+// findEntryToResume searches for past entries by name, and returns the latest
+// entry for each distinct name, prompting the user to pick one if there are
+// several.
+func findEntryToResume(nameFuzzy string) dinkur.Entry {
+	entries, err := c.GetEntryList(rootCtx, dinkur.SearchEntry{
+		Limit:     1000,
+		Shorthand: timeutil.TimeSpanPast,
+		NameFuzzy: nameFuzzy,
+	})
+	if err != nil {
+		console.PrintFatal("Error searching for entries:", err)
+	}
+	// entries are sorted oldest first, but we want the latest entry per name,
+	// with the latest shown first in the prompt.
+	var matches []dinkur.Entry
+	seen := make(map[string]struct{})
+	for i := len(entries) - 1; i >= 0; i-- {
+		key := strings.ToLower(strings.TrimSpace(entries[i].Name))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		matches = append(matches, entries[i])
+	}
+	switch len(matches) {
+	case 0:
+		fmt.Printf("No entries found matching %q.\n", nameFuzzy)
+		os.Exit(1)
+	case 1:
+		return matches[0]
+	}
+	entry, err := console.PromptEntrySelection(matches)
+	if err != nil {
+		console.PrintFatal("Prompt error:", err)
+	}
+	return entry
+}
+
+func printResumedEntry(startedEntry dinkur.StartedEntry) {
+	var toPrint []console.LabelledEntry
+	if startedEntry.Stopped != nil {
+		toPrint = append(toPrint, console.LabelledEntry{
+			Label: "Stopped entry:",
+			Entry: *startedEntry.Stopped,
+		})
+	}
+	toPrint = append(toPrint, console.LabelledEntry{
+		Label:      "Resumed entry:",
+		Entry:      startedEntry.Started,
+		NoDuration: true,
+	})
+	console.PrintEntryLabelSlice(toPrint)
+}
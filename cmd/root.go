@@ -32,6 +32,7 @@ import (
 
 	"github.com/dinkur/dinkur/internal/cfgpath"
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/fuzzytime"
 	"github.com/dinkur/dinkur/internal/license"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/dinkur/dinkur/pkg/dinkurclient"
@@ -54,6 +55,10 @@ var (
 	flagClient      = "db"
 	flagVerbose     = false
 	flagGrpcAddress = "localhost:59122"
+	flagGrpcTLS     = false
+	flagGrpcTLSCA   = ""
+	flagLocale      = ""
+	flagProfile     = ""
 
 	flagLicenseWarranty   bool
 	flagLicenseConditions bool
@@ -72,7 +77,8 @@ var RootCmd = &cobra.Command{
 Track how you spend time on your entries with Dinkur.
 `,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		switch strings.ToLower(flagColor) {
+		colorMode := viper.GetString("color")
+		switch strings.ToLower(colorMode) {
 		case "auto":
 			// Do nothing, fatih/color is on auto by default
 		case "never":
@@ -80,7 +86,7 @@ Track how you spend time on your entries with Dinkur.
 		case "always":
 			color.NoColor = false
 		default:
-			console.PrintFatal("Error parsing --color:", fmt.Errorf(`invalid value %q: only "auto", "always", or "never" may be used`, flagColor))
+			console.PrintFatal("Error parsing --color:", fmt.Errorf(`invalid value %q: only "auto", "always", or "never" may be used`, colorMode))
 		}
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -108,7 +114,7 @@ func Execute() {
 }
 
 func init() {
-	cobra.OnInitialize(initLogger, initConfig, initContext)
+	cobra.OnInitialize(readConfig, initLogger, initConfig, initContext)
 
 	RootCmd.SetOut(colorable.NewColorableStdout())
 	RootCmd.SetErr(colorable.NewColorableStderr())
@@ -125,32 +131,80 @@ func init() {
 	RootCmd.RegisterFlagCompletionFunc("client", clientComplete)
 	RootCmd.PersistentFlags().BoolVarP(&flagVerbose, "verbose", "v", flagVerbose, `enables debug logging`)
 	RootCmd.PersistentFlags().StringVar(&flagGrpcAddress, "grpc-address", flagGrpcAddress, `address of Dinkur daemon gRPC API`)
+	RootCmd.RegisterFlagCompletionFunc("grpc-address", daemonAddressComplete)
+	RootCmd.PersistentFlags().BoolVar(&flagGrpcTLS, "grpc-tls", flagGrpcTLS, `use TLS when connecting to the Dinkur daemon gRPC API`)
+	RootCmd.PersistentFlags().StringVar(&flagGrpcTLSCA, "grpc-tls-ca-file", flagGrpcTLSCA, `certificate authority file used to verify the Dinkur daemon's TLS certificate (default is system certificates)`)
+	RootCmd.PersistentFlags().StringVar(&flagLocale, "locale", flagLocale, `locale used when parsing fuzzy times, such as "en" or "sv" (default from $LANG)`)
+	RootCmd.RegisterFlagCompletionFunc("locale", localeComplete)
+	RootCmd.PersistentFlags().StringVar(&flagProfile, "profile", flagProfile, `named profile from the config file, overriding settings such as --data and --grpc-address`)
+	RootCmd.RegisterFlagCompletionFunc("profile", profileComplete)
 
-	//viper.BindPFlag("data", RootCmd.PersistentFlags().Lookup("data"))
-	//viper.BindPFlag("data-mkdir", RootCmd.PersistentFlags().Lookup("data-mkdir"))
+	viper.BindPFlag("data", RootCmd.PersistentFlags().Lookup("data"))
+	viper.BindPFlag("dataMkdir", RootCmd.PersistentFlags().Lookup("data-mkdir"))
+	viper.BindPFlag("color", RootCmd.PersistentFlags().Lookup("color"))
 	viper.BindPFlag("client", RootCmd.PersistentFlags().Lookup("client"))
-	//viper.SetDefault("data", dataFile)
-	//viper.SetDefault("data-mkdir", flagDataMkdir)
+	viper.BindPFlag("verbose", RootCmd.PersistentFlags().Lookup("verbose"))
+	viper.BindPFlag("grpcAddress", RootCmd.PersistentFlags().Lookup("grpc-address"))
+	viper.BindPFlag("grpcTLS", RootCmd.PersistentFlags().Lookup("grpc-tls"))
+	viper.BindPFlag("grpcTLSCAFile", RootCmd.PersistentFlags().Lookup("grpc-tls-ca-file"))
+	viper.BindPFlag("locale", RootCmd.PersistentFlags().Lookup("locale"))
+	viper.BindPFlag("profile", RootCmd.PersistentFlags().Lookup("profile"))
 	viper.SetDefault("client", flagClient)
 }
 
-// initConfig reads in config file and ENV variables if set.
-func initConfig() {
+// readConfig reads in config file and ENV variables if set. Environment
+// variables are prefixed with DINKUR_ and use underscores instead of dots,
+// such as DINKUR_DAEMON_AFK_THRESHOLD for the "daemon.afk.threshold" key.
+func readConfig() {
+	if path, ok := os.LookupEnv("DINKUR_CONFIG"); ok && !RootCmd.PersistentFlags().Changed("config") {
+		cfgFile = path
+	}
 	viper.SetConfigFile(cfgFile)
 
+	viper.SetEnvPrefix("dinkur")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv() // read in environment variables that match
 
 	// If a config file is found, read it in.
-	if err := viper.ReadInConfig(); err == nil {
-		log.Debug().WithString("config", viper.ConfigFileUsed()).Message("Using config file.")
-	} else if !errors.As(err, &viper.ConfigFileNotFoundError{}) && !errors.Is(err, os.ErrNotExist) {
+	if err := viper.ReadInConfig(); err != nil &&
+		!errors.As(err, &viper.ConfigFileNotFoundError{}) && !errors.Is(err, os.ErrNotExist) {
+		console.PrintFatal("Error reading config:", err)
+	}
+
+	if err := applyProfile(viper.GetString("profile")); err != nil {
 		console.PrintFatal("Error reading config:", err)
 	}
 }
 
+// initConfig applies the config that was read by readConfig.
+func initConfig() {
+	if used := viper.ConfigFileUsed(); used != "" {
+		if _, err := os.Stat(used); err == nil {
+			log.Debug().WithString("config", used).Message("Using config file.")
+		}
+	}
+
+	if locale := viper.GetString("locale"); locale != "" {
+		if err := fuzzytime.SetLocale(locale); err != nil {
+			console.PrintFatal("Error setting locale:", err)
+		}
+	}
+	log.Debug().WithString("locale", fuzzytime.CurrentLocale().Name).Message("Using locale for fuzzy time parsing.")
+
+	cal, err := calendarFromConfig()
+	if err != nil {
+		console.PrintFatal("Error reading calendar config:", err)
+	}
+	calendar = cal
+
+	if err := initEntryTableConfig(); err != nil {
+		console.PrintFatal("Error reading table config:", err)
+	}
+}
+
 func initLogger() {
 	level := logger.LevelInfo
-	if flagVerbose {
+	if viper.GetBool("verbose") {
 		level = logger.LevelDebug
 	}
 	prettyConf := consolepretty.DefaultConfig
@@ -165,6 +219,15 @@ func initContext() {
 }
 
 func connectClientOrExit() {
+	connectClientOrExitNoAFKCheck()
+	if strings.EqualFold(viper.GetString("client"), "grpc") {
+		checkStatusForAFK(c)
+	}
+}
+
+// connectClientOrExitNoAFKCheck is the same as connectClientOrExit, but does
+// not prompt the user to resolve any AFK status.
+func connectClientOrExitNoAFKCheck() {
 	client, err := connectClient(false)
 	if err != nil {
 		console.PrintFatal("Error connecting to client:", err)
@@ -189,19 +252,22 @@ func connectClient(skipMigrate bool) (dinkur.Client, error) {
 		}
 		return grpcClient, nil
 	default:
-		return nil, fmt.Errorf(`invalid value %q: only "db" or "grpc" may be used`, flagClient)
+		return nil, fmt.Errorf(`invalid value %q: only "db" or "grpc" may be used`, viper.GetString("client"))
 	}
 }
 
 func connectToGRPCClient() (dinkur.Client, error) {
-	c := dinkurclient.NewClient(flagGrpcAddress, dinkurclient.Options{})
+	c := dinkurclient.NewClient(viper.GetString("grpcAddress"), dinkurclient.Options{
+		TLS:       viper.GetBool("grpcTLS") || viper.GetString("grpcTLSCAFile") != "",
+		TLSCAFile: viper.GetString("grpcTLSCAFile"),
+		Profile:   viper.GetString("profile"),
+	})
 	if err := c.Connect(rootCtx); err != nil {
 		return nil, err
 	}
 	if err := c.Ping(rootCtx); err != nil {
 		return nil, fmt.Errorf("attempting ping: %w", err)
 	}
-	checkStatusForAFK(c)
 	return c, nil
 }
 
@@ -229,34 +295,28 @@ func promptAFKResolution(c dinkur.Client, activeEntry dinkur.Entry, afkSince tim
 	if err != nil {
 		console.PrintFatal("Prompt error:", err)
 	}
-	if res.Edit != nil {
-		update, err := c.UpdateEntry(rootCtx, *res.Edit)
-		if err != nil {
-			console.PrintFatal("Error editing entry:", err)
-		}
-		console.PrintEntryEdit(update)
-		fmt.Println()
-	}
-	if res.NewEntry != nil {
-		startedEntry, err := c.CreateEntry(rootCtx, *res.NewEntry)
-		if err != nil {
-			console.PrintFatal("Error starting entry:", err)
-		}
-		printStartedEntry(startedEntry)
-		fmt.Println()
-	}
-	if err := c.SetStatus(rootCtx, dinkur.EditStatus{}); err != nil {
-		console.PrintFatal("Error removing AFK status:", err)
+	resolved, err := c.ResolveAFK(rootCtx, dinkur.ResolveAFK{
+		Resolution: res.Resolution,
+		NewEntries: res.NewEntries,
+	})
+	if err != nil {
+		console.PrintFatal("Error resolving AFK status:", err)
 	}
+	printResolvedAFK(resolved)
 	fmt.Println("Continuing with command...")
 	fmt.Println()
 }
 
 func connectToDBClient(skipMigrate bool) (dinkur.Client, error) {
-	c := dinkurdb.NewClient(dataFile, dinkurdb.Options{
-		MkdirAll:             flagDataMkdir,
-		DebugLogging:         flagVerbose,
+	return connectToDBClientPath(cfgpath.ExpandHome(viper.GetString("data")), skipMigrate)
+}
+
+func connectToDBClientPath(dataPath string, skipMigrate bool) (dinkur.Client, error) {
+	c := dinkurdb.NewClient(dataPath, dinkurdb.Options{
+		MkdirAll:             viper.GetBool("dataMkdir"),
+		DebugLogging:         viper.GetBool("verbose"),
 		SkipMigrateOnConnect: skipMigrate,
+		Calendar:             &calendar,
 	})
 	return c, c.Connect(rootCtx)
 }
@@ -276,6 +336,15 @@ func clientComplete(*cobra.Command, []string, string) ([]string, cobra.ShellComp
 	}, cobra.ShellCompDirectiveDefault
 }
 
+func localeComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	locales := fuzzytime.Locales()
+	completions := make([]string, len(locales))
+	for i, locale := range locales {
+		completions[i] = fmt.Sprintf("%s\t%s", locale.Name, locale.Description)
+	}
+	return completions, cobra.ShellCompDirectiveDefault
+}
+
 func entryIDComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
 	client, err := connectClient(true)
 	if err != nil {
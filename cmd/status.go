@@ -21,46 +21,325 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/spf13/cobra"
 )
 
-// statusCmd represents the status command
-var statusCmd = &cobra.Command{
-	Use:     "status",
-	Args:    cobra.NoArgs,
-	Aliases: []string{"s"},
-	Short:   "Show status of active entry",
-	Long:    ``,
-	Run: func(cmd *cobra.Command, args []string) {
-		connectClientOrExit()
-		activeEntry, err := c.GetActiveEntry(rootCtx)
-		if err != nil {
-			console.PrintFatal("Error getting active entry:", err)
+const defaultStatusTemplate = `{{with .Entry}}{{.Name}} {{duration $.Elapsed}}{{end}}`
+
+func init() {
+	var (
+		flagOutput       = "pretty"
+		flagTemplate     = defaultStatusTemplate
+		flagTemplateFile = ""
+		flagFollow       = false
+		flagInterval     = time.Minute
+	)
+
+	var statusCmd = &cobra.Command{
+		Use:     "status",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"s"},
+		Short:   "Show status of active entry",
+		Long: fmt.Sprintf(`Shows the active entry and whether you are away from keyboard (AFK).
+
+When the Dinkur daemon sees that you have been at the keyboard for a while
+without any active entry, a suggested entry name is shown, based on your most
+recent entries.
+
+The --output flag can be used to feed status bars, such as waybar, polybar,
+i3blocks, or tmux. Together with the --follow flag a new line is printed
+every time the active entry or the AFK status changes, as well as on the
+interval set by --interval, so the elapsed time stays up to date.
+
+	%[1]s status -o json                      # entry, elapsed seconds, and AFK state
+	%[1]s status -o waybar --follow           # waybar custom module, with "return-type": "json"
+	%[1]s status -o template                  # same as --template '%[2]s'
+	%[1]s status --template '{{if .AFK}}AFK{{else}}{{with .Entry}}{{.Name}}{{end}}{{end}}'
+
+Setting --template or --template-file implies --output=template. The template
+is given the same fields as the JSON output: .Entry (nil if there is no active
+entry), .Elapsed, .ElapsedSeconds, .AFK, .AFKSince, .BackSince, .NotTracking,
+.NotTrackingSince, .SuggestedEntryName, and .PausedEntry (nil if there is no
+entry paused using "%[1]s pause").
+
+%[3]s
+
+When using the database client, --follow only picks up changes made by other
+processes on the --interval. Use --client=grpc for instant updates.
+`, RootCmd.Name(), defaultStatusTemplate, templateFuncsHelp),
+		Run: func(cmd *cobra.Command, args []string) {
+			if (cmd.Flags().Changed("template") || cmd.Flags().Changed("template-file")) &&
+				!cmd.Flags().Changed("output") {
+				flagOutput = "template"
+			}
+			printer, err := newStatusPrinter(flagOutput, flagTemplate, flagTemplateFile)
+			if err != nil {
+				console.PrintFatal("Error parsing --output:", err)
+			}
+			if printer.pretty {
+				connectClientOrExit()
+			} else {
+				// must not block status bars with an interactive prompt
+				connectClientOrExitNoAFKCheck()
+			}
+			if flagFollow {
+				if err := followStatus(c, printer, flagInterval); err != nil {
+					console.PrintFatal("Error following status:", err)
+				}
+				return
+			}
+			st, err := fetchStatusOutput(c)
+			if err != nil {
+				console.PrintFatal("Error getting status:", err)
+			}
+			if err := printer.print(st); err != nil {
+				console.PrintFatal("Error printing status:", err)
+			}
+		},
+	}
+
+	RootCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "waybar", "template"`)
+	statusCmd.RegisterFlagCompletionFunc("output", statusOutputFormatComplete)
+	statusCmd.Flags().StringVarP(&flagTemplate, "template", "t", flagTemplate, `Go text/template used by --output=template`)
+	statusCmd.Flags().StringVar(&flagTemplateFile, "template-file", "", `read Go text/template used by --output=template from file`)
+	statusCmd.MarkFlagsMutuallyExclusive("template", "template-file")
+	statusCmd.Flags().BoolVarP(&flagFollow, "follow", "f", flagFollow, "print a new status every time it changes")
+	addEntryTableFlags(statusCmd)
+	statusCmd.Flags().DurationVar(&flagInterval, "interval", flagInterval, "how often to print the status when using --follow, in addition to when it changes; 0 disables")
+}
+
+func statusOutputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"pretty\thuman readable and colored table formatting (default)",
+		"json\tJSON object with the active entry, elapsed seconds, and AFK state",
+		"waybar\tJSON object for waybar custom modules, with text, tooltip, and class",
+		"template\tformatted using the Go text/template given by --template",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+type statusOutput struct {
+	Entry          *dinkur.Entry `json:"entry"`
+	Elapsed        time.Duration `json:"-"`
+	ElapsedSeconds int64         `json:"elapsedSeconds"`
+	AFK            bool          `json:"afk"`
+	AFKSince       *time.Time    `json:"afkSince"`
+	BackSince      *time.Time    `json:"backSince"`
+
+	NotTracking        bool       `json:"notTracking"`
+	NotTrackingSince   *time.Time `json:"notTrackingSince"`
+	SuggestedEntryName string     `json:"suggestedEntryName"`
+
+	PausedEntry *dinkur.Entry `json:"pausedEntry"`
+}
+
+func newStatusOutput(entry, pausedEntry *dinkur.Entry, status dinkur.Status) statusOutput {
+	st := statusOutput{
+		Entry:       entry,
+		AFK:         status.AFKSince != nil && status.BackSince == nil,
+		AFKSince:    status.AFKSince,
+		BackSince:   status.BackSince,
+		PausedEntry: pausedEntry,
+	}
+	if entry == nil && status.NotTrackingSince != nil {
+		st.NotTracking = true
+		st.NotTrackingSince = status.NotTrackingSince
+		st.SuggestedEntryName = status.SuggestedEntryName
+	}
+	if entry != nil {
+		st.Elapsed = entry.Elapsed()
+		st.ElapsedSeconds = int64(st.Elapsed.Seconds())
+	}
+	return st
+}
+
+func fetchStatusOutput(c dinkur.Client) (statusOutput, error) {
+	entry, err := c.GetActiveEntry(rootCtx)
+	if err != nil {
+		return statusOutput{}, fmt.Errorf("get active entry: %w", err)
+	}
+	status, err := c.GetStatus(rootCtx)
+	if err != nil {
+		return statusOutput{}, fmt.Errorf("get status: %w", err)
+	}
+	pausedEntry, err := fetchPausedEntry(c, status)
+	if err != nil {
+		return statusOutput{}, err
+	}
+	return newStatusOutput(entry, pausedEntry, status), nil
+}
+
+func fetchPausedEntry(c dinkur.Client, status dinkur.Status) (*dinkur.Entry, error) {
+	if status.PausedEntryID == 0 {
+		return nil, nil
+	}
+	entry, err := c.GetEntry(rootCtx, status.PausedEntryID)
+	if errors.Is(err, dinkur.ErrNotFound) {
+		// the paused entry has been removed since it was paused
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get paused entry: %w", err)
+	}
+	return &entry, nil
+}
+
+type waybarOutput struct {
+	Text    string `json:"text"`
+	Alt     string `json:"alt"`
+	Tooltip string `json:"tooltip"`
+	Class   string `json:"class"`
+}
+
+func newWaybarOutput(st statusOutput) waybarOutput {
+	var out waybarOutput
+	var tooltip []string
+	if st.Entry != nil {
+		out.Text = fmt.Sprintf("%s %s", st.Entry.Name, console.FormatDuration(st.Elapsed))
+		out.Class = "active"
+		tooltip = append(tooltip, fmt.Sprintf("#%d %s\nStarted at %s (%s)",
+			st.Entry.ID, st.Entry.Name,
+			st.Entry.Start.Format("15:04"), console.FormatDuration(st.Elapsed)))
+	} else {
+		out.Class = "inactive"
+		tooltip = append(tooltip, "You have no active entry.")
+		if st.PausedEntry != nil {
+			out.Class = "paused"
+			tooltip = append(tooltip, fmt.Sprintf("Paused: %s", st.PausedEntry.Name))
 		}
-		if activeEntry != nil {
-			console.PrintEntryLabel(console.LabelledEntry{
-				Label: "Current entry:",
-				Entry: *activeEntry,
-			})
-		} else {
-			fmt.Println("You have no active entry.")
+	}
+	if st.NotTracking {
+		out.Class = "not-tracking"
+		tooltip = append(tooltip, fmt.Sprintf("Not tracking since %s", st.NotTrackingSince.Format("15:04")))
+		if st.SuggestedEntryName != "" {
+			tooltip = append(tooltip, fmt.Sprintf("Suggestion: %s", st.SuggestedEntryName))
 		}
-	},
+	}
+	if st.AFK {
+		out.Class = "afk"
+		tooltip = append(tooltip, fmt.Sprintf("Away since %s", st.AFKSince.Format("15:04")))
+	}
+	out.Alt = out.Class
+	out.Tooltip = strings.Join(tooltip, "\n")
+	return out
 }
 
-func init() {
-	RootCmd.AddCommand(statusCmd)
+type statusPrinter struct {
+	pretty bool
+	print  func(statusOutput) error
+}
 
-	// Here you will define your flags and configuration settings.
+func newStatusPrinter(output, tmplText, tmplFile string) (statusPrinter, error) {
+	switch strings.ToLower(output) {
+	case "pretty":
+		return statusPrinter{pretty: true, print: printStatusPretty}, nil
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		return statusPrinter{print: func(st statusOutput) error {
+			return enc.Encode(st)
+		}}, nil
+	case "waybar":
+		enc := json.NewEncoder(os.Stdout)
+		return statusPrinter{print: func(st statusOutput) error {
+			return enc.Encode(newWaybarOutput(st))
+		}}, nil
+	case "template":
+		tmpl, err := parseOutputTemplate(tmplText, tmplFile)
+		if err != nil {
+			return statusPrinter{}, err
+		}
+		return statusPrinter{print: func(st statusOutput) error {
+			return tmpl.execute(os.Stdout, st)
+		}}, nil
+	default:
+		return statusPrinter{}, fmt.Errorf("invalid output format: %q", output)
+	}
+}
 
-	// Cobra supports Persistent Flags which will work for this command
-	// and all subcommands, e.g.:
-	// statusCmd.PersistentFlags().String("foo", "", "A help for foo")
+func printStatusPretty(st statusOutput) error {
+	if st.Entry != nil {
+		console.PrintEntryLabel(console.LabelledEntry{
+			Label: "Current entry:",
+			Entry: *st.Entry,
+		})
+	} else if st.PausedEntry != nil {
+		console.PrintEntryLabel(console.LabelledEntry{
+			Label: "Paused entry:",
+			Entry: *st.PausedEntry,
+		})
+	} else {
+		fmt.Println("You have no active entry.")
+	}
+	if st.NotTracking {
+		console.PrintNotTracking(*st.NotTrackingSince, st.SuggestedEntryName)
+	}
+	return nil
+}
 
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// statusCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+func followStatus(c dinkur.Client, printer statusPrinter, interval time.Duration) error {
+	entries, err := c.StreamEntry(rootCtx)
+	if err != nil {
+		return fmt.Errorf("stream entries: %w", err)
+	}
+	statuses, err := c.StreamStatus(rootCtx)
+	if err != nil {
+		return fmt.Errorf("stream statuses: %w", err)
+	}
+	st, err := fetchStatusOutput(c)
+	if err != nil {
+		return err
+	}
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		if err := printer.print(st); err != nil {
+			return err
+		}
+		select {
+		case <-rootCtx.Done():
+			return nil
+		case <-tick:
+			if st.Entry != nil {
+				st.Elapsed = st.Entry.Elapsed()
+				st.ElapsedSeconds = int64(st.Elapsed.Seconds())
+			}
+		case _, ok := <-entries:
+			if !ok {
+				return errors.New("entry stream closed")
+			}
+			entry, err := c.GetActiveEntry(rootCtx)
+			if err != nil {
+				return fmt.Errorf("get active entry: %w", err)
+			}
+			st = newStatusOutput(entry, st.PausedEntry, dinkur.Status{
+				AFKSince:           st.AFKSince,
+				BackSince:          st.BackSince,
+				NotTrackingSince:   st.NotTrackingSince,
+				SuggestedEntryName: st.SuggestedEntryName,
+			})
+		case ev, ok := <-statuses:
+			if !ok {
+				return errors.New("status stream closed")
+			}
+			pausedEntry, err := fetchPausedEntry(c, ev.Status)
+			if err != nil {
+				return err
+			}
+			st = newStatusOutput(st.Entry, pausedEntry, ev.Status)
+		}
+	}
 }
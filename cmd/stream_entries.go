@@ -22,10 +22,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // streamEntriesCmd represents the test command
@@ -34,7 +36,7 @@ var streamEntriesCmd = &cobra.Command{
 	Args:  cobra.NoArgs,
 	Short: "Testing entry streaming",
 	Run: func(cmd *cobra.Command, args []string) {
-		if flagClient != "grpc" {
+		if !strings.EqualFold(viper.GetString("client"), "grpc") {
 			console.PrintFatal("Error running test:", `--client must be set to "grpc"`)
 		}
 		connectClientOrExit()
@@ -23,10 +23,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // streamStatusCmd represents the test command
@@ -35,7 +37,7 @@ var streamStatusCmd = &cobra.Command{
 	Args:  cobra.NoArgs,
 	Short: "Testing status streaming",
 	Run: func(cmd *cobra.Command, args []string) {
-		if flagClient != "grpc" {
+		if !strings.EqualFold(viper.GetString("client"), "grpc") {
 			console.PrintFatal("Error running test:", `--client must be set to "grpc"`)
 		}
 		connectClientOrExit()
@@ -0,0 +1,119 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/cfgpath"
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/dinkurclient"
+	"github.com/dinkur/dinkur/pkg/pairing"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagTLS           = false
+		flagTLSCAFile     = ""
+		flagRemoteProfile = ""
+	)
+
+	var syncCmd = &cobra.Command{
+		Use:               "sync <address|peer>",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: syncTargetComplete,
+		Short:             "Sync entries with a remote Dinkur daemon",
+		Long: fmt.Sprintf(`Exchanges entry changes with a remote Dinkur daemon, such as a daemon running
+on another computer or on your phone, so that both end up with the same entries.
+
+Every change to an entry is recorded, and only the changes the other side does
+not yet know about are sent. Syncing can be done in any order and as often as
+you like, and all synced databases eventually end up with the same entries.
+
+Conflicting edits to the same field of an entry are resolved by keeping the
+most recent edit. If an entry is edited on one side while deleted on the other,
+then the edited entry is kept.
+
+The local database is selected as usual, via the --client, --data, and
+--profile flags. For example, to sync your local database with the daemon on
+your laptop:
+
+	%[1]s sync --tls laptop.local:59122
+
+Daemons only accept sync requests from other computers that are paired using
+"%[1]s pair", unless the daemon is started with --pairing-require-token=false.
+
+Daemons on the local network that are advertised via mDNS can be listed using
+"%[1]s daemon discover", and are also suggested by the shell completion.
+
+Daemons paired using "%[1]s pair" can be synced by name, in which case the
+paired daemon's address, pinned TLS certificate, and token are used:
+
+	%[1]s sync laptop
+
+To sync via a git repository instead, see "%[1]s sync git --help".`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExitNoAFKCheck()
+			address := args[0]
+			opt := dinkurclient.Options{
+				TLS:       flagTLS || flagTLSCAFile != "",
+				TLSCAFile: flagTLSCAFile,
+				Profile:   flagRemoteProfile,
+			}
+			peer, err := pairing.NewStore(cfgpath.PeersPath).Peer(args[0])
+			if err == nil {
+				log.Debug().WithString("peer", peer.Name).WithString("address", peer.Address).
+					Message("Syncing with paired daemon.")
+				address = peer.Address
+				opt.TLS = true
+				opt.TLSFingerprint = peer.TLSFingerprint
+				opt.PeerToken = peer.Token
+			} else if !errors.Is(err, pairing.ErrPeerNotFound) {
+				console.PrintFatal("Error reading paired daemons:", err)
+			}
+			remote := dinkurclient.NewClient(address, opt)
+			if err := remote.Connect(rootCtx); err != nil {
+				console.PrintFatal("Error connecting to remote daemon:", err)
+			}
+			defer remote.Close()
+			result, err := dinkur.SyncClients(rootCtx, c, remote)
+			if err != nil {
+				console.PrintFatal("Error syncing entries:", err)
+			}
+			console.PrintSyncResult(address, result)
+		},
+	}
+
+	RootCmd.AddCommand(syncCmd)
+	syncCmd.AddCommand(newSyncGitCmd())
+	syncCmd.Flags().BoolVar(&flagTLS, "tls", flagTLS, "use TLS when connecting to the remote Dinkur daemon")
+	syncCmd.Flags().StringVar(&flagTLSCAFile, "tls-ca-file", flagTLSCAFile, "certificate authority file used to verify the remote Dinkur daemon's TLS certificate (default is system certificates)")
+	syncCmd.Flags().StringVar(&flagRemoteProfile, "remote-profile", flagRemoteProfile, "name of the remote Dinkur daemon's profile to sync with (default is the daemon's default profile)")
+}
+
+func syncTargetComplete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	peers, _ := peerNameComplete(cmd, args, toComplete)
+	addrs, _ := daemonAddressComplete(cmd, args, toComplete)
+	return append(peers, addrs...), cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,249 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/textsync"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var errNoSyncGitDir = errors.New(`no directory given, and the "sync.git.dir" config key is not set`)
+
+// gitAttributesLine makes git keep both sides' lines when merging concurrent
+// edits, which are then resolved when importing.
+const gitAttributesLine = "*" + textsync.FileExt + " merge=union"
+
+func newSyncGitCmd() *cobra.Command {
+	var (
+		flagGit = true
+	)
+
+	var syncGitCmd = &cobra.Command{
+		Use:   "git [directory]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Sync entries via a directory of text files in a git repository",
+		Long: fmt.Sprintf(`Mirrors the database to a directory of line-oriented text files, that can be
+committed to git, for syncing entries between computers without running a
+Dinkur daemon on each of them.
+
+Each file holds the entries that started in a given month, such as
+"2022-07%[2]s", with one JSON-encoded entry per line, identified by a stable ID.
+
+By default, the command does the following in the directory:
+
+ 1. Runs "git pull", if the repository has a remote
+ 2. Imports all changes from the files into the database
+ 3. Writes the database's entries back to the files
+ 4. Commits the files, and runs "git push" if the repository has a remote
+
+The directory is initialized as a git repository if it is not already part of
+one. Branches without an upstream are pulled from and pushed to the branch of
+the same name, if the repository has a single remote. A ".gitattributes" file is added that makes git keep both versions of
+concurrently edited entries when merging, which are then resolved the same way
+as "%[1]s sync", where the latest edit of each field wins. Entries edited on
+one computer while deleted on another are kept.
+
+The directory can also be set using the "sync.git.dir" config key:
+
+	%[1]s config set sync.git.dir ~/notes/dinkur
+	%[1]s sync git
+
+Use --git=false to only import and write the files, such as when syncing the
+directory some other way.`, RootCmd.Name(), textsync.FileExt),
+		Run: func(cmd *cobra.Command, args []string) {
+			dir := viper.GetString("sync.git.dir")
+			if len(args) > 0 {
+				dir = args[0]
+			}
+			if dir == "" {
+				console.PrintFatal("Error syncing entries:", errNoSyncGitDir)
+			}
+			connectClientOrExitNoAFKCheck()
+			if flagGit {
+				if err := gitPrepareSyncDir(dir); err != nil {
+					console.PrintFatal("Error preparing git repository:", err)
+				}
+			}
+			result, err := textsync.Sync(rootCtx, c, dir)
+			if err != nil {
+				console.PrintFatal("Error syncing entries:", err)
+			}
+			if flagGit {
+				if err := gitCommitSyncDir(dir); err != nil {
+					console.PrintFatal("Error committing to git repository:", err)
+				}
+			}
+			console.PrintTextSyncResult(dir, result)
+		},
+	}
+
+	syncGitCmd.Flags().BoolVar(&flagGit, "git", flagGit, "run git pull, commit, and push in the directory")
+	return syncGitCmd
+}
+
+// gitPrepareSyncDir initializes the git repository if needed, and pulls any
+// changes. Merge conflicts are left for the import and commit to resolve.
+func gitPrepareSyncDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if _, err := runGit(dir, "rev-parse", "--is-inside-work-tree"); err != nil {
+		log.Info().WithString("dir", dir).Message("Initializing git repository.")
+		if _, err := runGit(dir, "init", "--quiet"); err != nil {
+			return err
+		}
+	}
+	pullArgs := []string{"pull", "--quiet", "--no-rebase", "--no-edit"}
+	if !gitHasUpstream(dir) {
+		remote, branch, ok := gitRemoteBranch(dir)
+		if !ok {
+			log.Debug().Message("Git branch has no upstream. Skipping pull.")
+			return ensureGitAttributes(dir)
+		}
+		// also works for new repositories without any commits, unlike
+		// setting the upstream before pulling
+		pullArgs = append(pullArgs, remote, branch)
+	}
+	if _, err := runGit(dir, pullArgs...); err != nil {
+		conflicts, lsErr := runGit(dir, "ls-files", "--unmerged")
+		if lsErr != nil || conflicts == "" {
+			return err
+		}
+		log.Warn().Message("Git pull resulted in conflicts. Resolving them by importing both sides.")
+	}
+	// added after pulling, as the pulled commits may already have the file
+	return ensureGitAttributes(dir)
+}
+
+// gitCommitSyncDir commits any changed files, and pushes if the branch has an
+// upstream. Only files inside the sync directory are committed, in case it is
+// part of a larger repository with other changes staged.
+func gitCommitSyncDir(dir string) error {
+	if _, err := runGit(dir, "add", "--all", "--", "."); err != nil {
+		return err
+	}
+	hostname, _ := os.Hostname()
+	msg := fmt.Sprintf("Sync Dinkur entries from %s", hostname)
+	if gitIsMerging(dir) {
+		// git does not allow committing only some paths when concluding a
+		// merge, which was started by pulling
+		if _, err := runGit(dir, "commit", "--quiet", "--message", msg); err != nil {
+			return err
+		}
+	} else if _, err := runGit(dir, "diff", "--cached", "--quiet", "--", "."); err != nil {
+		if _, err := runGit(dir, "commit", "--quiet", "--message", msg, "--", "."); err != nil {
+			return err
+		}
+	}
+	if gitHasUpstream(dir) {
+		_, err := runGit(dir, "push", "--quiet")
+		return err
+	}
+	remote, ok := gitSingleRemote(dir)
+	if !ok {
+		log.Debug().Message("Git branch has no upstream. Skipping push.")
+		return nil
+	}
+	_, err := runGit(dir, "push", "--quiet", "--set-upstream", remote, "HEAD")
+	return err
+}
+
+func ensureGitAttributes(dir string) error {
+	path := filepath.Join(dir, ".gitattributes")
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == gitAttributesLine {
+			return nil
+		}
+	}
+	if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		content = append(content, '\n')
+	}
+	content = append(content, gitAttributesLine+"\n"...)
+	return os.WriteFile(path, content, 0644)
+}
+
+func gitHasUpstream(dir string) bool {
+	_, err := runGit(dir, "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
+	return err == nil
+}
+
+// gitSingleRemote returns the repository's remote, if it has exactly one.
+func gitSingleRemote(dir string) (string, bool) {
+	remotes, err := runGit(dir, "remote")
+	if err != nil || remotes == "" || strings.Contains(remotes, "\n") {
+		return "", false
+	}
+	return remotes, true
+}
+
+// gitRemoteBranch returns the branch with the same name as the current branch
+// on the repository's only remote, if it exists.
+func gitRemoteBranch(dir string) (remote, branch string, ok bool) {
+	remote, ok = gitSingleRemote(dir)
+	if !ok {
+		return "", "", false
+	}
+	branch, err := runGit(dir, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", "", false
+	}
+	if _, err := runGit(dir, "fetch", "--quiet", remote); err != nil {
+		log.Warn().WithError(err).Message("Failed to fetch from git remote.")
+		return "", "", false
+	}
+	if _, err := runGit(dir, "rev-parse", "--quiet", "--verify", "refs/remotes/"+remote+"/"+branch); err != nil {
+		return "", "", false
+	}
+	return remote, branch, true
+}
+
+func gitIsMerging(dir string) bool {
+	_, err := runGit(dir, "rev-parse", "--quiet", "--verify", "MERGE_HEAD")
+	return err == nil
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(rootCtx, "git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
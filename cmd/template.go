@@ -0,0 +1,130 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dinkur/dinkur/internal/console"
+)
+
+// templateEscapeReplacer unescapes the most common escape sequences, as shell
+// quoting makes it tedious to pass literal tabs and newlines in flags.
+var templateEscapeReplacer = strings.NewReplacer(`\\`, `\`, `\t`, "\t", `\n`, "\n")
+
+var templateFuncs = template.FuncMap{
+	"duration": console.FormatDuration,
+	"time":     templateFormatTime,
+	"rfc3339": func(t any) (string, error) {
+		return templateFormatTime(time.RFC3339, t)
+	},
+	"pad":     templatePadRight,
+	"padLeft": templatePadLeft,
+}
+
+const templateFuncsHelp = `Templates have access to the following functions:
+
+	duration <duration>        # format as h:mm:ss, e.g: {{duration .Elapsed}}
+	time <layout> <time>       # format using Go time layout, e.g: {{time "15:04" .Start}}
+	rfc3339 <time>             # format as RFC3339, e.g: {{rfc3339 .Start}}
+	pad <width> <value>        # pad with spaces on the right, e.g: {{pad 20 .Name}}
+	padLeft <width> <value>    # pad with spaces on the left, e.g: {{padLeft 4 .ID}}
+
+Nil times, such as the end time of an active entry, are formatted as empty
+strings. The escape sequences \t and \n are unescaped in the --template flag,
+but not in --template-file. A trailing newline is added unless the template
+already ends with one.`
+
+type outputTemplate struct {
+	tmpl    *template.Template
+	newline bool
+}
+
+// parseOutputTemplate parses the --template-file flag value if set,
+// or else the --template flag value.
+func parseOutputTemplate(text, file string) (outputTemplate, error) {
+	if file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return outputTemplate{}, fmt.Errorf("read --template-file: %w", err)
+		}
+		text = string(b)
+	} else {
+		text = templateEscapeReplacer.Replace(text)
+	}
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return outputTemplate{}, fmt.Errorf("parse template: %w", err)
+	}
+	return outputTemplate{
+		tmpl:    tmpl,
+		newline: !strings.HasSuffix(text, "\n"),
+	}, nil
+}
+
+func (t outputTemplate) execute(w io.Writer, data any) error {
+	if err := t.tmpl.Execute(w, data); err != nil {
+		return err
+	}
+	if t.newline {
+		_, err := fmt.Fprintln(w)
+		return err
+	}
+	return nil
+}
+
+func templateFormatTime(layout string, t any) (string, error) {
+	switch t := t.(type) {
+	case time.Time:
+		return t.Format(layout), nil
+	case *time.Time:
+		if t == nil {
+			return "", nil
+		}
+		return t.Format(layout), nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("expected time, got %T", t)
+	}
+}
+
+func templatePadRight(width int, v any) string {
+	s := fmt.Sprint(v)
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+	return s
+}
+
+func templatePadLeft(width int, v any) string {
+	s := fmt.Sprint(v)
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return strings.Repeat(" ", n) + s
+	}
+	return s
+}
@@ -0,0 +1,397 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/mattn/go-colorable"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var (
+	errWatchNoEntryToRestart = errors.New("no entry to restart")
+	errWatchNoActiveEntry    = errors.New("you have no active entry")
+)
+
+var watchKeybindings = []console.WatchKeybinding{
+	{Key: "s", Description: "stop"},
+	{Key: "r", Description: "restart"},
+	{Key: "n", Description: "rename"},
+	{Key: "q", Description: "quit"},
+}
+
+const (
+	keyCtrlC     = 3
+	keyBackspace = 8
+	keyEnter     = 13
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+func init() {
+	var (
+		flagRefresh = 10 * time.Second
+	)
+
+	var watchCmd = &cobra.Command{
+		Use:     "watch",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"w", "top"},
+		Short:   "Live-updating view of the active entry and today's entries",
+		Long: `Shows a live-updating view of the active entry with a ticking duration,
+today's entries with totals, and the current AFK status.
+
+The view is updated as soon as any entries or statuses change. When using
+the database client, changes made by other processes are only picked up on
+the periodic refresh, as set by the --refresh flag.
+
+Keybindings:
+  s       stop the active entry
+  r       restart, i.e. start a new entry with the same name as the active
+          entry, or of the latest entry if there is no active entry
+  n       rename the active entry
+  q       quit (also Ctrl+C)
+
+While renaming, press Enter to save or Escape to cancel.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			stdinFd := int(os.Stdin.Fd())
+			if !term.IsTerminal(stdinFd) {
+				console.PrintFatal("Error starting watch:", "standard input is not a terminal")
+			}
+			connectClientOrExit()
+			w, err := newWatcher(rootCtx, c, flagRefresh)
+			if err != nil {
+				console.PrintFatal("Error starting watch:", err)
+			}
+			oldState, err := term.MakeRaw(stdinFd)
+			if err != nil {
+				console.PrintFatal("Error setting terminal to raw mode:", err)
+			}
+			err = w.run()
+			term.Restore(stdinFd, oldState)
+			if err != nil {
+				console.PrintFatal("Error watching:", err)
+			}
+		},
+	}
+
+	RootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().DurationVar(&flagRefresh, "refresh", flagRefresh, "how often to refetch all data, in addition to the live updates; 0 disables")
+}
+
+type watcher struct {
+	ctx     context.Context
+	cancel  func()
+	client  dinkur.Client
+	refresh time.Duration
+	view    console.WatchView
+
+	entries  <-chan dinkur.StreamedEntry
+	statuses <-chan dinkur.StreamedStatus
+	keys     chan byte
+	results  chan watchResult
+
+	inputSubmit func(string) (string, error)
+}
+
+type watchResult struct {
+	message string
+	err     error
+}
+
+func newWatcher(ctx context.Context, client dinkur.Client, refresh time.Duration) (*watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	entries, err := client.StreamEntry(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("stream entries: %w", err)
+	}
+	statuses, err := client.StreamStatus(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("stream statuses: %w", err)
+	}
+	w := &watcher{
+		ctx:      ctx,
+		cancel:   cancel,
+		client:   client,
+		refresh:  refresh,
+		entries:  entries,
+		statuses: statuses,
+		keys:     make(chan byte),
+		results:  make(chan watchResult),
+	}
+	if err := w.fetch(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *watcher) run() error {
+	defer w.cancel()
+	out := colorable.NewColorableStdout()
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l") // alternate screen, hide cursor
+	defer fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+
+	go w.readKeys()
+
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+	var refresh <-chan time.Time
+	if w.refresh > 0 {
+		refreshTicker := time.NewTicker(w.refresh)
+		defer refreshTicker.Stop()
+		refresh = refreshTicker.C
+	}
+
+	for {
+		w.view.Now = time.Now()
+		w.render(out)
+		select {
+		case <-w.ctx.Done():
+			return nil
+		case <-tick.C:
+		case <-refresh:
+			w.setError(w.fetch())
+		case _, ok := <-w.entries:
+			if !ok {
+				w.entries = nil
+				w.setError(errors.New("entry stream closed"))
+				continue
+			}
+			w.setError(w.fetchEntries())
+		case ev, ok := <-w.statuses:
+			if !ok {
+				w.statuses = nil
+				w.setError(errors.New("status stream closed"))
+				continue
+			}
+			w.view.Status = ev.Status
+		case res := <-w.results:
+			w.view.Message, w.view.Error = res.message, res.err
+		case key, ok := <-w.keys:
+			if !ok {
+				return nil
+			}
+			if quit := w.handleKey(key); quit {
+				return nil
+			}
+		}
+	}
+}
+
+func (w *watcher) render(out io.Writer) {
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H\x1b[2J") // move to top-left, clear screen
+	console.FprintWatchView(&buf, w.view, watchKeybindings)
+	// the terminal is in raw mode, which does not translate LF to CRLF
+	out.Write(bytes.ReplaceAll(buf.Bytes(), []byte("\n"), []byte("\r\n")))
+}
+
+func (w *watcher) readKeys() {
+	defer close(w.keys)
+	b := make([]byte, 1)
+	for {
+		if _, err := os.Stdin.Read(b); err != nil {
+			return
+		}
+		select {
+		case w.keys <- b[0]:
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *watcher) handleKey(key byte) (quit bool) {
+	if key == keyCtrlC {
+		return true
+	}
+	if w.inputSubmit != nil {
+		w.handleInputKey(key)
+		return false
+	}
+	w.view.Message, w.view.Error = "", nil
+	switch key {
+	case 'q':
+		return true
+	case 's':
+		w.do(w.stopActive)
+	case 'r':
+		w.do(w.restart)
+	case 'n':
+		if w.view.Active == nil {
+			w.view.Error = errWatchNoActiveEntry
+			return false
+		}
+		id := w.view.Active.ID
+		w.startInput("New name:", w.view.Active.Name, func(name string) (string, error) {
+			return w.rename(id, name)
+		})
+	}
+	return false
+}
+
+func (w *watcher) handleInputKey(key byte) {
+	switch key {
+	case keyEscape:
+		w.stopInput()
+	case keyEnter:
+		submit := w.inputSubmit
+		input := strings.TrimSpace(w.view.Input)
+		w.stopInput()
+		if input == "" {
+			w.view.Error = errors.New("name must not be empty")
+			return
+		}
+		w.do(func() (string, error) {
+			return submit(input)
+		})
+	case keyBackspace, keyDelete:
+		if _, size := utf8.DecodeLastRuneInString(w.view.Input); size > 0 {
+			w.view.Input = w.view.Input[:len(w.view.Input)-size]
+		}
+	default:
+		if key >= ' ' {
+			// appending raw bytes, as multi-byte UTF-8 characters are read
+			// one byte at a time
+			w.view.Input += string([]byte{key})
+		}
+	}
+}
+
+func (w *watcher) startInput(prompt, initial string, submit func(string) (string, error)) {
+	w.view.Prompt = prompt
+	w.view.Input = initial
+	w.inputSubmit = submit
+}
+
+func (w *watcher) stopInput() {
+	w.view.Prompt = ""
+	w.view.Input = ""
+	w.inputSubmit = nil
+}
+
+// do runs the action in the background, as the client may block while
+// publishing entry events until the stream channels have been read.
+func (w *watcher) do(action func() (string, error)) {
+	go func() {
+		msg, err := action()
+		select {
+		case w.results <- watchResult{msg, err}:
+		case <-w.ctx.Done():
+		}
+	}()
+}
+
+func (w *watcher) stopActive() (string, error) {
+	stopped, err := w.client.StopActiveEntry(w.ctx, time.Now())
+	if err != nil {
+		return "", err
+	}
+	if stopped == nil {
+		return "", errWatchNoActiveEntry
+	}
+	return fmt.Sprintf("Stopped entry #%d `%s`.", stopped.ID, stopped.Name), nil
+}
+
+func (w *watcher) restart() (string, error) {
+	name, ok := w.restartName()
+	if !ok {
+		return "", errWatchNoEntryToRestart
+	}
+	started, err := w.client.CreateEntry(w.ctx, dinkur.NewEntry{Name: name})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Started entry #%d `%s`.", started.Started.ID, started.Started.Name), nil
+}
+
+func (w *watcher) restartName() (string, bool) {
+	if w.view.Active != nil {
+		return w.view.Active.Name, true
+	}
+	if len(w.view.Today) > 0 {
+		return w.view.Today[len(w.view.Today)-1].Name, true
+	}
+	return "", false
+}
+
+func (w *watcher) rename(id uint, name string) (string, error) {
+	update, err := w.client.UpdateEntry(w.ctx, dinkur.EditEntry{
+		IDOrZero: id,
+		Name:     &name,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Renamed entry #%d to `%s`.", update.After.ID, update.After.Name), nil
+}
+
+func (w *watcher) setError(err error) {
+	if err != nil {
+		w.view.Error = err
+	}
+}
+
+func (w *watcher) fetch() error {
+	if err := w.fetchEntries(); err != nil {
+		return err
+	}
+	status, err := w.client.GetStatus(w.ctx)
+	if err != nil {
+		return fmt.Errorf("get status: %w", err)
+	}
+	w.view.Status = status
+	return nil
+}
+
+func (w *watcher) fetchEntries() error {
+	active, err := w.client.GetActiveEntry(w.ctx)
+	if err != nil {
+		return fmt.Errorf("get active entry: %w", err)
+	}
+	today, err := w.client.GetEntryList(w.ctx, dinkur.SearchEntry{
+		Limit:     1000,
+		Shorthand: timeutil.TimeSpanThisDay,
+	})
+	if err != nil {
+		return fmt.Errorf("get today's entries: %w", err)
+	}
+	w.view.Active = active
+	w.view.Today = today
+	return nil
+}
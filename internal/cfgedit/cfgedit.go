@@ -0,0 +1,261 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package cfgedit contains functions to read and edit YAML configuration
+// files while preserving comments and key order.
+package cfgedit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Errors specific to editing config files.
+var (
+	ErrEmptyKey      = errors.New("empty key")
+	ErrNotAMapping   = errors.New("value is not a mapping")
+	ErrInvalidFormat = errors.New("config file root is not a mapping")
+)
+
+// Document is a parsed YAML config file.
+type Document struct {
+	root yaml.Node
+	// preamble is the content of a file consisting of only comments, which
+	// yaml.v3 does not parse into any node. It is written back as-is, before
+	// any keys that have been set.
+	preamble []byte
+}
+
+// KeyValue is a dot-separated key and its YAML-formatted value.
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+// Load reads and parses a YAML config file. A file that does not exist is
+// treated as an empty file.
+func Load(path string) (*Document, error) {
+	b, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse parses a YAML config file's content.
+func Parse(b []byte) (*Document, error) {
+	var doc Document
+	if err := yaml.Unmarshal(b, &doc.root); err != nil {
+		return nil, err
+	}
+	if doc.root.Kind == 0 {
+		if len(bytes.TrimSpace(b)) > 0 {
+			doc.preamble = b
+		}
+		doc.root = yaml.Node{Kind: yaml.DocumentNode}
+	}
+	if len(doc.root.Content) == 0 || isNullNode(doc.root.Content[0]) {
+		// a document without content, such as only "---", keeps its comments
+		// but gets an empty mapping to set keys in
+		mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if len(doc.root.Content) > 0 {
+			empty := doc.root.Content[0]
+			mapping.HeadComment = empty.HeadComment
+			mapping.LineComment = empty.LineComment
+			mapping.FootComment = empty.FootComment
+		}
+		doc.root.Content = []*yaml.Node{mapping}
+	}
+	if doc.root.Content[0].Kind != yaml.MappingNode {
+		return nil, ErrInvalidFormat
+	}
+	return &doc, nil
+}
+
+// Save writes the config file, creating any missing parent directories.
+func (d *Document) Save(path string) error {
+	b, err := d.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// Bytes returns the YAML-encoded config file.
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if d.preamble != nil {
+		buf.Write(d.preamble)
+		if !bytes.HasSuffix(d.preamble, []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if len(d.mapping().Content) == 0 {
+			return buf.Bytes(), nil
+		}
+	}
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&d.root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Get returns the YAML-formatted value of a dot-separated key, such as
+// "daemon.afk.threshold". Keys are matched case-insensitively.
+func (d *Document) Get(key string) (string, bool, error) {
+	node := d.mapping()
+	for _, part := range strings.Split(key, ".") {
+		if node.Kind != yaml.MappingNode {
+			return "", false, nil
+		}
+		_, value := findKey(node, part)
+		if value == nil {
+			return "", false, nil
+		}
+		node = value
+	}
+	str, err := formatNode(node)
+	return str, true, err
+}
+
+// Set changes the value of a dot-separated key, such as
+// "daemon.afk.threshold", creating any missing parent mappings. The value is
+// parsed as YAML, so "true" is a boolean and "[a, b]" is a list.
+// Keys are matched case-insensitively.
+func (d *Document) Set(key, value string) error {
+	if key == "" {
+		return ErrEmptyKey
+	}
+	var valueDoc yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &valueDoc); err != nil {
+		return fmt.Errorf("parse value: %w", err)
+	}
+	valueNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: ""}
+	if len(valueDoc.Content) > 0 {
+		valueNode = valueDoc.Content[0]
+	}
+	parts := strings.Split(key, ".")
+	node := d.mapping()
+	for i, part := range parts {
+		if part == "" {
+			return ErrEmptyKey
+		}
+		if node.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s: %w", strings.Join(parts[:i], "."), ErrNotAMapping)
+		}
+		keyNode, existing := findKey(node, part)
+		last := i == len(parts)-1
+		if existing == nil {
+			keyNode = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part}
+			existing = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, keyNode, existing)
+		}
+		if last {
+			// keep comments attached to the old value
+			valueNode.HeadComment = existing.HeadComment
+			valueNode.LineComment = existing.LineComment
+			valueNode.FootComment = existing.FootComment
+			*existing = *valueNode
+			return nil
+		}
+		node = existing
+	}
+	return nil
+}
+
+// List returns all leaf keys and their YAML-formatted values, sorted by key.
+func (d *Document) List() ([]KeyValue, error) {
+	var list []KeyValue
+	if err := appendLeaves(&list, "", d.mapping()); err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+	return list, nil
+}
+
+func (d *Document) mapping() *yaml.Node {
+	return d.root.Content[0]
+}
+
+func isNullNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null" && node.Value == ""
+}
+
+func appendLeaves(list *[]KeyValue, prefix string, node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode || len(node.Content) == 0 {
+		value, err := formatNode(node)
+		if err != nil {
+			return err
+		}
+		*list = append(*list, KeyValue{Key: prefix, Value: value})
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if err := appendLeaves(list, key, node.Content[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findKey(mapping *yaml.Node, key string) (keyNode, valueNode *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func formatNode(node *yaml.Node) (string, error) {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value, nil
+	}
+	// flow style keeps lists and mappings on a single line
+	clone := *node
+	clone.Style = yaml.FlowStyle
+	clone.HeadComment, clone.LineComment, clone.FootComment = "", "", ""
+	b, err := yaml.Marshal(&clone)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
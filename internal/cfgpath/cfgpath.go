@@ -20,6 +20,12 @@
 
 package cfgpath
 
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
 var (
 	// ConfigPath is the full path (including file name and extension) of the
 	// configuration file. E.g "~/.config/dinkur/config.yml"
@@ -27,10 +33,32 @@ var (
 	// DataPath is the full path (including file name and extension) of the
 	// database file. E.g "~/.local/share/dinkur/dinkur.db"
 	DataPath string
+	// PeersPath is the full path (including file name and extension) of the
+	// file of paired Dinkur daemons, which is stored next to the
+	// configuration file. E.g "~/.config/dinkur/peers.yml"
+	PeersPath string
 )
 
 func init() {
 	ConfigPath = getConfigPath()
 
 	DataPath = getDataPath()
+
+	PeersPath = filepath.Join(filepath.Dir(ConfigPath), "peers.yml")
+}
+
+// ExpandHome replaces a leading "~/" in a path with the user's home directory,
+// as paths in the config file are not expanded by any shell. The path is
+// returned as-is if it does not start with "~/", or if the home directory is
+// unknown.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") &&
+		!strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
 }
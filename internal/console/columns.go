@@ -0,0 +1,175 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package console
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Errors specific to parsing entry columns.
+var (
+	ErrUnknownEntryColumn = errors.New("unknown entry column")
+	ErrNoEntryColumns     = errors.New("no entry columns")
+)
+
+// EntryColumn is a column in the tables of entries.
+type EntryColumn string
+
+// Entry columns that can be shown in the tables of entries.
+const (
+	EntryColumnID       EntryColumn = "id"
+	EntryColumnName     EntryColumn = "name"
+	EntryColumnDay      EntryColumn = "day"
+	EntryColumnStart    EntryColumn = "start"
+	EntryColumnEnd      EntryColumn = "end"
+	EntryColumnDuration EntryColumn = "duration"
+	EntryColumnCreated  EntryColumn = "created"
+	EntryColumnUpdated  EntryColumn = "updated"
+)
+
+// EntryColumns is a list of all the available entry columns.
+var EntryColumns = []EntryColumn{
+	EntryColumnID,
+	EntryColumnName,
+	EntryColumnDay,
+	EntryColumnStart,
+	EntryColumnEnd,
+	EntryColumnDuration,
+	EntryColumnCreated,
+	EntryColumnUpdated,
+}
+
+var (
+	// entryColumns is nil when the default columns of each table is used.
+	entryColumns []EntryColumn
+
+	entryLabelDefaultColumns = []EntryColumn{
+		EntryColumnID, EntryColumnName, EntryColumnStart, EntryColumnEnd, EntryColumnDuration,
+	}
+	entryListDefaultColumns = []EntryColumn{
+		EntryColumnID, EntryColumnName, EntryColumnDay, EntryColumnStart, EntryColumnEnd, EntryColumnDuration,
+	}
+)
+
+// Header returns the table header text of the column.
+func (c EntryColumn) Header() string {
+	return strings.ToUpper(string(c))
+}
+
+// ParseEntryColumns parses a comma-separated list of entry columns, such as
+// "id,name,duration".
+func ParseEntryColumns(s string) ([]EntryColumn, error) {
+	var cols []EntryColumn
+	for _, str := range strings.Split(s, ",") {
+		str = strings.ToLower(strings.TrimSpace(str))
+		if str == "" {
+			continue
+		}
+		col, ok := parseEntryColumn(str)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownEntryColumn, str)
+		}
+		cols = append(cols, col)
+	}
+	if len(cols) == 0 {
+		return nil, ErrNoEntryColumns
+	}
+	return cols, nil
+}
+
+func parseEntryColumn(s string) (EntryColumn, bool) {
+	for _, col := range EntryColumns {
+		if string(col) == s {
+			return col, true
+		}
+	}
+	return "", false
+}
+
+// SetEntryColumns changes which columns to show in the tables of entries.
+// A nil slice resets it to each table's default columns.
+func SetEntryColumns(cols []EntryColumn) {
+	entryColumns = cols
+}
+
+// SetTimeFormat changes the Go time layout used when printing times, such as
+// "15:04:05". An empty string resets it to the default layouts, which only
+// includes the date when it's not today's date.
+func SetTimeFormat(layout string) {
+	if layout == "" {
+		timeFormatLong, timeFormatShort = timeFormatLongDefault, timeFormatShortDefault
+		return
+	}
+	timeFormatLong, timeFormatShort = layout, layout
+}
+
+func entryColumnsOrDefault(defaults []EntryColumn) []EntryColumn {
+	if entryColumns != nil {
+		return entryColumns
+	}
+	return defaults
+}
+
+func writeEntryColumnHeaders(t *table, cols []EntryColumn, prefix ...string) {
+	row := prefix
+	for _, col := range cols {
+		row = append(row, col.Header())
+	}
+	t.WriteColoredRow(tableHeaderColor, row...)
+}
+
+func writeCellsEntryColumns(t *table, entry dinkur.Entry, cols []EntryColumn) {
+	for _, col := range cols {
+		switch col {
+		case EntryColumnID:
+			writeCellEntryID(t, entry.ID)
+		case EntryColumnName:
+			writeCellEntryName(t, entry.Name)
+		case EntryColumnDay:
+			writeCellDate(t, newDate(entry.Start.Date()))
+		case EntryColumnStart:
+			writeCellEntryStart(t, entry.Start)
+		case EntryColumnEnd:
+			writeCellEntryEnd(t, entry.Start, entry.End)
+		case EntryColumnDuration:
+			writeCellDuration(t, entry.Elapsed())
+		case EntryColumnCreated:
+			writeCellTimestamp(t, entry.CreatedAt)
+		case EntryColumnUpdated:
+			writeCellTimestamp(t, entry.UpdatedAt)
+		default:
+			t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		}
+	}
+}
+
+func writeCellTimestamp(t *table, ti time.Time) {
+	layout := timeFormatShort
+	if newDate(ti.Date()) != newDate(time.Now().Date()) {
+		layout = timeFormatLong
+	}
+	writeCellTimeColor(t, ti, layout, tableSummaryColor)
+}
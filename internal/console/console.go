@@ -22,20 +22,29 @@ package console
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/discovery"
+	"github.com/dinkur/dinkur/pkg/textsync"
 	"github.com/fatih/color"
 	"github.com/mattn/go-colorable"
 )
 
+const (
+	timeFormatLongDefault  = "Jan 02 15:04"
+	timeFormatShortDefault = "15:04"
+)
+
 var (
 	stdout          = colorable.NewColorableStdout()
 	stderr          = colorable.NewColorableStderr()
-	timeFormatLong  = "Jan 02 15:04"
-	timeFormatShort = "15:04"
+	timeFormatLong  = timeFormatLongDefault
+	timeFormatShort = timeFormatShortDefault
 
 	entryIDColor              = color.New(color.FgHiBlack)
 	entryLabelColor           = color.New(color.FgWhite, color.Italic)
@@ -70,6 +79,10 @@ var (
 	tableCellEmptyText  = "-"
 	tableCellEmptyColor = color.New(color.FgHiBlack)
 
+	profileActiveColor = color.New(color.FgGreen, color.Bold)
+
+	pairingCodeColor = color.New(color.FgHiYellow, color.Bold)
+
 	usageHeaderColor = color.New(color.FgYellow, color.Underline, color.Italic)
 	usageHelpColor   = color.New(color.FgHiBlack, color.Italic)
 
@@ -89,25 +102,26 @@ type LabelledEntry struct {
 
 // PrintEntryLabel writes a label string followed by a formatted entry to STDOUT.
 func PrintEntryLabel(labelled LabelledEntry) {
-	var t table
-	t.SetSpacing("  ")
-	t.WriteColoredRow(tableHeaderColor, "", "ID", "NAME", "START", "END", "DURATION")
-	writeCellsLabelledEntry(&t, labelled)
-	t.CommitRow()
-	t.Fprintln(stdout)
+	PrintEntryLabelSlice([]LabelledEntry{labelled})
 }
 
 // PrintEntryLabelSlice writes a table of label strings followed by a formatted
 // entry to STDOUT.
 func PrintEntryLabelSlice(slice []LabelledEntry) {
+	fprintEntryLabelSlice(stdout, slice)
+}
+
+func fprintEntryLabelSlice(w io.Writer, slice []LabelledEntry) {
+	cols := entryColumnsOrDefault(entryLabelDefaultColumns)
 	var t table
 	t.SetSpacing("  ")
-	t.WriteColoredRow(tableHeaderColor, "", "ID", "NAME", "START", "END", "DURATION")
+	t.SetMaxWidth(terminalWidth())
+	writeEntryColumnHeaders(&t, cols, "")
 	for _, lbl := range slice {
-		writeCellsLabelledEntry(&t, lbl)
+		writeCellsLabelledEntry(&t, lbl, cols)
 		t.CommitRow()
 	}
-	t.Fprintln(stdout)
+	t.Fprintln(w)
 }
 
 // PrintFatal writes a label and some error value to STDERR and then exits the
@@ -122,8 +136,17 @@ func PrintFatal(label string, v any) {
 }
 
 // PrintEntryEdit writes a formatted entry and highlights any edits made to it,
-// by diffing the before and after entries, to STDOUT.
+// by diffing the before and after entries, to STDOUT. If custom columns have
+// been set via SetEntryColumns, then the before and after entries are instead
+// written as a table using those columns.
 func PrintEntryEdit(update dinkur.UpdatedEntry) {
+	if entryColumns != nil {
+		PrintEntryLabelSlice([]LabelledEntry{
+			{Label: "Before:", Entry: update.Before},
+			{Label: "After:", Entry: update.After},
+		})
+		return
+	}
 	var sb strings.Builder
 	entryLabelColor.Fprint(&sb, "Updated entry ")
 	entryIDColor.Fprint(&sb, "#", update.After.ID)
@@ -164,8 +187,12 @@ func PrintEntryList(entries []dinkur.Entry) {
 // PrintEntryListSearched writes a table for a list of entries, grouped by the date
 // (year, month, day), to STDOUT, as well as highlighting search terms (if any).
 func PrintEntryListSearched(entries []dinkur.Entry, searchStart, searchEnd string) {
+	fprintEntryListSearched(stdout, entries, searchStart, searchEnd)
+}
+
+func fprintEntryListSearched(w io.Writer, entries []dinkur.Entry, searchStart, searchEnd string) {
 	if len(entries) == 0 {
-		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		tableEmptyColor.Fprintln(w, tableEmptyText)
 		return
 	}
 	var reg *regexp.Regexp
@@ -177,48 +204,446 @@ func PrintEntryListSearched(entries []dinkur.Entry, searchStart, searchEnd strin
 			PrintFatal("Failed to compile highlight regex:", err)
 		}
 	}
+	cols := entryColumnsOrDefault(entryListDefaultColumns)
 	var t table
 	t.SetSpacing("  ")
 	t.SetPrefix("  ")
-	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "DAY", "START", "END", "DURATION")
+	t.SetMaxWidth(terminalWidth())
+	writeEntryColumnHeaders(&t, cols)
 	for i, group := range groupEntriesByDate(entries) {
 		if i > 0 {
 			t.CommitRow() // commit empty delimiting row
 		}
 		for i, entry := range group.entries {
-			writeCellEntryID(&t, entry.ID)
-			if reg != nil {
-				writeCellEntryNameSearched(&t, entry.Name, reg)
-			} else {
-				writeCellEntryName(&t, entry.Name)
-			}
-			if i == 0 {
-				writeCellDate(&t, group.date)
-			} else {
-				t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+			for _, col := range cols {
+				switch {
+				case col == EntryColumnName && reg != nil:
+					writeCellEntryNameSearched(&t, entry.Name, reg)
+				case col == EntryColumnDay && i > 0:
+					t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+				default:
+					writeCellsEntryColumns(&t, entry, []EntryColumn{col})
+				}
 			}
-			writeCellEntryStartEnd(&t, entry.Start, entry.End)
-			writeCellDuration(&t, entry.Elapsed())
 			t.CommitRow()
 		}
 	}
 	sum := sumEntries(entries)
 	t.CommitRow() // commit empty delimiting row
-	endStr := entryEndNilTextActive
-	if sum.end != nil {
-		endStr = sum.end.Format(timeFormatShort)
+	t.WriteColoredRow(tableSummaryColor, entryListSummaryRow(cols, sum, len(entries))...)
+	t.Fprintln(w)
+}
+
+func entryListSummaryRow(cols []EntryColumn, sum entrySum, count int) []string {
+	row := make([]string, len(cols))
+	totalIdx := 0
+	for i, col := range cols {
+		switch col {
+		case EntryColumnName:
+			totalIdx = i
+		case EntryColumnStart:
+			row[i] = sum.start.Format(timeFormatShort)
+		case EntryColumnEnd:
+			row[i] = entryEndNilTextActive
+			if sum.end != nil {
+				row[i] = sum.end.Format(timeFormatShort)
+			}
+		case EntryColumnDuration:
+			row[i] = FormatDuration(sum.duration)
+		default:
+			row[i] = tableCellEmptyText
+		}
+	}
+	// placed in the name column if shown, or else the first column
+	row[totalIdx] = fmt.Sprintf("TOTAL: %d entries", count)
+	return row
+}
+
+// PrintAFKPeriodList writes a table for a list of AFK periods to STDOUT.
+func PrintAFKPeriodList(periods []dinkur.AFKPeriod) {
+	if len(periods) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "DAY", "START", "END", "DURATION", "HOOK", "RESOLUTION")
+	var sum time.Duration
+	for _, period := range periods {
+		writeCellEntryID(&t, period.ID)
+		writeCellDate(&t, newDate(period.Start.Date()))
+		writeCellTimeColor(&t, period.Start, timeFormatShort, entryStartColor)
+		if period.End != nil {
+			endLayout := timeFormatShort
+			if newDate(period.End.Date()) != newDate(period.Start.Date()) {
+				endLayout = timeFormatLong
+			}
+			writeCellTimeColor(&t, *period.End, endLayout, entryEndColor)
+		} else {
+			t.WriteCellColor(entryEndNilTextNow, entryEndNilColor)
+		}
+		elapsed := period.Elapsed()
+		sum += elapsed
+		writeCellDuration(&t, elapsed)
+		if period.Hook != "" {
+			t.WriteCell(period.Hook)
+		} else {
+			t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		}
+		if period.Resolution == dinkur.AFKResolutionNone {
+			t.WriteCellColor(period.Resolution.String(), entryEndNilColor)
+		} else {
+			t.WriteCell(period.Resolution.String())
+		}
+		t.CommitRow()
 	}
+	t.CommitRow() // commit empty delimiting row
 	t.WriteColoredRow(tableSummaryColor,
 		tableCellEmptyText, // ID
-		fmt.Sprintf("TOTAL: %d entries", len(entries)), // NAME
-		tableCellEmptyText,                // DAY
-		sum.start.Format(timeFormatShort), // START
-		endStr,                            // END
-		FormatDuration(sum.duration),      // DURATION
+		fmt.Sprintf("TOTAL: %d periods", len(periods)), // DAY
+		tableCellEmptyText,  // START
+		tableCellEmptyText,  // END
+		FormatDuration(sum), // DURATION
+		tableCellEmptyText,  // HOOK
+		tableCellEmptyText,  // RESOLUTION
 	)
 	t.Fprintln(stdout)
 }
 
+// ReportEntry holds an entry and additional data used when printing reports.
+type ReportEntry struct {
+	Entry dinkur.Entry
+	// Continued is the later segments of the same task, that continue the
+	// entry, such as after pausing and resuming it.
+	Continued []dinkur.Entry
+	// Away is the duration of the entry that the user spent away (AFK).
+	Away time.Duration
+	// DayOff describes why the entry's day is not a workday, such as
+	// "weekend" or the name of a holiday, or is empty on workdays.
+	DayOff string
+	// Pomodoros is the number of completed Pomodoro work periods of the
+	// entry.
+	Pomodoros int
+}
+
+// Elapsed returns the duration of the entry, including any later segments.
+func (r ReportEntry) Elapsed() time.Duration {
+	elapsed := r.Entry.Elapsed()
+	for _, entry := range r.Continued {
+		elapsed += entry.Elapsed()
+	}
+	return elapsed
+}
+
+// ReportOptions holds settings for what to include in a report.
+type ReportOptions struct {
+	// Away includes the time spent away and the tracked time minus the time
+	// spent away.
+	Away bool
+	// Workdays includes the type of day per entry, as well as separate totals
+	// for workdays and days off.
+	Workdays bool
+	// Pomodoros includes the number of completed Pomodoro work periods.
+	Pomodoros bool
+	// Tasks includes the number of segments per entry, when entries that
+	// continue each other have been merged.
+	Tasks bool
+}
+
+// PrintEntryReport writes a table for a list of entries to STDOUT, with
+// the tracked time per entry, and any additional columns enabled by the
+// options.
+func PrintEntryReport(entries []ReportEntry, opt ReportOptions) {
+	if len(entries) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.SetMaxWidth(terminalWidth())
+	header := []string{"ID", "NAME", "DAY"}
+	if opt.Workdays {
+		header = append(header, "DAY TYPE")
+	}
+	if opt.Tasks {
+		header = append(header, "SEGMENTS")
+	}
+	header = append(header, "DURATION")
+	if opt.Away {
+		header = append(header, "AWAY", "NET")
+	}
+	if opt.Pomodoros {
+		header = append(header, "POMODOROS")
+	}
+	t.WriteColoredRow(tableHeaderColor, header...)
+	var total, workdays, daysOff reportSum
+	for _, r := range entries {
+		elapsed := r.Elapsed()
+		total.add(elapsed, r.Away, r.Pomodoros)
+		if r.DayOff == "" {
+			workdays.add(elapsed, r.Away, r.Pomodoros)
+		} else {
+			daysOff.add(elapsed, r.Away, r.Pomodoros)
+		}
+		writeCellEntryID(&t, r.Entry.ID)
+		writeCellEntryName(&t, r.Entry.Name)
+		writeCellDate(&t, newDate(r.Entry.Start.Date()))
+		if opt.Workdays {
+			if r.DayOff == "" {
+				t.WriteCell("workday")
+			} else {
+				t.WriteCellColor(r.DayOff, tableCellEmptyColor)
+			}
+		}
+		if opt.Tasks {
+			t.WriteCell(fmt.Sprint(1 + len(r.Continued)))
+		}
+		writeCellDuration(&t, elapsed)
+		if opt.Away {
+			writeCellDuration(&t, r.Away)
+			writeCellDuration(&t, elapsed-r.Away)
+		}
+		if opt.Pomodoros {
+			writeCellCount(&t, r.Pomodoros)
+		}
+		t.CommitRow()
+	}
+	t.CommitRow() // commit empty delimiting row
+	if opt.Workdays {
+		writeReportSummaryRow(&t, "WORKDAYS", workdays, opt)
+		writeReportSummaryRow(&t, "DAYS OFF", daysOff, opt)
+	}
+	writeReportSummaryRow(&t, "TOTAL", total, opt)
+	t.Fprintln(stdout)
+}
+
+type reportSum struct {
+	count     int
+	duration  time.Duration
+	away      time.Duration
+	pomodoros int
+}
+
+func (s *reportSum) add(duration, away time.Duration, pomodoros int) {
+	s.count++
+	s.duration += duration
+	s.away += away
+	s.pomodoros += pomodoros
+}
+
+func writeReportSummaryRow(t *table, label string, sum reportSum, opt ReportOptions) {
+	unit := "entries"
+	if opt.Tasks {
+		unit = "tasks"
+	}
+	row := []string{
+		tableCellEmptyText, // ID
+		fmt.Sprintf("%s: %d %s", label, sum.count, unit), // NAME
+		tableCellEmptyText, // DAY
+	}
+	if opt.Workdays {
+		row = append(row, tableCellEmptyText) // DAY TYPE
+	}
+	if opt.Tasks {
+		row = append(row, tableCellEmptyText) // SEGMENTS
+	}
+	row = append(row, FormatDuration(sum.duration)) // DURATION
+	if opt.Away {
+		row = append(row,
+			FormatDuration(sum.away),              // AWAY
+			FormatDuration(sum.duration-sum.away), // NET
+		)
+	}
+	if opt.Pomodoros {
+		row = append(row, fmt.Sprint(sum.pomodoros)) // POMODOROS
+	}
+	t.WriteColoredRow(tableSummaryColor, row...)
+}
+
+func writeCellCount(t *table, count int) {
+	if count == 0 {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+	} else {
+		t.WriteCell(fmt.Sprint(count))
+	}
+}
+
+// Profile is a named profile from the config file, used when printing the
+// list of profiles.
+type Profile struct {
+	Name        string
+	Data        string
+	GRPCAddress string
+	Active      bool
+}
+
+// PrintProfileList writes a table of profiles to STDOUT, marking the active
+// profile.
+func PrintProfileList(profiles []Profile) {
+	if len(profiles) == 0 {
+		tableEmptyColor.Fprintln(stdout, "No profiles defined in the config file.")
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "NAME", "DATA", "GRPC ADDRESS")
+	for _, p := range profiles {
+		if p.Active {
+			t.WriteCellColor("*", profileActiveColor)
+			t.WriteCellColor(p.Name, profileActiveColor)
+		} else {
+			t.WriteCell("")
+			t.WriteCell(p.Name)
+		}
+		writeCellOrEmpty(&t, p.Data)
+		writeCellOrEmpty(&t, p.GRPCAddress)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintDiscoveredDaemonList writes a table of Dinkur daemons found on the
+// local network to STDOUT.
+func PrintDiscoveredDaemonList(services []discovery.Service) {
+	if len(services) == 0 {
+		tableEmptyColor.Fprintln(stdout, "No Dinkur daemons found on the local network.")
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "NAME", "ADDRESS", "TLS FINGERPRINT (SHA-256)")
+	for _, svc := range services {
+		t.WriteCell(svc.Instance)
+		t.WriteCell(svc.Address())
+		writeCellOrEmpty(&t, svc.TLSFingerprint)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintSyncResult writes a summary of the changes exchanged with a remote
+// Dinkur daemon to STDOUT.
+func PrintSyncResult(address string, result dinkur.SyncResult) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "REMOTE", "PULLED", "PUSHED")
+	t.WriteCell(address)
+	t.WriteCell(fmt.Sprintf("%d changes", result.Pulled))
+	t.WriteCell(fmt.Sprintf("%d changes", result.Pushed))
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintPairingCode writes a pairing code, when it expires, and the daemon's
+// TLS fingerprint to STDOUT.
+func PrintPairingCode(code dinkur.PairingCode) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteCellColor("Pairing code:", entryLabelColor)
+	t.WriteCellColor(code.Code, pairingCodeColor)
+	t.CommitRow()
+	t.WriteCellColor("Expires:", entryLabelColor)
+	t.WriteCellColor(code.Expires.Local().Format(timeFormatShort), entryEndColor)
+	t.CommitRow()
+	t.WriteCellColor("TLS fingerprint:", entryLabelColor)
+	t.WriteCell(code.TLSFingerprint)
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintPeerLabel writes a label string followed by a formatted paired Dinkur
+// daemon to STDOUT.
+func PrintPeerLabel(label string, peer dinkur.Peer) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "NAME", "ADDRESS", "PAIRED", "TLS FINGERPRINT (SHA-256)")
+	t.WriteCellColor(label, entryLabelColor)
+	writePeerCells(&t, peer)
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintPeerList writes a table of paired Dinkur daemons to STDOUT.
+func PrintPeerList(peers []dinkur.Peer) {
+	if len(peers) == 0 {
+		tableEmptyColor.Fprintln(stdout, "No paired Dinkur daemons.")
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "NAME", "ADDRESS", "PAIRED", "TLS FINGERPRINT (SHA-256)")
+	for _, peer := range peers {
+		writePeerCells(&t, peer)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+func writePeerCells(t *table, peer dinkur.Peer) {
+	t.WriteCell(peer.Name)
+	writeCellOrEmpty(t, peer.Address)
+	t.WriteCellColor(peer.Paired.Local().Format(timeFormatLong), entryDateColor)
+	writeCellOrEmpty(t, peer.TLSFingerprint)
+}
+
+// PrintNotTracking writes since when the user has been at the keyboard
+// without any active entry, and any suggested entry name, to STDOUT.
+func PrintNotTracking(since time.Time, suggestedName string) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteCellColor("Not tracking since:", entryLabelColor)
+	t.WriteCellColor(since.Local().Format(timeFormatShort), entryStartColor)
+	t.WriteCellColor(FormatDuration(time.Since(since)), entryDurationColor)
+	t.CommitRow()
+	if suggestedName != "" {
+		t.WriteCellColor("Suggestion:", entryLabelColor)
+		t.WriteCellColor(fmt.Sprintf("`%s`", suggestedName), entryNameColor)
+		t.WriteCell("")
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintPomodoro writes a label string followed by the state of a Pomodoro
+// timer to STDOUT.
+func PrintPomodoro(label string, pomodoro dinkur.Pomodoro) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "NAME", "PHASE", "START", "END", "REMAINING", "CYCLES")
+	t.WriteCellColor(label, entryLabelColor)
+	writeCellEntryName(&t, pomodoro.EntryName)
+	t.WriteCell(pomodoro.Phase.String())
+	writeCellTimeColor(&t, pomodoro.PhaseStart.Local(), timeFormatShort, entryStartColor)
+	writeCellTimeColor(&t, pomodoro.PhaseEnd.Local(), timeFormatShort, entryEndColor)
+	writeCellDuration(&t, pomodoro.Remaining())
+	t.WriteCell(fmt.Sprint(pomodoro.CompletedCycles))
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintTextSyncResult writes a summary of the changes imported from, and files
+// written to, a directory of text files to STDOUT.
+func PrintTextSyncResult(dir string, result textsync.Result) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "DIRECTORY", "IMPORTED", "WRITTEN")
+	t.WriteCell(dir)
+	t.WriteCell(fmt.Sprintf("%d changes", result.Imported))
+	t.WriteCell(fmt.Sprintf("%d files", result.Written))
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+func writeCellOrEmpty(t *table, s string) {
+	if s == "" {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+	} else {
+		t.WriteCell(s)
+	}
+}
+
 // UsageTemplate returns a lightly colored usage template for Cobra.
 func UsageTemplate() string {
 	var sb strings.Builder
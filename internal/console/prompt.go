@@ -29,8 +29,10 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/dinkur/dinkur/internal/fuzzytime"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/mattn/go-isatty"
+	"gopkg.in/typ.v4"
 )
 
 func isNonInteractiveTTY() bool {
@@ -74,8 +76,9 @@ func PromptEntryRemoval(entry dinkur.Entry) error {
 // AFKResolution states what should be changed as decided from the human's AFK
 // resolution.
 type AFKResolution struct {
-	Edit     *dinkur.EditEntry
-	NewEntry *dinkur.NewEntry
+	Resolution dinkur.AFKResolution
+	// NewEntries are the entries to create, in order, for the time spent away.
+	NewEntries []dinkur.NewEntry
 }
 
 // PromptAFKResolution asks the user for how to resolve an AFK status.
@@ -105,7 +108,7 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 		sb.WriteString(` Assuming option "1. Leave the active entry as-is and continue with the invoked command."`)
 		fmt.Fprintln(stderr, sb.String())
 		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
-		return AFKResolution{}, nil
+		return AFKResolution{Resolution: dinkur.AFKResolutionKeep}, nil
 	}
 
 	sb.WriteString("How do you want to save this away time?\n")
@@ -120,6 +123,10 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 	writeEntryTimeSpanNowDuration(&sb, afkSince, nil, now.Sub(afkSince))
 	sb.WriteString("  (naming it in a later prompt).\n")
 
+	sb.WriteString("  4. Split the away time into multiple new entries ")
+	writeEntryTimeSpanNowDuration(&sb, afkSince, nil, now.Sub(afkSince))
+	sb.WriteString("  (naming them in later prompts).\n")
+
 	sb.WriteByte(' ')
 	promptCtrlCHelpColor.Fprint(&sb, "(press Ctrl+C to abort)")
 	sb.WriteByte('\n')
@@ -128,9 +135,9 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 	fmt.Fprint(stderr, sb.String())
 
 	prompt := &survey.Input{
-		Message: "Select option [1-3]:",
+		Message: "Select option [1-4]:",
 	}
-	answerInt, err := promptIntRange(prompt, 1, 3)
+	answerInt, err := promptIntRange(prompt, 1, 4)
 	if err != nil {
 		return AFKResolution{}, err
 	}
@@ -139,28 +146,27 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 	case 1:
 		// Leave the active entry as-is.
 		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
-		return AFKResolution{}, nil
+		return AFKResolution{Resolution: dinkur.AFKResolutionKeep}, nil
 
 	case 2:
 		// Discard the time
 		fmt.Fprintln(stderr, "Discarding the away time from the currently active entry.")
-		return AFKResolution{
-			Edit: &dinkur.EditEntry{
-				IDOrZero: activeEntry.ID,
-				End:      &afkSince,
-			},
-		}, nil
+		return AFKResolution{Resolution: dinkur.AFKResolutionDiscard}, nil
 
 	case 3:
 		// Save the time as a new entry
-		return promptAFKSaveAsNewEntry(activeEntry, afkSince)
+		return promptAFKSaveAsNewEntry(afkSince)
+
+	case 4:
+		// Split the time into multiple new entries
+		return promptAFKSplitIntoNewEntries(afkSince, now)
 
 	default:
 		return AFKResolution{}, errors.New("no answer chosen")
 	}
 }
 
-func promptAFKSaveAsNewEntry(activeEntry dinkur.Entry, afkSince time.Time) (AFKResolution, error) {
+func promptAFKSaveAsNewEntry(afkSince time.Time) (AFKResolution, error) {
 	name, err := promptNonEmptyString(&survey.Input{
 		Message: "Enter name of new entry:",
 	})
@@ -173,18 +179,62 @@ func promptAFKSaveAsNewEntry(activeEntry dinkur.Entry, afkSince time.Time) (AFKR
 	sb.WriteString(".\n")
 	fmt.Fprint(stderr, sb.String())
 	return AFKResolution{
-		Edit: &dinkur.EditEntry{
-			IDOrZero: activeEntry.ID,
-			End:      &afkSince,
-		},
-		NewEntry: &dinkur.NewEntry{
-			Name:               name,
-			Start:              &afkSince,
-			StartAfterIDOrZero: activeEntry.ID,
+		Resolution: dinkur.AFKResolutionNewEntry,
+		NewEntries: []dinkur.NewEntry{
+			{
+				Name:  name,
+				Start: &afkSince,
+			},
 		},
 	}, nil
 }
 
+func promptAFKSplitIntoNewEntries(afkSince, backSince time.Time) (AFKResolution, error) {
+	var newEntries []dinkur.NewEntry
+	start := afkSince
+	for start.Before(backSince) {
+		remaining := backSince.Sub(start)
+		var sb strings.Builder
+		sb.WriteString("Remaining away time to split: ")
+		writeEntryTimeSpanNowDuration(&sb, start, &backSince, remaining)
+		fmt.Fprintln(stderr, sb.String())
+
+		name, err := promptNonEmptyString(&survey.Input{
+			Message: fmt.Sprintf("Enter name of new entry #%d:", len(newEntries)+1),
+		})
+		if err != nil {
+			return AFKResolution{}, err
+		}
+		dur, err := promptDuration(&survey.Input{
+			Message: "Enter its duration (e.g 45m, 1h30m, or 1:30):",
+			Default: FormatDuration(remaining),
+		})
+		if err != nil {
+			return AFKResolution{}, err
+		}
+		if dur > remaining {
+			dur = remaining
+		}
+		end := start.Add(dur)
+		if backSince.Sub(end) < time.Second {
+			// avoid leaving a remainder that only consists of rounding errors
+			end = backSince
+		}
+		newEntries = append(newEntries, dinkur.NewEntry{
+			Name:  name,
+			Start: typ.Ref(start),
+			End:   typ.Ref(end),
+		})
+		start = end
+		fmt.Fprintln(stderr)
+	}
+	fmt.Fprintf(stderr, "Splitting the away time into %d new entries.\n", len(newEntries))
+	return AFKResolution{
+		Resolution: dinkur.AFKResolutionSplit,
+		NewEntries: newEntries,
+	}, nil
+}
+
 // PromptDupEntryResolution asks the user for how to resolve creating a new
 // duplicate entry.
 func PromptDupEntryResolution(activeEntry dinkur.Entry) (bool, error) {
@@ -219,6 +269,40 @@ func PromptDupEntryResolution(activeEntry dinkur.Entry) (bool, error) {
 	return ok, nil
 }
 
+// PromptPairingCode asks the user for the pairing code shown by another
+// Dinkur daemon.
+func PromptPairingCode() (string, error) {
+	return promptNonEmptyString(&survey.Input{
+		Message: "Pairing code:",
+		Help:    "The code shown when running the pair command without arguments on the other computer.",
+	})
+}
+
+// PromptEntrySelection asks the user to pick one of the given entries, such
+// as when several past entries matched a search. Will return an error if the
+// current TTY is not an interactive session.
+func PromptEntrySelection(entries []dinkur.Entry) (dinkur.Entry, error) {
+	if len(entries) == 0 {
+		return dinkur.Entry{}, errors.New("no entries to select from")
+	}
+	if isNonInteractiveTTY() {
+		return dinkur.Entry{}, fmt.Errorf("%d entries matched, but the terminal seems to be non-interactive", len(entries))
+	}
+	options := make([]string, len(entries))
+	for i, entry := range entries {
+		options[i] = fmt.Sprintf("#%d %q (%s)", entry.ID, entry.Name, entry.Start.Local().Format(timeFormatLong))
+	}
+	prompt := &survey.Select{
+		Message: "Select entry:",
+		Options: options,
+	}
+	var index int
+	if err := survey.AskOne(prompt, &index); err != nil {
+		return dinkur.Entry{}, convPromptErr(err)
+	}
+	return entries[index], nil
+}
+
 func promptNonEmptyString(prompt survey.Prompt) (string, error) {
 	for {
 		var answer string
@@ -257,6 +341,28 @@ func promptIntRange(prompt survey.Prompt, lower, upper int) (int, error) {
 	}
 }
 
+func promptDuration(prompt survey.Prompt) (time.Duration, error) {
+	for {
+		answer, err := promptNonEmptyString(prompt)
+		if err != nil {
+			return 0, err
+		}
+
+		dur, err := fuzzytime.ParseDuration(answer)
+		if err != nil {
+			promptErrorColor.Fprintf(stderr, "Invalid duration: %v\n\n", err)
+			continue
+		}
+
+		if dur <= 0 {
+			promptErrorColor.Fprintf(stderr, "Please enter a positive duration.\n\n")
+			continue
+		}
+
+		return dur, nil
+	}
+}
+
 func isDigit(b byte) bool {
 	return b >= '0' && b <= '9'
 }
@@ -28,9 +28,16 @@ import (
 	"github.com/fatih/color"
 )
 
+// tableMinTruncatedWidth is the minimum width that truncatable columns are
+// truncated down to, even when the table does not fit within the max width.
+const tableMinTruncatedWidth = 12
+
 type tableCell struct {
 	s string
 	w int
+	// truncate is optional, and is used to render a narrower version of the
+	// cell when the table does not fit within the max width.
+	truncate func(width int) tableCell
 }
 
 type table struct {
@@ -39,6 +46,7 @@ type table struct {
 	pendingRow []tableCell
 	prefix     string
 	spacing    string
+	maxWidth   int
 }
 
 func (t *table) SetPrefix(prefix string) {
@@ -49,6 +57,12 @@ func (t *table) SetSpacing(spacing string) {
 	t.spacing = spacing
 }
 
+// SetMaxWidth sets the width that the table will try to fit within by
+// truncating truncatable cells. Zero means no limit.
+func (t *table) SetMaxWidth(width int) {
+	t.maxWidth = width
+}
+
 func (t *table) WriteColoredRow(c *color.Color, headers ...string) {
 	for _, cell := range headers {
 		t.WriteCellWidth(c.Sprint(cell), utf8.RuneCountInString(cell))
@@ -57,7 +71,7 @@ func (t *table) WriteColoredRow(c *color.Color, headers ...string) {
 }
 
 func (t *table) WriteCell(s string) {
-	t.pendingRow = append(t.pendingRow, tableCell{s, utf8.RuneCountInString(s)})
+	t.pendingRow = append(t.pendingRow, tableCell{s: s, w: utf8.RuneCountInString(s)})
 }
 
 func (t *table) WriteCellColor(s string, c *color.Color) {
@@ -65,7 +79,11 @@ func (t *table) WriteCellColor(s string, c *color.Color) {
 }
 
 func (t *table) WriteCellWidth(s string, width int) {
-	t.pendingRow = append(t.pendingRow, tableCell{s, width})
+	t.pendingRow = append(t.pendingRow, tableCell{s: s, w: width})
+}
+
+func (t *table) WriteCellTruncatable(s string, width int, truncate func(width int) tableCell) {
+	t.pendingRow = append(t.pendingRow, tableCell{s: s, w: width, truncate: truncate})
 }
 
 func (t *table) CommitRow() {
@@ -83,6 +101,7 @@ func (t *table) Println() {
 }
 
 func (t *table) Fprintln(w io.Writer) {
+	t.fitMaxWidth()
 	var sb strings.Builder
 	rowsWithSpaces := len(t.colWidth) - 1
 	spaces := strings.Repeat(" ", t.WidestCellWidth())
@@ -116,6 +135,63 @@ func (t *table) WidestCellWidth() int {
 	return width
 }
 
+func (t *table) Width() int {
+	width := utf8.RuneCountInString(t.prefix)
+	for _, w := range t.colWidth {
+		width += w
+	}
+	if len(t.colWidth) > 1 {
+		width += (len(t.colWidth) - 1) * utf8.RuneCountInString(t.spacing)
+	}
+	return width
+}
+
+func (t *table) fitMaxWidth() {
+	if t.maxWidth <= 0 {
+		return
+	}
+	over := t.Width() - t.maxWidth
+	for col := range t.colWidth {
+		if over <= 0 {
+			return
+		}
+		minWidth, ok := t.minColWidth(col)
+		if !ok || minWidth >= t.colWidth[col] {
+			continue
+		}
+		newWidth := t.colWidth[col] - over
+		if newWidth < minWidth {
+			newWidth = minWidth
+		}
+		over -= t.colWidth[col] - newWidth
+		t.colWidth[col] = newWidth
+		for _, row := range t.rows {
+			if col < len(row) && row[col].truncate != nil && row[col].w > newWidth {
+				row[col] = row[col].truncate(newWidth)
+			}
+		}
+	}
+}
+
+// minColWidth returns the width that a column can be truncated down to, which
+// is limited by the widest non-truncatable cell. Returns false if the column
+// does not contain any truncatable cells.
+func (t *table) minColWidth(col int) (int, bool) {
+	minWidth := tableMinTruncatedWidth
+	var anyTruncatable bool
+	for _, row := range t.rows {
+		if col >= len(row) {
+			continue
+		}
+		if row[col].truncate != nil {
+			anyTruncatable = true
+		} else if row[col].w > minWidth {
+			minWidth = row[col].w
+		}
+	}
+	return minWidth, anyTruncatable
+}
+
 func (t *table) expandColWidths(cells []tableCell) {
 	for len(t.colWidth) < len(cells) {
 		t.colWidth = append(t.colWidth, 0)
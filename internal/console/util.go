@@ -21,9 +21,12 @@ package console
 
 import (
 	"fmt"
+	"os"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"golang.org/x/term"
 )
 
 // FormatDuration returns a formatted time.Duration in the format of
@@ -38,6 +41,29 @@ func FormatDuration(d time.Duration) string {
 	return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
 }
 
+// truncateString shortens a string to the given number of runes, where the
+// last rune is replaced with an ellipsis if it got truncated.
+func truncateString(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	if maxRunes <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	return string(runes[:maxRunes-1]) + "…"
+}
+
+// terminalWidth returns the width of the terminal attached to STDOUT, or zero
+// if STDOUT is not a terminal.
+func terminalWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
 func newDate(year int, month time.Month, day int) date {
 	return date{year, month, day}
 }
@@ -0,0 +1,145 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package console
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/fatih/color"
+)
+
+var (
+	watchTitleColor      = color.New(color.FgHiWhite, color.Bold)
+	watchTimeColor       = color.New(color.FgHiBlack)
+	watchSectionColor    = color.New(color.FgYellow, color.Underline, color.Italic)
+	watchStatusAFKColor  = color.New(color.FgHiRed)
+	watchStatusBackColor = color.New(color.FgHiYellow)
+	watchStatusOKColor   = color.New(color.FgGreen)
+	watchKeyColor        = color.New(color.FgHiCyan, color.Bold)
+	watchKeyHelpColor    = color.New(color.FgHiBlack)
+	watchMessageColor    = color.New(color.FgWhite, color.Italic)
+	watchErrorColor      = color.New(color.FgRed)
+	watchPromptColor     = color.New(color.FgHiMagenta)
+)
+
+// WatchView holds the state to render in the live-updating watch view.
+type WatchView struct {
+	// Now is the time shown in the title, and is used when calculating the
+	// durations of active entries and AFK statuses.
+	Now time.Time
+	// Active is the active entry, or nil if there is no active entry.
+	Active *dinkur.Entry
+	// Today is the list of today's entries.
+	Today []dinkur.Entry
+	// Status is the current AFK status.
+	Status dinkur.Status
+	// Message is shown below the keybindings, such as the result of the
+	// latest action.
+	Message string
+	// Error is shown below the keybindings, with error coloring. Takes
+	// precedence over Message.
+	Error error
+	// Prompt is shown together with Input when reading text input from the
+	// user, such as a new entry name. Keybinding help is hidden while set.
+	Prompt string
+	// Input is the text entered so far when Prompt is set.
+	Input string
+}
+
+// WatchKeybinding is a key and a description of what it does, as shown in
+// the watch view.
+type WatchKeybinding struct {
+	Key         string
+	Description string
+}
+
+// FprintWatchView writes the watch view to a writer. Any previous output is
+// not cleared, so this is expected to be written to a cleared screen.
+func FprintWatchView(w io.Writer, v WatchView, keys []WatchKeybinding) {
+	watchTitleColor.Fprint(w, "Dinkur")
+	fmt.Fprint(w, " ")
+	watchTimeColor.Fprintln(w, v.Now.Format("Mon Jan 02 15:04:05"))
+	fmt.Fprintln(w)
+
+	if v.Active != nil {
+		fprintEntryLabelSlice(w, []LabelledEntry{{
+			Label: "Current entry:",
+			Entry: *v.Active,
+		}})
+	} else {
+		fmt.Fprintln(w, "You have no active entry.")
+	}
+	fmt.Fprintln(w)
+
+	fprintWatchStatus(w, v.Status, v.Now)
+	fmt.Fprintln(w)
+
+	watchSectionColor.Fprintln(w, "Today:")
+	fprintEntryListSearched(w, v.Today, "", "")
+	fmt.Fprintln(w)
+
+	if v.Prompt != "" {
+		watchPromptColor.Fprint(w, v.Prompt, " ")
+		fmt.Fprintln(w, v.Input+"█")
+	} else {
+		for i, key := range keys {
+			if i > 0 {
+				fmt.Fprint(w, "  ")
+			}
+			watchKeyColor.Fprintf(w, "[%s]", key.Key)
+			watchKeyHelpColor.Fprint(w, " ", key.Description)
+		}
+		fmt.Fprintln(w)
+	}
+	if v.Error != nil {
+		watchErrorColor.Fprintln(w, v.Error)
+	} else if v.Message != "" {
+		watchMessageColor.Fprintln(w, v.Message)
+	}
+}
+
+func fprintWatchStatus(w io.Writer, status dinkur.Status, now time.Time) {
+	fmt.Fprint(w, "Status: ")
+	switch {
+	case status.AFKSince != nil && status.BackSince != nil:
+		watchStatusBackColor.Fprintf(w, "back since %s, after being away since %s (%s)",
+			status.BackSince.Format(timeFormatShort),
+			status.AFKSince.Format(timeFormatShort),
+			FormatDuration(status.BackSince.Sub(*status.AFKSince)))
+	case status.AFKSince != nil:
+		watchStatusAFKColor.Fprintf(w, "away since %s (%s)",
+			status.AFKSince.Format(timeFormatShort),
+			FormatDuration(now.Sub(*status.AFKSince)))
+	case status.NotTrackingSince != nil:
+		watchStatusAFKColor.Fprintf(w, "at keyboard, but not tracking since %s (%s)",
+			status.NotTrackingSince.Format(timeFormatShort),
+			FormatDuration(now.Sub(*status.NotTrackingSince)))
+		if status.SuggestedEntryName != "" {
+			fmt.Fprintf(w, ", suggestion: %s", status.SuggestedEntryName)
+		}
+	default:
+		watchStatusOKColor.Fprint(w, "at keyboard")
+	}
+	fmt.Fprintln(w)
+}
@@ -27,15 +27,14 @@ import (
 	"github.com/fatih/color"
 )
 
-func writeCellsLabelledEntry(t *table, labelled LabelledEntry) {
+func writeCellsLabelledEntry(t *table, labelled LabelledEntry, cols []EntryColumn) {
 	t.WriteCellColor(labelled.Label, entryLabelColor)
-	writeCellEntryID(t, labelled.Entry.ID)
-	writeCellEntryName(t, labelled.Entry.Name)
-	writeCellEntryStartEnd(t, labelled.Entry.Start, labelled.Entry.End)
-	if labelled.NoDuration {
-		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
-	} else {
-		writeCellDuration(t, labelled.Entry.Elapsed())
+	for _, col := range cols {
+		if col == EntryColumnDuration && labelled.NoDuration {
+			t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+			continue
+		}
+		writeCellsEntryColumns(t, labelled.Entry, []EntryColumn{col})
 	}
 }
 
@@ -48,13 +47,22 @@ func writeCellEntryID(t *table, id uint) {
 func writeCellEntryName(t *table, name string) {
 	var sb strings.Builder
 	width := writeEntryName(&sb, name)
-	t.WriteCellWidth(sb.String(), width)
+	t.WriteCellTruncatable(sb.String(), width, truncateCellEntryName(name))
 }
 
 func writeCellEntryNameSearched(t *table, name string, reg *regexp.Regexp) {
 	var sb strings.Builder
 	width := writeEntryNameSearched(&sb, name, reg)
-	t.WriteCellWidth(sb.String(), width)
+	// search highlighting is dropped from truncated names
+	t.WriteCellTruncatable(sb.String(), width, truncateCellEntryName(reg.ReplaceAllString(name, "$1")))
+}
+
+func truncateCellEntryName(name string) func(width int) tableCell {
+	return func(width int) tableCell {
+		var sb strings.Builder
+		w := writeEntryName(&sb, truncateString(name, width-2))
+		return tableCell{s: sb.String(), w: w}
+	}
 }
 
 func writeCellDate(t *table, d date) {
@@ -80,8 +88,11 @@ func writeCellEntryTimeSpanDuration(t *table, start time.Time, end *time.Time, d
 	t.WriteCellWidth(sb.String(), width)
 }
 
-func writeCellEntryStartEnd(t *table, start time.Time, end *time.Time) {
+func writeCellEntryStart(t *table, start time.Time) {
 	writeCellTimeColor(t, start, timeFormatShort, entryStartColor)
+}
+
+func writeCellEntryEnd(t *table, start time.Time, end *time.Time) {
 	if end != nil {
 		var endLayout = timeFormatShort
 		if newDate(end.Date()) != newDate(start.Date()) {
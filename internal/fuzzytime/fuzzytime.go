@@ -25,12 +25,9 @@ package fuzzytime
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 	"time"
-
-	"github.com/olebedev/when"
-	"github.com/olebedev/when/rules/common"
-	"github.com/olebedev/when/rules/en"
 )
 
 var (
@@ -38,28 +35,13 @@ var (
 	ErrUnknownFormat = errors.New("unknown time format")
 )
 
-var w *when.Parser
-
-func init() {
-	w = when.New(nil)
-	w.Add(en.All...)
-	w.Add(common.All...)
-}
-
-// Parse attempts to parse the string literal "now", a delta time, a list of
-// known formats, and lastly via the `when` fuzzy parsing package, and returns
-// the time on the first match it finds.
+// Parse attempts to parse the string literal "now" (or its equivalent in the
+// current locale), a delta time, a duration followed by "ago" (or its
+// equivalent in the current locale), a list of known formats, and lastly via the
+// `when` fuzzy parsing package using the rules of the current locale, and
+// returns the time on the first match it finds.
 func Parse(s string, base time.Time) (time.Time, error) {
-	if strings.EqualFold(s, "now") {
-		return time.Now(), nil
-	}
-	if t, ok := ParseDelta(s, base); ok {
-		return t, nil
-	}
-	if t, err := ParseKnownLayouts(s); err == nil {
-		return t, nil
-	}
-	return ParseWhen(s, base)
+	return activeLocaleParser().parse(s, base)
 }
 
 var knownLayouts = []string{
@@ -83,16 +65,10 @@ func ParseKnownLayouts(s string) (time.Time, error) {
 	return time.Time{}, ErrUnknownFormat
 }
 
-// ParseWhen performs a fuzzy time parsing via the `when` package.
+// ParseWhen performs a fuzzy time parsing via the `when` package, using the
+// rules of the current locale.
 func ParseWhen(s string, base time.Time) (time.Time, error) {
-	r, err := w.Parse(s, base.Truncate(time.Second))
-	if err != nil {
-		return time.Time{}, err
-	}
-	if r == nil {
-		return time.Time{}, ErrUnknownFormat
-	}
-	return r.Time, nil
+	return activeLocaleParser().parseWhen(s, base)
 }
 
 // ParseDelta attempts to parse the string as a time.Duration if it is prefixed
@@ -110,3 +86,42 @@ func ParseDelta(s string, base time.Time) (time.Time, bool) {
 	}
 	return base.Add(d), true
 }
+
+// ParseDuration attempts to parse the string as a duration in a more fuzzy
+// manner than time.ParseDuration. In addition to the formats supported by
+// time.ParseDuration, it accepts whitespace and spelled out units in English
+// or the current locale ("1 hour 30 min"), clock notation ("1:30" or
+// "1:30:00"), and plain numbers, which are treated as minutes ("45").
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), ""))
+	if s == "" {
+		return 0, ErrUnknownFormat
+	}
+	if d, err := time.ParseDuration(activeLocaleParser().durationUnitsReplacer.Replace(s)); err == nil {
+		return d, nil
+	}
+	if d, ok := parseDurationClock(s); ok {
+		return d, nil
+	}
+	if minutes, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(minutes * float64(time.Minute)), nil
+	}
+	return 0, ErrUnknownFormat
+}
+
+func parseDurationClock(s string) (time.Duration, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(n) * units[i]
+	}
+	return d, true
+}
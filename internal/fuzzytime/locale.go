@@ -0,0 +1,317 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fuzzytime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/fuzzytime/sv"
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/en"
+)
+
+// DefaultLocale is the name of the locale used when no other locale has been
+// selected, or when the locale from the environment is not supported.
+const DefaultLocale = "en"
+
+var (
+	// ErrUnknownLocale is returned when setting a locale that has not been
+	// registered.
+	ErrUnknownLocale = errors.New("unknown locale")
+)
+
+// Locale is a set of language-specific rules used when fuzzy parsing times
+// and durations.
+type Locale struct {
+	// Name is the language code of the locale, such as "en" or "sv".
+	Name string
+	// Description is a human readable name of the locale, such as "English".
+	Description string
+	// Rules are the `when` fuzzy parsing rules of the locale.
+	Rules []rules.Rule
+	// NowWords are words that mean the current time, such as "now".
+	NowWords []string
+	// DurationUnits maps spelled out duration units to the units used by
+	// time.ParseDuration, such as "hours" to "h".
+	DurationUnits map[string]string
+	// AgoWords are words that, when suffixed to a duration, mean that
+	// duration before the base time, such as "ago" in "2h ago".
+	AgoWords []string
+	// ForWords are words that separate the start time from the duration in
+	// a time range, such as "for" in "13:00 for 2h".
+	ForWords []string
+}
+
+var english = Locale{
+	Name:        "en",
+	Description: "English",
+	Rules:       en.All,
+	NowWords:    []string{"now"},
+	AgoWords:    []string{"ago"},
+	ForWords:    []string{"for"},
+	DurationUnits: map[string]string{
+		"hours": "h", "hour": "h", "hrs": "h", "hr": "h",
+		"minutes": "m", "minute": "m", "mins": "m", "min": "m",
+		"seconds": "s", "second": "s", "secs": "s", "sec": "s",
+	},
+}
+
+var swedish = Locale{
+	Name:        "sv",
+	Description: "Swedish",
+	Rules:       sv.All,
+	NowWords:    []string{"nu"},
+	AgoWords:    []string{"sedan", "sen"},
+	ForWords:    []string{"i"},
+	DurationUnits: map[string]string{
+		"timmar": "h", "timme": "h", "tim": "h",
+		"minuter": "m", "minut": "m",
+		"sekunder": "s", "sekund": "s", "sek": "s",
+	},
+}
+
+var (
+	localesMutex sync.RWMutex
+	locales      = map[string]*localeParser{}
+	active       *localeParser
+)
+
+func init() {
+	RegisterLocale(english)
+	RegisterLocale(swedish)
+	if err := SetLocale(LocaleFromEnv()); err != nil {
+		SetLocale(DefaultLocale)
+	}
+}
+
+// RegisterLocale adds a locale to the set of locales that can be selected
+// using SetLocale. Any previously registered locale with the same name is
+// replaced.
+func RegisterLocale(locale Locale) {
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+	name := strings.ToLower(locale.Name)
+	p := newLocaleParser(locale)
+	if active != nil && active.locale.Name == name {
+		active = p
+	}
+	locales[name] = p
+}
+
+// Locales returns all registered locales, sorted by name.
+func Locales() []Locale {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+	list := make([]Locale, 0, len(locales))
+	for _, p := range locales {
+		list = append(list, p.locale)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// CurrentLocale returns the locale used when parsing.
+func CurrentLocale() Locale {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+	return active.locale
+}
+
+// SetLocale changes the locale used when parsing. The English rules are
+// always used as a fallback after the rules of the selected locale.
+func SetLocale(name string) error {
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+	p, ok := locales[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownLocale, name)
+	}
+	active = p
+	return nil
+}
+
+// ParseAnyLocale is the same as Parse, but if parsing fails using the current
+// locale then it attempts all other registered locales as well. Useful when
+// parsing before the locale has been selected, such as when parsing
+// command-line flags before reading the config file.
+func ParseAnyLocale(s string, base time.Time) (time.Time, error) {
+	t, err := Parse(s, base)
+	if err == nil {
+		return t, nil
+	}
+	localesMutex.RLock()
+	parsers := make([]*localeParser, 0, len(locales))
+	for _, p := range locales {
+		if p != active {
+			parsers = append(parsers, p)
+		}
+	}
+	localesMutex.RUnlock()
+	sort.Slice(parsers, func(i, j int) bool {
+		return parsers[i].locale.Name < parsers[j].locale.Name
+	})
+	for _, p := range parsers {
+		if t, parseErr := p.parse(s, base); parseErr == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+type localeParser struct {
+	locale                Locale
+	when                  *when.Parser
+	nowWords              []string
+	agoWords              []string
+	forWords              []string
+	durationUnitsReplacer *strings.Replacer
+}
+
+func newLocaleParser(locale Locale) *localeParser {
+	p := &localeParser{locale: locale, when: when.New(nil)}
+	withFallbacks := []Locale{locale}
+	if !strings.EqualFold(locale.Name, english.Name) {
+		withFallbacks = append(withFallbacks, english)
+	}
+	units := map[string]string{}
+	for _, l := range withFallbacks {
+		p.when.Add(l.Rules...)
+		p.nowWords = append(p.nowWords, l.NowWords...)
+		p.agoWords = append(p.agoWords, l.AgoWords...)
+		p.forWords = append(p.forWords, l.ForWords...)
+		for unit, short := range l.DurationUnits {
+			if _, ok := units[unit]; !ok {
+				units[unit] = short
+			}
+		}
+	}
+	p.when.Add(common.All...)
+
+	// strings.Replacer prioritizes by argument order, so the longest units
+	// must come first so "minuter" is not replaced as "min" + "uter".
+	unitNames := make([]string, 0, len(units))
+	for unit := range units {
+		unitNames = append(unitNames, unit)
+	}
+	sort.Slice(unitNames, func(i, j int) bool {
+		if len(unitNames[i]) != len(unitNames[j]) {
+			return len(unitNames[i]) > len(unitNames[j])
+		}
+		return unitNames[i] < unitNames[j]
+	})
+	oldnew := make([]string, 0, len(unitNames)*2)
+	for _, unit := range unitNames {
+		oldnew = append(oldnew, unit, units[unit])
+	}
+	p.durationUnitsReplacer = strings.NewReplacer(oldnew...)
+	return p
+}
+
+func (p *localeParser) parse(s string, base time.Time) (time.Time, error) {
+	if p.isNowWord(s) {
+		return time.Now(), nil
+	}
+	if t, ok := ParseDelta(s, base); ok {
+		return t, nil
+	}
+	if t, ok := p.parseAgo(s, base); ok {
+		return t, nil
+	}
+	if t, err := ParseKnownLayouts(s); err == nil {
+		return t, nil
+	}
+	return p.parseWhen(s, base)
+}
+
+func (p *localeParser) parseAgo(s string, base time.Time) (time.Time, bool) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 || !containsFold(p.agoWords, fields[len(fields)-1]) {
+		return time.Time{}, false
+	}
+	d, err := ParseDuration(strings.Join(fields[:len(fields)-1], ""))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return base.Add(-d), true
+}
+
+func (p *localeParser) parseWhen(s string, base time.Time) (time.Time, error) {
+	r, err := p.when.Parse(s, base.Truncate(time.Second))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if r == nil {
+		return time.Time{}, ErrUnknownFormat
+	}
+	return r.Time, nil
+}
+
+func (p *localeParser) isNowWord(s string) bool {
+	return containsFold(p.nowWords, s)
+}
+
+func containsFold(words []string, s string) bool {
+	for _, word := range words {
+		if strings.EqualFold(s, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func activeLocaleParser() *localeParser {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+	return active
+}
+
+// LocaleFromEnv returns the language code from the $LC_ALL, $LC_TIME, or $LANG
+// environment variables, in that order of precedence, or DefaultLocale if
+// none are set. For example "sv_SE.UTF-8" results in "sv".
+func LocaleFromEnv() string {
+	for _, key := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if lang := languageCode(os.Getenv(key)); lang != "" {
+			return lang
+		}
+	}
+	return DefaultLocale
+}
+
+func languageCode(s string) string {
+	if i := strings.IndexAny(s, "_.@"); i != -1 {
+		s = s[:i]
+	}
+	s = strings.ToLower(s)
+	if s == "c" || s == "posix" {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,144 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fuzzytime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrRangeEndBeforeStart is returned when a parsed time range ends before
+	// it starts.
+	ErrRangeEndBeforeStart = errors.New("range end time is before start time")
+)
+
+// Range is a time range, with an optional end time.
+type Range struct {
+	Start time.Time
+	End   *time.Time
+}
+
+var clockRangeRegex = regexp.MustCompile(`^(.*?)\s*\b(\d{1,2}(?:[:.]\d{2})?)\s*(?:-|–)\s*(\d{1,2}(?:[:.]\d{2})?)$`)
+
+// ParseRange attempts to parse the string as a time range. Any of the
+// following forms are supported:
+//
+//	9-11:30                 (clock range, today)
+//	yesterday 9-11:30       (clock range, with a fuzzy date prefix)
+//	yesterday 13:00 for 2h  (fuzzy start time and a fuzzy duration)
+//	2h ago for 45m
+//	yesterday 13:00         (fuzzy start time, without end time)
+//
+// The word "for" may also be its equivalent in the current locale. Clock
+// ranges where the end is before the start, such as "22-01", are treated as
+// ending the following day.
+func ParseRange(s string, base time.Time) (Range, error) {
+	s = strings.TrimSpace(s)
+	if r, ok, err := parseClockRange(s, base); ok {
+		return r, err
+	}
+	if r, ok, err := parseForRange(s, base); ok {
+		return r, err
+	}
+	start, err := Parse(s, base)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: start}, nil
+}
+
+func parseClockRange(s string, base time.Time) (Range, bool, error) {
+	groups := clockRangeRegex.FindStringSubmatch(s)
+	if groups == nil {
+		return Range{}, false, nil
+	}
+	day := base
+	if prefix := strings.TrimSpace(groups[1]); prefix != "" {
+		var err error
+		day, err = Parse(prefix, base)
+		if err != nil {
+			return Range{}, false, nil
+		}
+	}
+	start, ok := parseClock(groups[2], day)
+	if !ok {
+		return Range{}, false, nil
+	}
+	end, ok := parseClock(groups[3], day)
+	if !ok {
+		return Range{}, false, nil
+	}
+	if end.Before(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return Range{Start: start, End: &end}, true, nil
+}
+
+func parseClock(s string, day time.Time) (time.Time, bool) {
+	hourStr, minuteStr, _ := cutAny(s, ":.")
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil || hour > 23 {
+		return time.Time{}, false
+	}
+	var minute int
+	if minuteStr != "" {
+		minute, err = strconv.Atoi(minuteStr)
+		if err != nil || minute > 59 {
+			return time.Time{}, false
+		}
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location()), true
+}
+
+func cutAny(s, chars string) (before, after string, found bool) {
+	if i := strings.IndexAny(s, chars); i != -1 {
+		return s[:i], s[i+1:], true
+	}
+	return s, "", false
+}
+
+func parseForRange(s string, base time.Time) (Range, bool, error) {
+	p := activeLocaleParser()
+	fields := strings.Fields(s)
+	for i := len(fields) - 2; i > 0; i-- {
+		if !containsFold(p.forWords, fields[i]) {
+			continue
+		}
+		d, err := ParseDuration(strings.Join(fields[i+1:], " "))
+		if err != nil {
+			continue
+		}
+		start, err := p.parse(strings.Join(fields[:i], " "), base)
+		if err != nil {
+			return Range{}, true, err
+		}
+		if d < 0 {
+			return Range{}, true, ErrRangeEndBeforeStart
+		}
+		end := start.Add(d)
+		return Range{Start: start, End: &end}, true, nil
+	}
+	return Range{}, false, nil
+}
@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sv
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"gopkg.in/typ.v4"
+)
+
+// CasualDate matches relative day words, such as "idag", "igår",
+// "i förrgår", "imorgon", "i övermorgon", "ikväll", and "inatt".
+func CasualDate(s rules.Strategy) rules.Rule {
+	overwrite := s == rules.Override
+
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(i\s*dag|i\s*kväll|i\s*natt|i\s*förrgår|i\s*går|i\s*övermorgon|i\s*morgon)(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			lower := strings.Join(strings.Fields(strings.ToLower(m.Captures[0])), "")
+
+			switch lower {
+			case "idag":
+			case "ikväll":
+				if c.Hour == nil && c.Minute == nil || overwrite {
+					c.Hour = typ.Ref(18)
+					c.Minute = typ.Ref(0)
+					c.Second = typ.Ref(0)
+				}
+			case "inatt":
+				if c.Hour == nil && c.Minute == nil || overwrite {
+					c.Hour = typ.Ref(23)
+					c.Minute = typ.Ref(0)
+					c.Second = typ.Ref(0)
+				}
+			case "igår":
+				if c.Duration == 0 || overwrite {
+					c.Duration -= 24 * time.Hour
+				}
+			case "iförrgår":
+				if c.Duration == 0 || overwrite {
+					c.Duration -= 2 * 24 * time.Hour
+				}
+			case "imorgon":
+				if c.Duration == 0 || overwrite {
+					c.Duration += 24 * time.Hour
+				}
+			case "iövermorgon":
+				if c.Duration == 0 || overwrite {
+					c.Duration += 2 * 24 * time.Hour
+				}
+			default:
+				return false, nil
+			}
+
+			return true, nil
+		},
+	}
+}
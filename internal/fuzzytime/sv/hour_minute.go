@@ -0,0 +1,88 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sv
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"gopkg.in/typ.v4"
+)
+
+const (
+	clockPrefixPattern = `kl(?:ockan|\.)?\s*`
+	hourPattern        = `((?:[0-1]?[0-9])|(?:2[0-3]))`
+)
+
+// HourMinute matches times of day using either colon or dot as separator,
+// with an optional "kl" or "klockan" prefix, such as "14:30", "kl 9.15", or
+// "klockan 08:00".
+func HourMinute(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)` +
+			`((?:` + clockPrefixPattern + `)?)` +
+			hourPattern + `[:.]([0-5][0-9])` +
+			`(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
+				return false, nil
+			}
+			hour, err := strconv.Atoi(m.Captures[1])
+			if err != nil {
+				return false, fmt.Errorf("hour minute rule: %w", err)
+			}
+			minute, err := strconv.Atoi(m.Captures[2])
+			if err != nil {
+				return false, fmt.Errorf("hour minute rule: %w", err)
+			}
+			c.Hour = &hour
+			c.Minute = &minute
+			c.Second = typ.Ref(0)
+			return true, nil
+		},
+	}
+}
+
+// Hour matches whole hours prefixed with "kl" or "klockan", such as "kl 14"
+// or "klockan 9".
+func Hour(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)` +
+			`(` + clockPrefixPattern + `)` + hourPattern +
+			`(?:[^\w:.]|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
+				return false, nil
+			}
+			hour, err := strconv.Atoi(m.Captures[1])
+			if err != nil {
+				return false, fmt.Errorf("hour rule: %w", err)
+			}
+			c.Hour = &hour
+			c.Minute = typ.Ref(0)
+			c.Second = typ.Ref(0)
+			return true, nil
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sv
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"gopkg.in/typ.v4"
+)
+
+const (
+	relativeAmountPattern = `(` + integerWordsPattern + `|[0-9]+|ett\s*par|några|en\s*halv|ett\s*halvt|halv|halvt)`
+	relativeUnitPattern   = `(sekund(?:er)?|sek|min(?:ut(?:er)?)?|timm(?:e|ar)|tim|dag(?:ar)?|veck(?:a|or)|månad(?:er)?|år)`
+)
+
+// PastTime matches durations in the past, such as "för 20 min sedan",
+// "2 timmar sen", or "för en halv timme sedan".
+func PastTime(s rules.Strategy) rules.Rule {
+	overwrite := s == rules.Override
+
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(?:för\s+)?` +
+			relativeAmountPattern + `\s*` + relativeUnitPattern +
+			`\s+(?:sedan|sen)(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			return applyRelative(m, c, ref, -1, overwrite)
+		},
+	}
+}
+
+// Deadline matches durations in the future, such as "om 20 min" or
+// "om en timme".
+func Deadline(s rules.Strategy) rules.Rule {
+	overwrite := s == rules.Override
+
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)om\s+` +
+			relativeAmountPattern + `\s*` + relativeUnitPattern +
+			`(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			return applyRelative(m, c, ref, 1, overwrite)
+		},
+	}
+}
+
+func applyRelative(m *rules.Match, c *rules.Context, ref time.Time, sign int, overwrite bool) (bool, error) {
+	amount := strings.Join(strings.Fields(strings.ToLower(m.Captures[0])), "")
+	unit := strings.ToLower(m.Captures[1])
+
+	var num float64
+	switch {
+	case strings.HasPrefix(amount, "ett") && strings.HasSuffix(amount, "par"):
+		num = 2
+	case amount == "några":
+		num = 3
+	case strings.Contains(amount, "halv"):
+		num = 0.5
+	default:
+		if n, ok := integerWords[amount]; ok {
+			num = float64(n)
+		} else {
+			n, err := strconv.Atoi(amount)
+			if err != nil {
+				return false, fmt.Errorf("convert %q to int: %w", amount, err)
+			}
+			num = float64(n)
+		}
+	}
+
+	var unitDur time.Duration
+	switch {
+	case strings.HasPrefix(unit, "sek"):
+		unitDur = time.Second
+	case strings.HasPrefix(unit, "min"):
+		unitDur = time.Minute
+	case strings.HasPrefix(unit, "tim"):
+		unitDur = time.Hour
+	case strings.HasPrefix(unit, "dag"):
+		unitDur = 24 * time.Hour
+	case strings.HasPrefix(unit, "veck"):
+		unitDur = 7 * 24 * time.Hour
+	case strings.HasPrefix(unit, "månad"):
+		if num != float64(int(num)) {
+			unitDur = 30 * 24 * time.Hour
+			break
+		}
+		if c.Month == nil || overwrite {
+			c.Month = typ.Ref(int(ref.Month()) + sign*int(num))
+		}
+		return true, nil
+	case unit == "år":
+		if num != float64(int(num)) {
+			unitDur = 365 * 24 * time.Hour
+			break
+		}
+		if c.Year == nil || overwrite {
+			c.Year = typ.Ref(ref.Year() + sign*int(num))
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+
+	if c.Duration == 0 || overwrite {
+		c.Duration = time.Duration(sign) * time.Duration(num*float64(unitDur))
+	}
+	return true, nil
+}
@@ -0,0 +1,73 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package sv contains Swedish rules for the olebedev/when fuzzy time parser,
+// such as "igår 14:00", "för 20 min sedan", or "i måndags".
+package sv
+
+import "github.com/olebedev/when/rules"
+
+// All contains all Swedish rules.
+var All = []rules.Rule{
+	Weekday(rules.Override),
+	CasualDate(rules.Override),
+	HourMinute(rules.Override),
+	Hour(rules.Override),
+	PastTime(rules.Override),
+	Deadline(rules.Override),
+}
+
+var weekdayOffset = map[string]int{
+	"söndag":  0,
+	"sön":     0,
+	"måndag":  1,
+	"mån":     1,
+	"tisdag":  2,
+	"tis":     2,
+	"onsdag":  3,
+	"ons":     3,
+	"torsdag": 4,
+	"tors":    4,
+	"tor":     4,
+	"fredag":  5,
+	"fre":     5,
+	"lördag":  6,
+	"lör":     6,
+}
+
+const weekdayOffsetPattern = `(?:söndag|sön|måndag|mån|tisdag|tis|onsdag|ons|torsdag|tors|tor|fredag|fre|lördag|lör)`
+
+var integerWords = map[string]int{
+	"en":   1,
+	"ett":  1,
+	"två":  2,
+	"tre":  3,
+	"fyra": 4,
+	"fem":  5,
+	"sex":  6,
+	"sju":  7,
+	"åtta": 8,
+	"nio":  9,
+	"tio":  10,
+	"elva": 11,
+	"tolv": 12,
+}
+
+const integerWordsPattern = `(?:en|ett|två|tre|fyra|fem|sex|sju|åtta|nio|tio|elva|tolv)`
@@ -0,0 +1,72 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sv
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+// Weekday matches weekdays, such as "måndag", "på fredag", "nästa tisdag",
+// "förra onsdag", or "i torsdags".
+func Weekday(s rules.Strategy) rules.Rule {
+	overwrite := s == rules.Override
+
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)` +
+			`(?:(i|förra|nästa|på|denna|den\s+här)\s+)?` +
+			`(` + weekdayOffsetPattern[3:] + // skip '(?:'
+			`(s?)(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			day := strings.ToLower(m.Captures[1])
+			dayInt, ok := weekdayOffset[day]
+			if !ok {
+				return false, nil
+			}
+			if c.Duration != 0 && !overwrite {
+				return false, nil
+			}
+
+			norm := strings.ToLower(m.Captures[0])
+			switch {
+			case norm == "förra", norm == "i" && m.Captures[2] != "":
+				diff := int(ref.Weekday()) - dayInt
+				if diff <= 0 {
+					diff += 7
+				}
+				c.Duration = -time.Duration(diff) * 24 * time.Hour
+			case norm == "denna", strings.HasPrefix(norm, "den"):
+				c.Duration = time.Duration(dayInt-int(ref.Weekday())) * 24 * time.Hour
+			default:
+				diff := dayInt - int(ref.Weekday())
+				if diff <= 0 {
+					diff += 7
+				}
+				c.Duration = time.Duration(diff) * 24 * time.Hour
+			}
+
+			return true, nil
+		},
+	}
+}
@@ -34,6 +34,10 @@ var TimeDefaultLayout = "Jan 02 15:04"
 // Time is a pflag.Value-compatible type for allowing datetimes to be used in
 // flags. The fuzzytime package is used to parse the user-provided flag
 // string value.
+//
+// Flags are parsed before the config file is read, so any registered locale
+// is accepted when setting the value, while the locale from the config is
+// given precedence when retrieving the time via Time or TimePtr.
 type Time struct {
 	Now    bool
 	source string
@@ -59,7 +63,7 @@ func (t *Time) String() string {
 // Set attempts to parse the string as a time.Time and updates its internal
 // state on success, or returns a parsing error if it fails.
 func (t *Time) Set(s string) error {
-	parsed, err := fuzzytime.Parse(s, time.Now())
+	parsed, err := fuzzytime.ParseAnyLocale(s, time.Now())
 	if err != nil {
 		return err
 	}
@@ -81,7 +85,7 @@ func (t *Time) Time(base time.Time) time.Time {
 	if t.Now || !t.valid {
 		return time.Now()
 	}
-	parsed, _ := fuzzytime.Parse(t.source, base)
+	parsed, _ := fuzzytime.ParseAnyLocale(t.source, base)
 	return parsed
 }
 
@@ -97,7 +101,7 @@ func (t *Time) TimePtr(base time.Time) *time.Time {
 	if !t.valid {
 		return nil
 	}
-	parsed, _ := fuzzytime.Parse(t.source, base)
+	parsed, _ := fuzzytime.ParseAnyLocale(t.source, base)
 	return &parsed
 }
 
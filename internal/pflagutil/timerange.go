@@ -22,6 +22,8 @@ package pflagutil
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dinkur/dinkur/pkg/timeutil"
@@ -30,19 +32,23 @@ import (
 
 // NewTimeRangePtr returns a pointer to a new TimeRange instance.
 func NewTimeRangePtr(shorthand timeutil.TimeSpanShorthand) *TimeRange {
-	return (*TimeRange)(&shorthand)
+	return &TimeRange{shorthand: shorthand}
 }
 
 // TimeRange is a pflag.Value-compatible type for allowing time span shorthand
-// enumeration to be used in flags.
-type TimeRange timeutil.TimeSpanShorthand
+// enumeration to be used in flags, including rolling windows such as
+// "last7d".
+type TimeRange struct {
+	shorthand timeutil.TimeSpanShorthand
+	count     uint
+}
 
 // String returns the string representation of the time range.
 func (r *TimeRange) String() string {
 	if r == nil {
 		return ""
 	}
-	switch timeutil.TimeSpanShorthand(*r) {
+	switch r.shorthand {
 	case timeutil.TimeSpanNone:
 		return "all"
 	case timeutil.TimeSpanPast:
@@ -61,6 +67,26 @@ func (r *TimeRange) String() string {
 		return "tomorrow"
 	case timeutil.TimeSpanNextWeek:
 		return "nextweek"
+	case timeutil.TimeSpanThisMonth:
+		return "month"
+	case timeutil.TimeSpanPrevMonth:
+		return "lastmonth"
+	case timeutil.TimeSpanNextMonth:
+		return "nextmonth"
+	case timeutil.TimeSpanThisQuarter:
+		return "quarter"
+	case timeutil.TimeSpanPrevQuarter:
+		return "lastquarter"
+	case timeutil.TimeSpanNextQuarter:
+		return "nextquarter"
+	case timeutil.TimeSpanThisYear:
+		return "year"
+	case timeutil.TimeSpanPrevYear:
+		return "lastyear"
+	case timeutil.TimeSpanNextYear:
+		return "nextyear"
+	case timeutil.TimeSpanLastDays:
+		return fmt.Sprintf("last%dd", r.count)
 	default:
 		return ""
 	}
@@ -69,11 +95,15 @@ func (r *TimeRange) String() string {
 // Set attempts to parse the string as a timeutil.TimeSpanShorthand and updates
 // its internal state on success, or returns a parsing error if it fails.
 func (r *TimeRange) Set(s string) error {
+	if count, ok := parseLastDays(s); ok {
+		*r = TimeRange{shorthand: timeutil.TimeSpanLastDays, count: count}
+		return nil
+	}
 	parsed, ok := parseShorthand(s)
 	if !ok {
 		return fmt.Errorf("unknown time range: %q", s)
 	}
-	*r = TimeRange(parsed)
+	*r = TimeRange{shorthand: parsed}
 	return nil
 }
 
@@ -87,7 +117,30 @@ func (r *TimeRange) TimeSpanShorthand() timeutil.TimeSpanShorthand {
 	if r == nil {
 		return timeutil.TimeSpanNone
 	}
-	return timeutil.TimeSpanShorthand(*r)
+	return r.shorthand
+}
+
+// Count returns the number of units used by rolling shorthands, such as the
+// N in "last N days", or zero if not applicable.
+func (r *TimeRange) Count() uint {
+	if r == nil {
+		return 0
+	}
+	return r.count
+}
+
+var lastDaysRegex = regexp.MustCompile(`^(?:last[\s-]*)?(\d+)[\s-]*d(?:ays?)?$`)
+
+func parseLastDays(s string) (uint, bool) {
+	groups := lastDaysRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if groups == nil {
+		return 0, false
+	}
+	count, err := strconv.ParseUint(groups[1], 10, 32)
+	if err != nil || count == 0 {
+		return 0, false
+	}
+	return uint(count), true
 }
 
 func parseShorthand(s string) (timeutil.TimeSpanShorthand, bool) {
@@ -110,6 +163,24 @@ func parseShorthand(s string) (timeutil.TimeSpanShorthand, bool) {
 		return timeutil.TimeSpanNextDay, true
 	case "nextweek", "nw":
 		return timeutil.TimeSpanNextWeek, true
+	case "month", "m":
+		return timeutil.TimeSpanThisMonth, true
+	case "lastmonth", "lm":
+		return timeutil.TimeSpanPrevMonth, true
+	case "nextmonth", "nm":
+		return timeutil.TimeSpanNextMonth, true
+	case "quarter", "q":
+		return timeutil.TimeSpanThisQuarter, true
+	case "lastquarter", "lq":
+		return timeutil.TimeSpanPrevQuarter, true
+	case "nextquarter", "nq":
+		return timeutil.TimeSpanNextQuarter, true
+	case "year":
+		return timeutil.TimeSpanThisYear, true
+	case "lastyear", "ly":
+		return timeutil.TimeSpanPrevYear, true
+	case "nextyear", "ny":
+		return timeutil.TimeSpanNextYear, true
 	default:
 		return timeutil.TimeSpanNone, false
 	}
@@ -138,5 +209,25 @@ func TimeRangeCompletion(*cobra.Command, []string, string) ([]string, cobra.Shel
 		"nd\tlist tomorrow's entries",
 		"nextweek\tlist next week's entries",
 		"nw\tlist next week's entries",
+		"month\tlist this month's entries",
+		"m\tlist this month's entries",
+		"lastmonth\tlist last month's entries",
+		"lm\tlist last month's entries",
+		"nextmonth\tlist next month's entries",
+		"nm\tlist next month's entries",
+		"quarter\tlist this quarter's entries",
+		"q\tlist this quarter's entries",
+		"lastquarter\tlist last quarter's entries",
+		"lq\tlist last quarter's entries",
+		"nextquarter\tlist next quarter's entries",
+		"nq\tlist next quarter's entries",
+		"year\tlist this year's entries",
+		"lastyear\tlist last year's entries",
+		"ly\tlist last year's entries",
+		"nextyear\tlist next year's entries",
+		"ny\tlist next year's entries",
+		"last7d\tlist entries from the last 7 days, including today",
+		"last30d\tlist entries from the last 30 days, including today",
+		"7d\tlist entries from the last 7 days, including today",
 	}, cobra.ShellCompDirectiveDefault
 }
@@ -29,17 +29,78 @@ import (
 
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"gopkg.in/typ.v4/chans"
+	"gopkg.in/typ.v4/slices"
 )
 
 // Errors specific to AFK-detectors.
 var (
-	ErrObserverIsNil = errors.New("observer is nil")
+	ErrObserverIsNil    = errors.New("observer is nil")
+	ErrUnknownHook      = errors.New("unknown AFK hook")
+	ErrNegativeDuration = errors.New("duration cannot be negative")
 )
 
-var afkPollIntervalDur = 3 * time.Second
-var afkThresholdDur = 5 * time.Minute
 var log = logger.NewScoped("AFK")
 
+// Options for the AFK-detector.
+type Options struct {
+	// Threshold is how long the user needs to be idle before being considered
+	// AFK.
+	Threshold time.Duration
+	// PollInterval is how often the hooks are checked for the user's idle
+	// time.
+	PollInterval time.Duration
+	// AllowHooks is a list of names of the hooks to use. All available hooks
+	// are used if the list is empty.
+	AllowHooks []string
+	// DenyHooks is a list of names of the hooks to never use. This takes
+	// precedence over the AllowHooks list.
+	DenyHooks []string
+}
+
+// DefaultOptions values are used for any zero values used when creating a new
+// AFK-detector or when changing its options.
+var DefaultOptions = Options{
+	Threshold:    5 * time.Minute,
+	PollInterval: 3 * time.Second,
+}
+
+func (opt Options) withDefaults() Options {
+	if opt.Threshold == 0 {
+		opt.Threshold = DefaultOptions.Threshold
+	}
+	if opt.PollInterval == 0 {
+		opt.PollInterval = DefaultOptions.PollInterval
+	}
+	return opt
+}
+
+func (opt Options) validate() error {
+	if opt.Threshold < 0 {
+		return fmt.Errorf("threshold: %w", ErrNegativeDuration)
+	}
+	if opt.PollInterval < 0 {
+		return fmt.Errorf("poll interval: %w", ErrNegativeDuration)
+	}
+	for _, name := range opt.AllowHooks {
+		if !slices.Contains(HookNames(), name) {
+			return fmt.Errorf("allow list: %w: %q", ErrUnknownHook, name)
+		}
+	}
+	for _, name := range opt.DenyHooks {
+		if !slices.Contains(HookNames(), name) {
+			return fmt.Errorf("deny list: %w: %q", ErrUnknownHook, name)
+		}
+	}
+	return nil
+}
+
+func (opt Options) hookEnabled(name string) bool {
+	if slices.Contains(opt.DenyHooks, name) {
+		return false
+	}
+	return len(opt.AllowHooks) == 0 || slices.Contains(opt.AllowHooks, name)
+}
+
 // Detector is an AFK-detector.
 type Detector interface {
 	// Start makes this detector start listening for OS-specific events to then
@@ -50,19 +111,32 @@ type Detector interface {
 	// StopDetecting makes this detector stop listening for OS-specific events by
 	// cleaning up its Goroutines and hooks.
 	StopDetecting() error
+	// Options returns the options currently used by this detector.
+	Options() Options
+	// SetOptions changes the options used by this detector. If the detector is
+	// currently detecting, then its hooks are restarted to apply the changes.
+	SetOptions(opt Options) error
 
 	StartedObs() *chans.PubSub[Started]
 	StoppedObs() *chans.PubSub[Stopped]
 }
 
 // Started contains event data for when user has gone AFK.
-type Started struct{}
+type Started struct {
+	// Since is when the user went AFK. This is usually when the event was
+	// sent, but some hooks only detect that the user has been AFK afterwards,
+	// such as when the computer has been suspended.
+	Since time.Time
+	// Hook is the name of the hook that detected that the user went AFK.
+	Hook string
+}
 
 // Stopped contains event data for when user is no longer AFK (after being AFK).
 type Stopped struct {
 }
 
 type detectorHookRegisterer interface {
+	Name() string
 	Register(*detector) (detectorHook, error)
 }
 
@@ -73,9 +147,24 @@ type detectorHook interface {
 
 var detectorHooks []detectorHookRegisterer
 
+// HookNames returns the names of all AFK hooks available on this OS. These
+// names are used in the Options allow and deny lists.
+func HookNames() []string {
+	names := make([]string, len(detectorHooks))
+	for i, reg := range detectorHooks {
+		names[i] = reg.Name()
+	}
+	return names
+}
+
 // New creates a new AFK-detector.
-func New() Detector {
+//
+// Both the global DefaultOptions and the opt parameter is used. The
+// DefaultOptions values are only used for any zero valued fields in the
+// opt parameter.
+func New(opt Options) Detector {
 	return &detector{
+		opt: opt.withDefaults(),
 		startedObs: chans.PubSub[Started]{
 			PubTimeoutAfter: 10 * time.Second,
 			OnPubTimeout: func(ev Started) {
@@ -97,6 +186,9 @@ type detector struct {
 	startedObs chans.PubSub[Started]
 	stoppedObs chans.PubSub[Stopped]
 
+	opt      Options
+	optMutex sync.RWMutex
+
 	hooks          []detectorHook
 	startStopMutex sync.Mutex
 	ticker         *time.Ticker
@@ -111,12 +203,16 @@ func (d *detector) tryChangeIsAFK(isAFK bool) bool {
 	return true
 }
 
-func (d *detector) markAsAFK() {
+func (d *detector) markAsAFK(hook string) {
+	d.markAsAFKSince(time.Now(), hook)
+}
+
+func (d *detector) markAsAFKSince(since time.Time, hook string) {
 	if !d.tryChangeIsAFK(true) {
 		return
 	}
-	log.Debug().Message("User is now AFK.")
-	d.startedObs.PubWait(Started{})
+	log.Debug().WithTime("since", since).WithString("hook", hook).Message("User is now AFK.")
+	d.startedObs.PubWait(Started{Since: since, Hook: hook})
 }
 
 func (d *detector) markAsNoLongerAFK() {
@@ -127,32 +223,98 @@ func (d *detector) markAsNoLongerAFK() {
 	d.stoppedObs.PubWait(Stopped{})
 }
 
+func (d *detector) threshold() time.Duration {
+	d.optMutex.RLock()
+	defer d.optMutex.RUnlock()
+	return d.opt.Threshold
+}
+
+func (d *detector) pollInterval() time.Duration {
+	d.optMutex.RLock()
+	defer d.optMutex.RUnlock()
+	return d.opt.PollInterval
+}
+
+func (d *detector) Options() Options {
+	d.optMutex.RLock()
+	defer d.optMutex.RUnlock()
+	opt := d.opt
+	opt.AllowHooks = append([]string(nil), opt.AllowHooks...)
+	opt.DenyHooks = append([]string(nil), opt.DenyHooks...)
+	return opt
+}
+
+func (d *detector) SetOptions(opt Options) error {
+	opt = opt.withDefaults()
+	if err := opt.validate(); err != nil {
+		return err
+	}
+	d.startStopMutex.Lock()
+	defer d.startStopMutex.Unlock()
+	d.optMutex.Lock()
+	d.opt = opt
+	d.optMutex.Unlock()
+	if d.ticker == nil {
+		return nil
+	}
+	log.Debug().Message("Restarting AFK hooks to apply new options.")
+	d.stopHooksNoLock()
+	return d.startHooksNoLock()
+}
+
 func (d *detector) StartDetecting() error {
+	d.startStopMutex.Lock()
+	defer d.startStopMutex.Unlock()
+	return d.startHooksNoLock()
+}
+
+func (d *detector) startHooksNoLock() error {
 	if len(detectorHooks) == 0 {
 		log.Warn().Message("No AFK-detectors available for this OS.")
 		return nil
 	}
-	d.startStopMutex.Lock()
-	defer d.startStopMutex.Unlock()
+	opt := d.Options()
+	if err := opt.validate(); err != nil {
+		return err
+	}
 	d.hooks = nil
 	for _, reg := range detectorHooks {
+		if !opt.hookEnabled(reg.Name()) {
+			log.Debug().WithString("hook", reg.Name()).Message("Skipping disabled AFK hook.")
+			continue
+		}
 		hook, err := reg.Register(d)
 		if err != nil {
-			d.StopDetecting()
+			d.stopHooksNoLock()
 			return err
 		}
 		if hook != nil {
 			d.hooks = append(d.hooks, hook)
 		}
 	}
-	d.ticker = time.NewTicker(afkPollIntervalDur)
-	go d.timerTickListener(d.ticker)
+	d.ticker = time.NewTicker(opt.PollInterval)
+	d.tickChanStop = make(chan struct{})
+	go d.timerTickListener(d.ticker, d.tickChanStop, d.hooks)
 	return nil
 }
 
 func (d *detector) StopDetecting() error {
 	d.startStopMutex.Lock()
-	defer d.startStopMutex.Unlock()
+	d.stopHooksNoLock()
+	d.startStopMutex.Unlock()
+	unsubStartErr := d.startedObs.UnsubAll()
+	unsubStopErr := d.stoppedObs.UnsubAll()
+	if unsubStartErr != nil && unsubStopErr != nil {
+		return fmt.Errorf("unsub all afk-start and stop subs: %w; %v", unsubStartErr, unsubStopErr)
+	} else if unsubStartErr != nil {
+		return fmt.Errorf("unsub all afk-start subs: %w", unsubStartErr)
+	} else if unsubStopErr != nil {
+		return fmt.Errorf("unsub all afk-stop subs: %w", unsubStopErr)
+	}
+	return nil
+}
+
+func (d *detector) stopHooksNoLock() {
 	for _, hook := range d.hooks {
 		if err := hook.Unregister(); err != nil {
 			log.Error().WithError(err).Messagef("Failed to unregister %T.", hook)
@@ -164,29 +326,19 @@ func (d *detector) StopDetecting() error {
 		d.ticker = nil
 	}
 	if d.tickChanStop != nil {
-		d.tickChanStop <- struct{}{}
+		close(d.tickChanStop)
 		d.tickChanStop = nil
 	}
-	unsubStartErr := d.startedObs.UnsubAll()
-	unsubStopErr := d.stoppedObs.UnsubAll()
-	if unsubStartErr != nil && unsubStopErr != nil {
-		return fmt.Errorf("unsub all afk-start and stop subs: %w; %v", unsubStartErr, unsubStopErr)
-	} else if unsubStartErr != nil {
-		return fmt.Errorf("unsub all afk-start subs: %w", unsubStartErr)
-	} else if unsubStopErr != nil {
-		return fmt.Errorf("unsub all afk-stop subs: %w", unsubStopErr)
-	}
-	return nil
 }
 
-func (d *detector) timerTickListener(ticker *time.Ticker) {
+func (d *detector) timerTickListener(ticker *time.Ticker, stop <-chan struct{}, hooks []detectorHook) {
 	for {
 		select {
-		case <-d.tickChanStop:
+		case <-stop:
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			for _, hook := range d.hooks {
+			for _, hook := range hooks {
 				if err := hook.Tick(); err != nil {
 					log.Warn().WithError(err).
 						Messagef("Failed to tick AFK hook %T.", hook)
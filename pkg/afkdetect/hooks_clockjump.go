@@ -0,0 +1,88 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package afkdetect
+
+import (
+	"time"
+)
+
+const clockJumpHookName = "clockjump"
+
+func init() {
+	detectorHooks = append(detectorHooks, clockJumpHookRegisterer{})
+}
+
+type clockJumpHookRegisterer struct {
+}
+
+func (h clockJumpHookRegisterer) Name() string {
+	return clockJumpHookName
+}
+
+func (h clockJumpHookRegisterer) Register(d *detector) (detectorHook, error) {
+	if d == nil {
+		return nil, nil
+	}
+	log.Debug().Message("Registering wall-clock jump detection.")
+	return &clockJumpHook{
+		d:        d,
+		lastTick: time.Now(),
+	}, nil
+}
+
+// clockJumpHook detects when the computer has been suspended or hibernated,
+// as the tick timer does not tick while the computer is asleep.
+type clockJumpHook struct {
+	d        *detector
+	lastTick time.Time
+}
+
+func (h *clockJumpHook) Unregister() error {
+	log.Debug().Message("Unregistering wall-clock jump detection.")
+	return nil
+}
+
+func (h *clockJumpHook) Tick() error {
+	now := time.Now()
+	lastTick := h.lastTick
+	h.lastTick = now
+	var (
+		// Go's monotonic clock does not include time spent in suspension on
+		// some OS'es (e.g GNU/Linux), while on others it does (e.g Windows).
+		// The wall clock always does, so checking both covers both cases.
+		monoElapsed = now.Sub(lastTick)
+		wallElapsed = now.Round(0).Sub(lastTick.Round(0))
+		unobserved  = wallElapsed - monoElapsed
+		overdue     = monoElapsed - h.d.pollInterval()
+		threshold   = h.d.threshold()
+	)
+	if unobserved < threshold && overdue < threshold {
+		return nil
+	}
+	log.Debug().
+		WithDuration("wallElapsed", wallElapsed).
+		WithDuration("monoElapsed", monoElapsed).
+		Message("Detected wall-clock jump. Computer was most likely suspended.")
+	h.d.markAsAFKSince(lastTick, clockJumpHookName)
+	// Someone had to wake the computer up, so the user is most likely back.
+	h.d.markAsNoLongerAFK()
+	return nil
+}
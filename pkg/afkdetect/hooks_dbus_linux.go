@@ -26,6 +26,8 @@ import (
 	"github.com/godbus/dbus/v5"
 )
 
+const dbusHookName = "dbus"
+
 func init() {
 	detectorHooks = append(detectorHooks, dbusHookRegisterer{})
 }
@@ -33,6 +35,10 @@ func init() {
 type dbusHookRegisterer struct {
 }
 
+func (h dbusHookRegisterer) Name() string {
+	return dbusHookName
+}
+
 func (h dbusHookRegisterer) Register(d *detector) (detectorHook, error) {
 	if d == nil {
 		return nil, nil
@@ -85,7 +91,7 @@ func (h *dbusHook) handleDbusSignal() {
 				continue
 			}
 			if activeChanged {
-				h.d.markAsAFK()
+				h.d.markAsAFK(dbusHookName)
 			} else {
 				h.d.markAsNoLongerAFK()
 			}
@@ -125,8 +131,8 @@ func (h *dbusHook) Tick() error {
 		}
 	}
 	idleDur := time.Duration(idleDurMs) * time.Millisecond
-	if idleDur > afkThresholdDur {
-		h.d.markAsAFK()
+	if idleDur > h.d.threshold() {
+		h.d.markAsAFK(dbusHookName)
 	} else {
 		h.d.markAsNoLongerAFK()
 	}
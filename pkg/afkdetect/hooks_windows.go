@@ -30,6 +30,8 @@ import (
 
 var singletonWindowsHooks = &windowsHooks{}
 
+const windowsHookName = "windows"
+
 func init() {
 	detectorHooks = append(detectorHooks, singletonWindowsHooks)
 }
@@ -39,6 +41,10 @@ type windowsHooks struct {
 	detMutex sync.RWMutex
 }
 
+func (h *windowsHooks) Name() string {
+	return windowsHookName
+}
+
 func (h *windowsHooks) Register(d *detector) (detectorHook, error) {
 	if d == nil {
 		return nil, nil
@@ -87,7 +93,7 @@ func convUnregisterCodeToErr(code int32) error {
 
 func (h *windowsHooks) Tick() error {
 	if bool(C.GetWorkstationLocked()) {
-		h.detector.markAsAFK()
+		h.detector.markAsAFK(windowsHookName)
 		return nil
 	}
 	if err := convSysErrCode(int32(C.GetThreadStatus())); err != nil {
@@ -95,8 +101,8 @@ func (h *windowsHooks) Tick() error {
 	}
 	sinceAFKMs := C.GetTickMs() - C.GetLastEventTickMs()
 	sinceAFK := (time.Duration(sinceAFKMs) * time.Millisecond).Truncate(time.Second)
-	if sinceAFK > afkThresholdDur {
-		h.detector.markAsAFK()
+	if sinceAFK > h.detector.threshold() {
+		h.detector.markAsAFK(windowsHookName)
 	} else {
 		h.detector.markAsNoLongerAFK()
 	}
@@ -52,20 +52,29 @@ const (
 
 // Column names for Entry.
 const (
-	EntryColumnID    = "id"
-	EntryColumnStart = "start"
-	EntryColumnEnd   = "end"
+	EntryColumnID            = "id"
+	EntryColumnUUID          = "uuid"
+	EntryColumnStart         = "start"
+	EntryColumnEnd           = "end"
+	EntryColumnContinuesUUID = "continues_uuid"
 )
 
 // Entry is a time tracked entry stored in the database.
 type Entry struct {
 	CommonFields
+	// UUID is a globally unique identifier for this entry, in the form of a
+	// ULID. Unlike the ID, which is only unique within a single database, the
+	// UUID is the same across all databases the entry has been synced to.
+	UUID string `gorm:"not null;default:'';index"`
 	// Name of the entry.
 	Name string `gorm:"not null;default:''"`
 	// Start time of the entry.
 	Start time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
 	// End time of the entry, or nil if the entry is still active.
 	End *time.Time `gorm:"index"`
+	// ContinuesUUID is the UUID of the entry that this entry continues, such
+	// as when resuming a paused entry, or empty.
+	ContinuesUUID string `gorm:"not null;default:'';index"`
 }
 
 // Elapsed returns the duration of the entry. If the entry is currently active,
@@ -97,12 +106,138 @@ func (EntryFTS5) TableName() string {
 	return "entries_idx"
 }
 
+// Column names for EntryChange.
+const (
+	EntryChangeColumnEntryUUID = "entry_uuid"
+	EntryChangeColumnNodeID    = "node_id"
+	EntryChangeColumnSeq       = "seq"
+	EntryChangeColumnTimestamp = "timestamp"
+)
+
+// EntryChangeField is an enumeration of which entry field an EntryChange
+// applies to.
+type EntryChangeField string
+
+// Known entry change fields.
+const (
+	EntryChangeFieldName      EntryChangeField = "name"
+	EntryChangeFieldStart     EntryChangeField = "start"
+	EntryChangeFieldEnd       EntryChangeField = "end"
+	EntryChangeFieldDeleted   EntryChangeField = "deleted"
+	EntryChangeFieldContinues EntryChangeField = "continues"
+)
+
+// EntryChange is a single field change to an entry. The changes make up an
+// append-only log that is exchanged when syncing with other databases, while
+// the Entry table holds the resolved state of the log for faster queries.
+type EntryChange struct {
+	CommonFields
+	// EntryUUID is the UUID of the entry that was changed.
+	EntryUUID string `gorm:"not null;index"`
+	// NodeID is the ID of the SyncNode that made the change.
+	NodeID string `gorm:"not null;uniqueIndex:idx_entry_changes_node_seq"`
+	// Seq is a sequence number of the change, incremented per node.
+	Seq uint64 `gorm:"not null;uniqueIndex:idx_entry_changes_node_seq"`
+	// Timestamp is a hybrid logical clock, in nanoseconds since the Unix epoch,
+	// of when the change was made. It is used to find the last write of each
+	// field.
+	Timestamp int64 `gorm:"not null"`
+	// Field is the entry field that was changed.
+	Field EntryChangeField `gorm:"not null"`
+	// Value is the new value of the field, encoded as a string. Times are
+	// encoded using RFC 3339 with nanoseconds, where an empty string means nil.
+	// Deletions hold the JSON-encoded vector clock of the entry's changes that
+	// were observed when the entry was deleted.
+	Value string `gorm:"not null;default:''"`
+}
+
+// SyncNode holds the identity of this database used when syncing with other
+// databases. At most one row of this object is expected to be in the database
+// at any given time.
+type SyncNode struct {
+	CommonFields
+	// NodeID is a globally unique identifier of this database, in the form of
+	// a ULID.
+	NodeID string `gorm:"not null"`
+}
+
+// Column names for Status.
+const (
+	StatusColumnHeartbeat = "heartbeat"
+)
+
 // Status is used to track the user's current status, such as if they're
 // currently AFK.
 type Status struct {
 	CommonFields
 	AFKSince  *time.Time
 	BackSince *time.Time
+	// Heartbeat is when the Dinkur daemon was last known to be running.
+	Heartbeat *time.Time
+	// NotTrackingSince is set when the user has been at the keyboard for a
+	// while without any active entry.
+	NotTrackingSince   *time.Time
+	SuggestedEntryName string
+	// PausedEntryID is the ID of the entry that was paused and can be
+	// resumed, or zero if there is no paused entry.
+	PausedEntryID uint
+}
+
+// Column names for AFKPeriod.
+const (
+	AFKPeriodColumnStart      = "start"
+	AFKPeriodColumnEnd        = "end"
+	AFKPeriodColumnResolution = "resolution"
+)
+
+// AFKResolution is an enumeration of how an AFK period was resolved by the
+// user.
+type AFKResolution string
+
+// Known AFK resolution values.
+const (
+	AFKResolutionNone      AFKResolution = ""
+	AFKResolutionKeep      AFKResolution = "keep"
+	AFKResolutionDiscard   AFKResolution = "discard"
+	AFKResolutionNewEntry  AFKResolution = "new-entry"
+	AFKResolutionDismissed AFKResolution = "dismissed"
+	AFKResolutionSplit     AFKResolution = "split"
+)
+
+// AFKPeriod is a historical record of when the user was AFK, stored in the
+// database. While Status only holds the current AFK period, a new AFKPeriod
+// is added for each time the user goes AFK.
+type AFKPeriod struct {
+	CommonFields
+	// Start is when the user went AFK.
+	Start time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
+	// End is when the user returned from being AFK, or nil if the user is still
+	// AFK.
+	End *time.Time `gorm:"index"`
+	// Hook is the name of the AFK detector hook that detected that the user
+	// went AFK.
+	Hook string `gorm:"not null;default:''"`
+	// Resolution is how the user resolved the time spent away. An empty value
+	// means it is not yet resolved.
+	Resolution AFKResolution `gorm:"not null;default:'';index"`
+}
+
+// Column names for PomodoroCycle.
+const (
+	PomodoroCycleColumnStart = "start"
+	PomodoroCycleColumnEnd   = "end"
+)
+
+// PomodoroCycle is a completed work period of a Pomodoro timer.
+type PomodoroCycle struct {
+	CommonFields
+	// EntryUUID is the UUID of the entry that was active during the work
+	// period.
+	EntryUUID string `gorm:"not null;default:'';index"`
+	// Start is when the work period started.
+	Start time.Time `gorm:"not null;index"`
+	// End is when the work period was completed.
+	End time.Time `gorm:"not null;index"`
 }
 
 // Migration holds the latest migration revision identifier. At most one row of
@@ -118,7 +253,7 @@ type MigrationVersion int
 // LatestMigrationVersion is an integer revision identifier for what migration
 // was last applied to the database. This is stored in the database to quickly
 // figure out if new migrations needs to be applied.
-const LatestMigrationVersion MigrationVersion = 8
+const LatestMigrationVersion MigrationVersion = 14
 
 const (
 	// MigrationUnknown means that Dinkur was unable to evaluate the database's
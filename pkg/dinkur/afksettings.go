@@ -0,0 +1,84 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkur
+
+import (
+	"context"
+	"time"
+)
+
+// AFKDetector is the Dinkur client methods targeted to the AFK detection of a
+// Dinkur daemon. Like the Pairer interface, this is only implemented by
+// clients that talk to a Dinkur daemon, as the daemon is what detects when the
+// user goes AFK, and is therefore not part of the Client interface.
+type AFKDetector interface {
+	GetAFKSettings(ctx context.Context) (AFKSettings, error)
+	UpdateAFKSettings(ctx context.Context, edit EditAFKSettings) (AFKSettings, error)
+}
+
+// AFKSettings is the settings used by a Dinkur daemon's AFK detection.
+type AFKSettings struct {
+	// Threshold is how long the user needs to be idle before being considered
+	// AFK.
+	Threshold time.Duration
+	// PollInterval is how often the user's idle time is checked.
+	PollInterval time.Duration
+	// AllowHooks is a list of names of the AFK hooks to use. All available
+	// hooks are used if the list is empty.
+	AllowHooks []string
+	// DenyHooks is a list of names of the AFK hooks to never use. This takes
+	// precedence over the AllowHooks list.
+	DenyHooks []string
+	// AvailableHooks is a list of names of all AFK hooks available on the
+	// daemon's OS.
+	AvailableHooks []string
+}
+
+// EditAFKSettings holds parameters used when changing the settings of a Dinkur
+// daemon's AFK detection.
+type EditAFKSettings struct {
+	// Threshold is the new AFK threshold. Setting it to zero resets it to the
+	// daemon's default value.
+	//
+	// No change to the threshold is applied if this is set to nil.
+	Threshold *time.Duration
+	// PollInterval is the new poll interval. Setting it to zero resets it to
+	// the daemon's default value.
+	//
+	// No change to the poll interval is applied if this is set to nil.
+	PollInterval *time.Duration
+	// AllowHooks is the new list of names of the AFK hooks to use.
+	//
+	// No change to the list is applied if this is empty, unless
+	// ClearAllowHooks is enabled.
+	AllowHooks []string
+	// DenyHooks is the new list of names of the AFK hooks to never use.
+	//
+	// No change to the list is applied if this is empty, unless
+	// ClearDenyHooks is enabled.
+	DenyHooks []string
+	// ClearAllowHooks empties the list of AFK hooks to use, so that all
+	// available hooks are used. This is ignored if AllowHooks is not empty.
+	ClearAllowHooks bool
+	// ClearDenyHooks empties the list of AFK hooks to never use. This is
+	// ignored if DenyHooks is not empty.
+	ClearDenyHooks bool
+}
@@ -34,13 +34,17 @@ import (
 
 // Common errors used by multiple Dinkur client and daemon implementations.
 var (
-	ErrAlreadyConnected    = errors.New("client is already connected to database")
-	ErrNotConnected        = errors.New("client is not connected to database")
-	ErrEntryNameEmpty      = errors.New("entry name cannot be empty")
-	ErrEntryEndBeforeStart = errors.New("entry end time cannot be before start time")
-	ErrNotFound            = gorm.ErrRecordNotFound
-	ErrLimitTooLarge       = errors.New("search limit is too large, maximum: " + strconv.Itoa(math.MaxInt))
-	ErrClientIsNil         = errors.New("client is nil")
+	ErrAlreadyConnected     = errors.New("client is already connected to database")
+	ErrNotConnected         = errors.New("client is not connected to database")
+	ErrEntryNameEmpty       = errors.New("entry name cannot be empty")
+	ErrEntryEndBeforeStart  = errors.New("entry end time cannot be before start time")
+	ErrNotFound             = gorm.ErrRecordNotFound
+	ErrLimitTooLarge        = errors.New("search limit is too large, maximum: " + strconv.Itoa(math.MaxInt))
+	ErrClientIsNil          = errors.New("client is nil")
+	ErrNotAFK               = errors.New("user is not AFK")
+	ErrAFKResolutionInvalid = errors.New("invalid AFK resolution")
+	ErrEntryChangeInvalid   = errors.New("invalid entry change")
+	ErrNoPausedEntry        = errors.New("no paused entry to resume")
 )
 
 // Client is a Dinkur client interface. This is the core interface to act upon
@@ -54,6 +58,8 @@ type Client interface {
 
 	Entries
 	Statuses
+	Sync
+	PomodoroCycles
 }
 
 // Entries is the Dinkur client methods targeted to reading, creating, and
@@ -66,6 +72,8 @@ type Entries interface {
 	DeleteEntry(ctx context.Context, id uint) (Entry, error)
 	CreateEntry(ctx context.Context, entry NewEntry) (StartedEntry, error)
 	StopActiveEntry(ctx context.Context, endTime time.Time) (*Entry, error)
+	PauseActiveEntry(ctx context.Context, endTime time.Time) (*Entry, error)
+	ResumeEntry(ctx context.Context, resume ResumeEntry) (StartedEntry, error)
 	StreamEntry(ctx context.Context) (<-chan StreamedEntry, error)
 }
 
@@ -75,6 +83,18 @@ type Statuses interface {
 	StreamStatus(ctx context.Context) (<-chan StreamedStatus, error)
 	SetStatus(ctx context.Context, edit EditStatus) error
 	GetStatus(ctx context.Context) (Status, error)
+	SetHeartbeat(ctx context.Context, heartbeat time.Time) error
+	SetNotTracking(ctx context.Context, edit EditNotTracking) error
+	GetAFKPeriodList(ctx context.Context, search SearchAFKPeriod) ([]AFKPeriod, error)
+	ResolveAFK(ctx context.Context, resolve ResolveAFK) (ResolvedAFK, error)
+}
+
+// Sync is the Dinkur client methods targeted to syncing entries between
+// multiple Dinkur databases, such as between a desktop and a phone.
+type Sync interface {
+	GetSyncNode(ctx context.Context) (SyncNode, error)
+	GetSyncChanges(ctx context.Context, search SearchSyncChanges) ([]EntryChange, error)
+	ApplySyncChanges(ctx context.Context, changes []EntryChange) error
 }
 
 // SearchEntry holds parameters used when searching for list of entries.
@@ -84,11 +104,38 @@ type SearchEntry struct {
 	Limit uint
 
 	Shorthand          timeutil.TimeSpanShorthand
+	ShorthandCount     uint // N in rolling shorthands, such as "last N days"
 	NameFuzzy          string
 	NameHighlightStart string
 	NameHighlightEnd   string
 }
 
+// SearchSyncChanges holds parameters used when getting entry changes to sync.
+// The changes are ordered by node ID and sequence number, so that the next
+// page of changes can be gotten by advancing the Since vector clock using the
+// previous page.
+type SearchSyncChanges struct {
+	// Since is the vector clock of changes that are already known, and are
+	// therefore excluded. Changes from nodes not in the vector clock are all
+	// included.
+	Since VectorClock
+	// Limit is the maximum number of changes to get. A value of zero means no
+	// limit is applied. Unlike SearchEntry, the limit is applied at the start
+	// of the results.
+	Limit uint
+}
+
+// SearchAFKPeriod holds parameters used when searching for list of AFK
+// periods.
+type SearchAFKPeriod struct {
+	Start *time.Time
+	End   *time.Time
+	Limit uint
+
+	Shorthand      timeutil.TimeSpanShorthand
+	ShorthandCount uint // N in rolling shorthands, such as "last N days"
+}
+
 // EditEntry holds parameters used when editing a entry.
 type EditEntry struct {
 	// IDOrZero of the entry to edit. If set to nil, then Dinkur will attempt to make
@@ -104,7 +151,9 @@ type EditEntry struct {
 	//
 	// No change to the entry start timestamp is applied if this is set to nil.
 	Start *time.Time
-	// StartFuzzy is the new entry start timestamp, but will be parsed fuzzy.
+	// StartFuzzy is the new entry start timestamp, but will be parsed fuzzy
+	// using the locale configured where the edit is applied, such as in the
+	// daemon when using the gRPC client.
 	// This is ignored if empty string or if Start is supplied.
 	//
 	// No change to the entry start timestamp is applied if this is set to empty.
@@ -113,7 +162,9 @@ type EditEntry struct {
 	//
 	// No change to the entry end timestamp is applied if this is set to nil.
 	End *time.Time
-	// EndFuzzy is the new entry end timestamp, but will be parsed fuzzy.
+	// EndFuzzy is the new entry end timestamp, but will be parsed fuzzy
+	// using the locale configured where the edit is applied, such as in the
+	// daemon when using the gRPC client.
 	// This is ignored if empty string or if End is supplied.
 	//
 	// No change to the entry end timestamp is applied if this is set to empty.
@@ -151,6 +202,16 @@ type StartedEntry struct {
 	Stopped *Entry
 }
 
+// ResumeEntry holds parameters used when resuming an entry.
+type ResumeEntry struct {
+	// IDOrZero of the entry to resume. If zero, then the paused entry is
+	// resumed.
+	IDOrZero uint
+	// Start is the start time of the resumed entry. The current time is used
+	// if nil.
+	Start *time.Time
+}
+
 // StreamedEntry holds a entry and its event type.
 type StreamedEntry struct {
 	Entry Entry
@@ -166,4 +227,47 @@ type StreamedStatus struct {
 type EditStatus struct {
 	AFKSince  *time.Time // set if currently AFK
 	BackSince *time.Time // set if returned from being AFK
+	// AFKHook is the name of the AFK detector hook that detected that the user
+	// went AFK. Only used when the AFKSince field is newly set.
+	AFKHook string
+	// AFKResolution states how the user resolved the time spent away. Only used
+	// when the AFKSince field is cleared. Defaults to AFKResolutionDismissed.
+	AFKResolution AFKResolution
+}
+
+// EditNotTracking holds values used when updating the "not tracking" status.
+type EditNotTracking struct {
+	// Since is set if the user has been at the keyboard for a while without
+	// any active entry. Set to nil to clear the status.
+	Since *time.Time
+	// SuggestedEntryName is the name of an entry that the user may want to
+	// start. Ignored if Since is nil.
+	SuggestedEntryName string
+}
+
+// ResolveAFK holds parameters used when resolving the current AFK status.
+type ResolveAFK struct {
+	// Resolution states how to resolve the time spent away. The
+	// AFKResolutionNone value is not allowed.
+	//
+	// All resolutions except AFKResolutionKeep and AFKResolutionDismissed will
+	// end the active entry at the time the user went AFK.
+	Resolution AFKResolution
+	// NewEntries are the entries to create for the time spent away, in order.
+	// Required when using AFKResolutionNewEntry or AFKResolutionSplit, and
+	// ignored otherwise. Only the Name, Start, and End fields are used.
+	//
+	// If the start time is not set, then the new entry starts where the
+	// previous new entry ended, or when the user went AFK for the first new
+	// entry. If the end time is not set, then the new entry is left active.
+	NewEntries []NewEntry
+}
+
+// ResolvedAFK is the response from resolving the AFK status.
+type ResolvedAFK struct {
+	// Edited is the active entry before and after ending it when the user went
+	// AFK, or nil if the active entry was left unchanged.
+	Edited *UpdatedEntry
+	// Created are the new entries created for the time spent away, in order.
+	Created []Entry
 }
@@ -43,12 +43,20 @@ type CommonFields struct {
 // Entry is a time tracked entry.
 type Entry struct {
 	CommonFields `yaml:",inline"`
+	// UUID is a globally unique identifier for this entry. Unlike the ID, which
+	// is only unique within a single database, the UUID is the same across all
+	// databases the entry has been synced to.
+	UUID string `json:"uuid" yaml:"uuid" xml:"Uuid"`
 	// Name of the entry.
 	Name string `json:"name" yaml:"name" xml:"Name"`
 	// Start time of the entry.
 	Start time.Time `json:"start" yaml:"start" xml:"Start"`
 	// End time of the entry, or nil if the entry is still active.
 	End *time.Time `json:"end" yaml:"end" xml:"End"`
+	// ContinuesUUID is the UUID of the entry that this entry continues, such
+	// as when resuming a paused entry, or empty. Entries linked this way are
+	// segments of the same task.
+	ContinuesUUID string `json:"continuesUuid,omitempty" yaml:"continuesUuid,omitempty" xml:"ContinuesUuid,omitempty"`
 }
 
 // Elapsed returns the duration of the entry. If the entry is currently active,
@@ -96,4 +104,197 @@ type Status struct {
 	TimeFields
 	AFKSince  *time.Time // set if currently AFK
 	BackSince *time.Time // set if returned from being AFK
+	Heartbeat *time.Time // set if the daemon has ever been running
+	// NotTrackingSince is set if the user has been at the keyboard for a while
+	// without any active entry.
+	NotTrackingSince *time.Time
+	// SuggestedEntryName is the name of an entry that the user may want to
+	// start, based on their most recent entries. Only set together with
+	// NotTrackingSince, and may be empty if there is no suggestion.
+	SuggestedEntryName string
+	// PausedEntryID is the ID of the entry that was paused and can be
+	// resumed, or zero if there is no paused entry.
+	PausedEntryID uint
+}
+
+// AFKResolution is an enumeration of how an AFK period was resolved by the
+// user.
+type AFKResolution byte
+
+const (
+	// AFKResolutionNone means the AFK period has not yet been resolved, such as
+	// when the user is still AFK or has not yet answered how to deal with the
+	// time spent away.
+	AFKResolutionNone AFKResolution = iota
+	// AFKResolutionKeep means the time spent away was kept in the active entry.
+	AFKResolutionKeep
+	// AFKResolutionDiscard means the time spent away was discarded by ending
+	// the active entry when the user went AFK.
+	AFKResolutionDiscard
+	// AFKResolutionNewEntry means the time spent away was saved as a new entry.
+	AFKResolutionNewEntry
+	// AFKResolutionDismissed means the AFK status was cleared without the user
+	// resolving it, such as when the active entry was changed while AFK.
+	AFKResolutionDismissed
+	// AFKResolutionSplit means the time spent away was split into multiple new
+	// entries.
+	AFKResolutionSplit
+)
+
+func (r AFKResolution) String() string {
+	switch r {
+	case AFKResolutionNone:
+		return "unresolved"
+	case AFKResolutionKeep:
+		return "keep"
+	case AFKResolutionDiscard:
+		return "discard"
+	case AFKResolutionNewEntry:
+		return "new entry"
+	case AFKResolutionDismissed:
+		return "dismissed"
+	case AFKResolutionSplit:
+		return "split"
+	default:
+		return "unknown"
+	}
+}
+
+// AFKPeriod is a historical record of when the user was AFK (away from
+// keyboard).
+type AFKPeriod struct {
+	CommonFields `yaml:",inline"`
+	// Start is when the user went AFK.
+	Start time.Time `json:"start" yaml:"start" xml:"Start"`
+	// End is when the user returned from being AFK, or nil if the user is still
+	// AFK.
+	End *time.Time `json:"end" yaml:"end" xml:"End"`
+	// Hook is the name of the AFK detector hook that detected that the user
+	// went AFK, such as "dbus". May be empty if unknown.
+	Hook string `json:"hook" yaml:"hook" xml:"Hook"`
+	// Resolution is how the user resolved the time spent away.
+	Resolution AFKResolution `json:"resolution" yaml:"resolution" xml:"Resolution"`
+}
+
+// Elapsed returns the duration of the AFK period. If the user is still AFK,
+// the duration is calculated from the start to now.
+func (p AFKPeriod) Elapsed() time.Duration {
+	var end time.Time
+	if p.End != nil {
+		end = *p.End
+	} else {
+		end = time.Now()
+	}
+	return end.Sub(p.Start)
+}
+
+// ElapsedWithin returns the duration of the part of the AFK period that
+// overlaps the given time span. A nil end means the time span is still
+// ongoing, as with active entries.
+func (p AFKPeriod) ElapsedWithin(start time.Time, end *time.Time) time.Duration {
+	now := time.Now()
+	pStart, pEnd := p.Start, now
+	if p.End != nil {
+		pEnd = *p.End
+	}
+	spanEnd := now
+	if end != nil {
+		spanEnd = *end
+	}
+	if start.After(pStart) {
+		pStart = start
+	}
+	if spanEnd.Before(pEnd) {
+		pEnd = spanEnd
+	}
+	if !pEnd.After(pStart) {
+		return 0
+	}
+	return pEnd.Sub(pStart)
+}
+
+// VectorClock maps sync node IDs to the sequence number of the latest change
+// known from that node.
+type VectorClock map[string]uint64
+
+func (clock VectorClock) clone() VectorClock {
+	clone := make(VectorClock, len(clock))
+	for nodeID, seq := range clock {
+		clone[nodeID] = seq
+	}
+	return clone
+}
+
+// SyncNode holds the sync identity and state of a Dinkur database.
+type SyncNode struct {
+	// NodeID is a globally unique identifier of the database.
+	NodeID string
+	// Clock holds the latest change known by the database from each node,
+	// including itself.
+	Clock VectorClock
+}
+
+// EntryChangeField is an enumeration of which entry field an EntryChange
+// applies to.
+type EntryChangeField byte
+
+const (
+	// EntryChangeFieldUnknown means the remote Dinkur daemon or client sent an
+	// undefined entry change field.
+	EntryChangeFieldUnknown EntryChangeField = iota
+	// EntryChangeFieldName means the change sets the entry's name.
+	EntryChangeFieldName
+	// EntryChangeFieldStart means the change sets the entry's start time.
+	EntryChangeFieldStart
+	// EntryChangeFieldEnd means the change sets the entry's end time.
+	EntryChangeFieldEnd
+	// EntryChangeFieldDeleted means the change deletes the entry.
+	EntryChangeFieldDeleted
+	// EntryChangeFieldContinues means the change sets the UUID of the entry
+	// that the entry continues.
+	EntryChangeFieldContinues
+)
+
+func (f EntryChangeField) String() string {
+	switch f {
+	case EntryChangeFieldName:
+		return "name"
+	case EntryChangeFieldStart:
+		return "start"
+	case EntryChangeFieldEnd:
+		return "end"
+	case EntryChangeFieldDeleted:
+		return "deleted"
+	case EntryChangeFieldContinues:
+		return "continues"
+	default:
+		return "unknown"
+	}
+}
+
+// EntryChange is a single field change to an entry. Changes are exchanged
+// when syncing, where each field of an entry is resolved to the value of the
+// change with the latest timestamp.
+//
+// A deleted entry is only kept deleted if the deletion observed all other
+// changes to the entry. In other words, an entry that is edited on one node
+// and concurrently deleted on another node resolves to the edited entry.
+type EntryChange struct {
+	// EntryUUID is the UUID of the entry that was changed.
+	EntryUUID string
+	// NodeID is the ID of the sync node that made the change.
+	NodeID string
+	// Seq is a sequence number of the change, incremented per node.
+	Seq uint64
+	// Timestamp is when the change was made. The time is taken from a hybrid
+	// logical clock, so it is always later than any change known by the node
+	// at the time of the change.
+	Timestamp time.Time
+	// Field is the entry field that was changed.
+	Field EntryChangeField
+	// Value is the new value of the field, encoded as a string. Times are
+	// encoded using RFC 3339 with nanoseconds, where an empty string means nil.
+	// Deletions hold the JSON-encoded VectorClock of the entry's changes that
+	// were observed when the entry was deleted.
+	Value string
 }
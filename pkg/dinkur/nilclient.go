@@ -88,6 +88,18 @@ func (*NilClient) StopActiveEntry(context.Context, time.Time) (*Entry, error) {
 	return nil, ErrClientIsNil
 }
 
+// PauseActiveEntry is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) PauseActiveEntry(context.Context, time.Time) (*Entry, error) {
+	return nil, ErrClientIsNil
+}
+
+// ResumeEntry is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) ResumeEntry(context.Context, ResumeEntry) (StartedEntry, error) {
+	return StartedEntry{}, ErrClientIsNil
+}
+
 // StreamEntry is a dummy implementation of the dinkur.Client that only
 // returns the "client is nil" error.
 func (*NilClient) StreamEntry(context.Context) (<-chan StreamedEntry, error) {
@@ -111,3 +123,57 @@ func (*NilClient) SetStatus(context.Context, EditStatus) error {
 func (*NilClient) GetStatus(context.Context) (Status, error) {
 	return Status{}, ErrClientIsNil
 }
+
+// SetHeartbeat is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) SetHeartbeat(context.Context, time.Time) error {
+	return ErrClientIsNil
+}
+
+// SetNotTracking is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) SetNotTracking(context.Context, EditNotTracking) error {
+	return ErrClientIsNil
+}
+
+// GetAFKPeriodList is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetAFKPeriodList(context.Context, SearchAFKPeriod) ([]AFKPeriod, error) {
+	return nil, ErrClientIsNil
+}
+
+// ResolveAFK is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) ResolveAFK(context.Context, ResolveAFK) (ResolvedAFK, error) {
+	return ResolvedAFK{}, ErrClientIsNil
+}
+
+// GetSyncNode is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) GetSyncNode(context.Context) (SyncNode, error) {
+	return SyncNode{}, ErrClientIsNil
+}
+
+// GetSyncChanges is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetSyncChanges(context.Context, SearchSyncChanges) ([]EntryChange, error) {
+	return nil, ErrClientIsNil
+}
+
+// ApplySyncChanges is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) ApplySyncChanges(context.Context, []EntryChange) error {
+	return ErrClientIsNil
+}
+
+// CreatePomodoroCycle is a dummy implementation of the dinkur.Client that
+// only returns the "client is nil" error.
+func (*NilClient) CreatePomodoroCycle(context.Context, NewPomodoroCycle) (PomodoroCycle, error) {
+	return PomodoroCycle{}, ErrClientIsNil
+}
+
+// GetPomodoroCycleList is a dummy implementation of the dinkur.Client that
+// only returns the "client is nil" error.
+func (*NilClient) GetPomodoroCycleList(context.Context, SearchPomodoroCycle) ([]PomodoroCycle, error) {
+	return nil, ErrClientIsNil
+}
@@ -0,0 +1,64 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkur
+
+import (
+	"context"
+	"time"
+)
+
+// Pairer is the Dinkur client methods targeted to pairing Dinkur daemons, so
+// they can sync entries with each other. Unlike the other client interfaces,
+// this is only implemented by clients that talk to a Dinkur daemon, and is
+// therefore not part of the Client interface.
+type Pairer interface {
+	StartPairing(ctx context.Context) (PairingCode, error)
+	WaitForPairing(ctx context.Context, code string) (Peer, error)
+	RequestPairing(ctx context.Context, address, code string) (Peer, error)
+	GetPeerList(ctx context.Context) ([]Peer, error)
+	DeletePeer(ctx context.Context, name string) (Peer, error)
+}
+
+// PairingCode is a short code that another Dinkur daemon can use to pair with
+// the daemon that created it.
+type PairingCode struct {
+	// Code is the short pairing code, to be entered on the other daemon.
+	Code string
+	// Expires is when the pairing code is no longer valid.
+	Expires time.Time
+	// TLSFingerprint is the SHA-256 fingerprint of the daemon's TLS
+	// certificate, so the user can verify it on the other daemon.
+	TLSFingerprint string
+}
+
+// Peer is a paired Dinkur daemon.
+type Peer struct {
+	// Name is the unique name of the peer, which is the hostname of the
+	// computer it is running on.
+	Name string
+	// Address is the host and port of the peer's gRPC API.
+	Address string
+	// TLSFingerprint is the pinned SHA-256 fingerprint of the peer's TLS
+	// certificate.
+	TLSFingerprint string
+	// Paired is when the pairing was made.
+	Paired time.Time
+}
@@ -0,0 +1,225 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkur
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Errors that are specific to Pomodoro timers.
+var (
+	ErrPomodoroSettingsInvalid     = errors.New("invalid Pomodoro settings")
+	ErrPomodoroCycleEndBeforeStart = errors.New("Pomodoro cycle end time cannot be before start time")
+)
+
+// PomodoroTimer is the Dinkur client methods targeted to running a Pomodoro
+// timer. Like the Pairer interface, this is only implemented by clients that
+// talk to a Dinkur daemon, as the daemon is what keeps the time, and is
+// therefore not part of the Client interface.
+type PomodoroTimer interface {
+	StartPomodoro(ctx context.Context, start StartPomodoro) (Pomodoro, error)
+	StopPomodoro(ctx context.Context) (*Pomodoro, error)
+	GetPomodoro(ctx context.Context) (*Pomodoro, error)
+	StreamPomodoro(ctx context.Context) (<-chan StreamedPomodoro, error)
+}
+
+// PomodoroCycles is the Dinkur client methods targeted to the history of
+// completed Pomodoro work periods.
+type PomodoroCycles interface {
+	CreatePomodoroCycle(ctx context.Context, cycle NewPomodoroCycle) (PomodoroCycle, error)
+	GetPomodoroCycleList(ctx context.Context, search SearchPomodoroCycle) ([]PomodoroCycle, error)
+}
+
+// PomodoroSettings is the durations used by a Pomodoro timer.
+type PomodoroSettings struct {
+	// Work is the duration of each work period.
+	Work time.Duration
+	// Break is the duration of the short breaks between work periods.
+	Break time.Duration
+	// LongBreak is the duration of every long break.
+	LongBreak time.Duration
+	// LongBreakEvery is after how many completed work periods a long break is
+	// taken instead of a short break.
+	LongBreakEvery uint
+	// BreakEntryName is the name of the entry started during breaks. The
+	// active entry is instead stopped during breaks if this is empty.
+	BreakEntryName string
+}
+
+// DefaultPomodoroSettings values are used for any zero values when starting a
+// Pomodoro timer.
+var DefaultPomodoroSettings = PomodoroSettings{
+	Work:           25 * time.Minute,
+	Break:          5 * time.Minute,
+	LongBreak:      15 * time.Minute,
+	LongBreakEvery: 4,
+}
+
+// WithDefaults returns a copy of the settings where any zero values are
+// replaced by the values from DefaultPomodoroSettings.
+func (s PomodoroSettings) WithDefaults() PomodoroSettings {
+	if s.Work == 0 {
+		s.Work = DefaultPomodoroSettings.Work
+	}
+	if s.Break == 0 {
+		s.Break = DefaultPomodoroSettings.Break
+	}
+	if s.LongBreak == 0 {
+		s.LongBreak = DefaultPomodoroSettings.LongBreak
+	}
+	if s.LongBreakEvery == 0 {
+		s.LongBreakEvery = DefaultPomodoroSettings.LongBreakEvery
+	}
+	return s
+}
+
+// PomodoroPhase is an enumeration of the periods of a Pomodoro timer.
+type PomodoroPhase byte
+
+const (
+	// PomodoroPhaseNone means the timer is not running.
+	PomodoroPhaseNone PomodoroPhase = iota
+	// PomodoroPhaseWork means the timer is in a work period.
+	PomodoroPhaseWork
+	// PomodoroPhaseBreak means the timer is in a short break.
+	PomodoroPhaseBreak
+	// PomodoroPhaseLongBreak means the timer is in a long break.
+	PomodoroPhaseLongBreak
+)
+
+func (p PomodoroPhase) String() string {
+	switch p {
+	case PomodoroPhaseNone:
+		return "none"
+	case PomodoroPhaseWork:
+		return "work"
+	case PomodoroPhaseBreak:
+		return "break"
+	case PomodoroPhaseLongBreak:
+		return "long break"
+	default:
+		return "unknown"
+	}
+}
+
+// PomodoroEvent is an enumeration of the events of a Pomodoro timer.
+type PomodoroEvent byte
+
+const (
+	// PomodoroEventUnknown is an unknown event.
+	PomodoroEventUnknown PomodoroEvent = iota
+	// PomodoroEventStarted means the timer was started.
+	PomodoroEventStarted
+	// PomodoroEventBreakStarted means a work period was completed and a break
+	// started.
+	PomodoroEventBreakStarted
+	// PomodoroEventWorkStarted means a break ended and a new work period
+	// started.
+	PomodoroEventWorkStarted
+	// PomodoroEventStopped means the timer was stopped, either by the user or
+	// because the active entry was changed by the user.
+	PomodoroEventStopped
+)
+
+func (e PomodoroEvent) String() string {
+	switch e {
+	case PomodoroEventStarted:
+		return "started"
+	case PomodoroEventBreakStarted:
+		return "break started"
+	case PomodoroEventWorkStarted:
+		return "work started"
+	case PomodoroEventStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// Pomodoro is the state of a Pomodoro timer.
+type Pomodoro struct {
+	// EntryName is the name of the entry started during work periods.
+	EntryName string
+	// Settings is the durations used by the timer.
+	Settings PomodoroSettings
+	// Phase is whether the timer is in a work period or a break.
+	Phase PomodoroPhase
+	// PhaseStart is when the current period started.
+	PhaseStart time.Time
+	// PhaseEnd is when the current period ends.
+	PhaseEnd time.Time
+	// CompletedCycles is the number of work periods completed since the timer
+	// was started.
+	CompletedCycles uint
+}
+
+// Remaining returns the time left of the current period.
+func (p Pomodoro) Remaining() time.Duration {
+	if remaining := time.Until(p.PhaseEnd); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// StartPomodoro holds parameters used when starting a Pomodoro timer.
+type StartPomodoro struct {
+	// EntryName is the name of the entry started during work periods.
+	EntryName string
+	// Settings is the durations used by the timer. Any zero values are
+	// replaced by the values from DefaultPomodoroSettings.
+	Settings PomodoroSettings
+}
+
+// StreamedPomodoro is an event holding the updated state of a Pomodoro timer.
+type StreamedPomodoro struct {
+	Pomodoro Pomodoro
+	Event    PomodoroEvent
+}
+
+// PomodoroCycle is a completed work period of a Pomodoro timer.
+type PomodoroCycle struct {
+	CommonFields `yaml:",inline"`
+	// EntryUUID is the UUID of the entry that was active during the work
+	// period.
+	EntryUUID string `json:"entryUuid" yaml:"entryUuid" xml:"EntryUuid"`
+	// Start is when the work period started.
+	Start time.Time `json:"start" yaml:"start" xml:"Start"`
+	// End is when the work period was completed.
+	End time.Time `json:"end" yaml:"end" xml:"End"`
+}
+
+// NewPomodoroCycle holds parameters used when adding a completed work period
+// to the Pomodoro cycle history.
+type NewPomodoroCycle struct {
+	EntryUUID string
+	Start     time.Time
+	End       time.Time
+}
+
+// SearchPomodoroCycle holds parameters used when searching for list of
+// Pomodoro cycles.
+type SearchPomodoroCycle struct {
+	Start *time.Time
+	End   *time.Time
+	Limit uint
+}
@@ -0,0 +1,99 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkur
+
+import (
+	"context"
+	"fmt"
+)
+
+// SyncResult is the response from syncing two Dinkur databases.
+type SyncResult struct {
+	// Pulled is the number of changes sent from the remote to the local
+	// database.
+	Pulled int
+	// Pushed is the number of changes sent from the local to the remote
+	// database.
+	Pushed int
+}
+
+// SyncChangesPageSize is the maximum number of changes sent per request when
+// syncing using SyncClients, to stay well below the gRPC message size limit
+// when syncing a large history of changes.
+const SyncChangesPageSize = 1000
+
+// SyncClients exchanges entry changes between two Dinkur databases, such as
+// the local database and a remote Dinkur daemon, so that both end up with the
+// same entries. Only the changes that the other side does not yet know about,
+// according to its vector clock, are sent, in pages of SyncChangesPageSize
+// changes.
+func SyncClients(ctx context.Context, local, remote Sync) (SyncResult, error) {
+	localNode, err := local.GetSyncNode(ctx)
+	if err != nil {
+		return SyncResult{}, fmt.Errorf("get local sync node: %w", err)
+	}
+	remoteNode, err := remote.GetSyncNode(ctx)
+	if err != nil {
+		return SyncResult{}, fmt.Errorf("get remote sync node: %w", err)
+	}
+	pulled, err := syncChanges(ctx, remote, local, localNode.Clock)
+	if err != nil {
+		return SyncResult{Pulled: pulled}, fmt.Errorf("pull remote changes: %w", err)
+	}
+	// the pulled changes are already known by the remote, according to the
+	// remote's vector clock, and are therefore not pushed back
+	pushed, err := syncChanges(ctx, local, remote, remoteNode.Clock)
+	if err != nil {
+		return SyncResult{Pulled: pulled, Pushed: pushed}, fmt.Errorf("push local changes: %w", err)
+	}
+	return SyncResult{Pulled: pulled, Pushed: pushed}, nil
+}
+
+// syncChanges sends the changes newer than the vector clock from one database
+// to another, one page at a time. Returns the number of changes sent.
+func syncChanges(ctx context.Context, from, to Sync, since VectorClock) (int, error) {
+	since = since.clone()
+	var count int
+	for {
+		changes, err := from.GetSyncChanges(ctx, SearchSyncChanges{
+			Since: since,
+			Limit: SyncChangesPageSize,
+		})
+		if err != nil {
+			return count, fmt.Errorf("get changes: %w", err)
+		}
+		if len(changes) == 0 {
+			return count, nil
+		}
+		if err := to.ApplySyncChanges(ctx, changes); err != nil {
+			return count, fmt.Errorf("apply changes: %w", err)
+		}
+		count += len(changes)
+		if len(changes) < SyncChangesPageSize {
+			return count, nil
+		}
+		for _, change := range changes {
+			if change.Seq > since[change.NodeID] {
+				since[change.NodeID] = change.Seq
+			}
+		}
+	}
+}
@@ -22,15 +22,20 @@ package dinkurclient
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/pairing"
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
@@ -47,10 +52,32 @@ var (
 var log = logger.NewScoped("client")
 
 // Options for the Dinkur client.
-type Options struct{}
+type Options struct {
+	// TLS enables transport security when connecting to the daemon.
+	TLS bool
+	// TLSCAFile is the path to a PEM-encoded certificate authority file used
+	// to verify the daemon's certificate. The system's certificates are used
+	// if empty. Only used when TLS is enabled.
+	TLSCAFile string
+	// TLSFingerprint pins the daemon's certificate to the one with the given
+	// SHA-256 fingerprint, as returned by discovery.Fingerprint, instead of
+	// verifying it using certificate authorities. Only used when TLS is
+	// enabled.
+	TLSFingerprint string
+	// Profile is the name of the daemon's profile, i.e which of the daemon's
+	// databases, to use. The daemon's default profile is used if empty.
+	// Profile names are case-insensitive.
+	Profile string
+	// PeerToken is the token used to authenticate as a paired daemon, as
+	// stored by the pairing package.
+	PeerToken string
+}
 
 // NewClient returns a new dinkur.Client-compatible implementation that uses
 // gRPC towards a remote Dinkur daemon to perform all dinkur.Client entries.
+//
+// The returned client also implements dinkur.Pairer, dinkur.PomodoroTimer, and
+// dinkur.AFKDetector.
 func NewClient(serverAddr string, opt Options) dinkur.Client {
 	return &client{
 		Options:    opt,
@@ -64,13 +91,16 @@ type client struct {
 	conn       *grpc.ClientConn
 	entryer    dinkurapiv1.EntriesClient
 	statuses   dinkurapiv1.StatusesClient
+	syncer     dinkurapiv1.SyncClient
+	pairer     dinkurapiv1.PairingClient
+	pomodoros  dinkurapiv1.PomodoroClient
 }
 
 func (c *client) assertConnected() error {
 	if c == nil {
 		return dinkur.ErrClientIsNil
 	}
-	if c.conn == nil || c.entryer == nil || c.statuses == nil {
+	if c.conn == nil || c.entryer == nil || c.statuses == nil || c.syncer == nil || c.pairer == nil || c.pomodoros == nil {
 		return dinkur.ErrNotConnected
 	}
 	return nil
@@ -80,20 +110,67 @@ func (c *client) Connect(ctx context.Context) error {
 	if c == nil {
 		return dinkur.ErrClientIsNil
 	}
-	if c.conn != nil || c.entryer != nil || c.statuses != nil {
+	if c.conn != nil || c.entryer != nil || c.statuses != nil || c.syncer != nil || c.pairer != nil || c.pomodoros != nil {
 		return dinkur.ErrAlreadyConnected
 	}
-	// TODO: add credentials via opts args
-	conn, err := grpc.DialContext(ctx, c.serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := c.transportCredentials()
+	if err != nil {
+		return err
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if c.Profile != "" || c.PeerToken != "" {
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(c.unaryMetadataInterceptor),
+			grpc.WithStreamInterceptor(c.streamMetadataInterceptor))
+	}
+	conn, err := grpc.DialContext(ctx, c.serverAddr, dialOpts...)
 	if err != nil {
 		return convError(err)
 	}
 	c.conn = conn
 	c.entryer = dinkurapiv1.NewEntriesClient(conn)
 	c.statuses = dinkurapiv1.NewStatusesClient(conn)
+	c.syncer = dinkurapiv1.NewSyncClient(conn)
+	c.pairer = dinkurapiv1.NewPairingClient(conn)
+	c.pomodoros = dinkurapiv1.NewPomodoroClient(conn)
 	return nil
 }
 
+func (c *client) transportCredentials() (credentials.TransportCredentials, error) {
+	if !c.TLS {
+		return insecure.NewCredentials(), nil
+	}
+	if c.TLSFingerprint != "" {
+		return credentials.NewTLS(pairing.PinnedTLSConfig(c.TLSFingerprint)), nil
+	}
+	if c.TLSCAFile == "" {
+		return credentials.NewTLS(&tls.Config{}), nil
+	}
+	creds, err := credentials.NewClientTLSFromFile(c.TLSCAFile, "")
+	if err != nil {
+		return nil, fmt.Errorf("load TLS CA file: %w", err)
+	}
+	return creds, nil
+}
+
+func (c *client) unaryMetadataInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(c.outgoingMetadata(ctx), method, req, reply, cc, opts...)
+}
+
+func (c *client) streamMetadataInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(c.outgoingMetadata(ctx), desc, cc, method, opts...)
+}
+
+func (c *client) outgoingMetadata(ctx context.Context) context.Context {
+	if c.Profile != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, dinkurapiv1.ProfileMetadataKey, strings.ToLower(c.Profile))
+	}
+	if c.PeerToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, dinkurapiv1.PeerTokenMetadataKey, c.PeerToken)
+	}
+	return ctx
+}
+
 func (c *client) Close() (err error) {
 	if conn := c.conn; conn != nil {
 		err = conn.Close()
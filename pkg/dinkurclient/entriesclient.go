@@ -70,6 +70,7 @@ func (c *client) GetEntryList(ctx context.Context, search dinkur.SearchEntry) ([
 		End:                togrpc.TimestampPtr(search.End),
 		Limit:              uint64(search.Limit),
 		Shorthand:          togrpc.Shorthand(search.Shorthand),
+		ShorthandCount:     uint64(search.ShorthandCount),
 		NameFuzzy:          search.NameFuzzy,
 		NameHighlightStart: search.NameHighlightStart,
 		NameHighlightEnd:   search.NameHighlightEnd,
@@ -180,6 +181,42 @@ func (c *client) StopActiveEntry(ctx context.Context, endTime time.Time) (*dinku
 	return entry, nil
 }
 
+func (c *client) PauseActiveEntry(ctx context.Context, endTime time.Time) (*dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.entryer.PauseActiveEntry, &dinkurapiv1.PauseActiveEntryRequest{
+		End: togrpc.TimestampPtr(&endTime),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	entry, err := fromgrpc.EntryPtr(res.PausedEntry)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entry, nil
+}
+
+func (c *client) ResumeEntry(ctx context.Context, resume dinkur.ResumeEntry) (dinkur.StartedEntry, error) {
+	res, err := invoke(ctx, c, c.entryer.ResumeEntry, &dinkurapiv1.ResumeEntryRequest{
+		IdOrZero: uint64(resume.IDOrZero),
+		Start:    togrpc.TimestampPtr(resume.Start),
+	})
+	if err != nil {
+		return dinkur.StartedEntry{}, convError(err)
+	}
+	prevEntry, err := fromgrpc.EntryPtr(res.PreviouslyActiveEntry)
+	if err != nil {
+		return dinkur.StartedEntry{}, fmt.Errorf("stopped entry: %w", convError(err))
+	}
+	resumedEntry, err := fromgrpc.EntryPtrNoNil(res.ResumedEntry)
+	if err != nil {
+		return dinkur.StartedEntry{}, fmt.Errorf("resumed entry: %w", convError(err))
+	}
+	return dinkur.StartedEntry{
+		Stopped: prevEntry,
+		Started: resumedEntry,
+	}, nil
+}
+
 func (c *client) StreamEntry(ctx context.Context) (<-chan dinkur.StreamedEntry, error) {
 	if err := c.assertConnected(); err != nil {
 		return nil, err
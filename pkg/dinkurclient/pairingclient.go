@@ -0,0 +1,96 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+)
+
+func (c *client) StartPairing(ctx context.Context) (dinkur.PairingCode, error) {
+	res, err := invoke(ctx, c, c.pairer.StartPairing, &dinkurapiv1.StartPairingRequest{})
+	if err != nil {
+		return dinkur.PairingCode{}, convError(err)
+	}
+	return dinkur.PairingCode{
+		Code:           res.Code,
+		Expires:        fromgrpc.TimeOrZero(res.Expires),
+		TLSFingerprint: res.TlsFingerprint,
+	}, nil
+}
+
+func (c *client) WaitForPairing(ctx context.Context, code string) (dinkur.Peer, error) {
+	res, err := invoke(ctx, c, c.pairer.WaitForPairing, &dinkurapiv1.WaitForPairingRequest{
+		Code: code,
+	})
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	peer, err := fromgrpc.PeerPtrNoNil(res.Peer)
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	return peer, nil
+}
+
+func (c *client) RequestPairing(ctx context.Context, address, code string) (dinkur.Peer, error) {
+	res, err := invoke(ctx, c, c.pairer.RequestPairing, &dinkurapiv1.RequestPairingRequest{
+		Address: address,
+		Code:    code,
+	})
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	peer, err := fromgrpc.PeerPtrNoNil(res.Peer)
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	return peer, nil
+}
+
+func (c *client) GetPeerList(ctx context.Context) ([]dinkur.Peer, error) {
+	res, err := invoke(ctx, c, c.pairer.GetPeerList, &dinkurapiv1.GetPeerListRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	peers, err := fromgrpc.PeerSlice(res.Peers)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return peers, nil
+}
+
+func (c *client) DeletePeer(ctx context.Context, name string) (dinkur.Peer, error) {
+	res, err := invoke(ctx, c, c.pairer.DeletePeer, &dinkurapiv1.DeletePeerRequest{
+		Name: name,
+	})
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	peer, err := fromgrpc.PeerPtrNoNil(res.DeletedPeer)
+	if err != nil {
+		return dinkur.Peer{}, convError(err)
+	}
+	return peer, nil
+}
@@ -0,0 +1,150 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"io"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (c *client) StartPomodoro(ctx context.Context, start dinkur.StartPomodoro) (dinkur.Pomodoro, error) {
+	res, err := invoke(ctx, c, c.pomodoros.StartPomodoro, &dinkurapiv1.StartPomodoroRequest{
+		EntryName: start.EntryName,
+		Settings:  togrpc.PomodoroSettings(start.Settings),
+	})
+	if err != nil {
+		return dinkur.Pomodoro{}, convError(err)
+	}
+	pomodoro, err := fromgrpc.PomodoroPtr(res.Pomodoro)
+	if err != nil {
+		return dinkur.Pomodoro{}, convError(err)
+	}
+	if pomodoro == nil {
+		return dinkur.Pomodoro{}, ErrResponseIsNil
+	}
+	return *pomodoro, nil
+}
+
+func (c *client) StopPomodoro(ctx context.Context) (*dinkur.Pomodoro, error) {
+	res, err := invoke(ctx, c, c.pomodoros.StopPomodoro, &dinkurapiv1.StopPomodoroRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	pomodoro, err := fromgrpc.PomodoroPtr(res.Pomodoro)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return pomodoro, nil
+}
+
+func (c *client) GetPomodoro(ctx context.Context) (*dinkur.Pomodoro, error) {
+	res, err := invoke(ctx, c, c.pomodoros.GetPomodoro, &dinkurapiv1.GetPomodoroRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	pomodoro, err := fromgrpc.PomodoroPtr(res.Pomodoro)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return pomodoro, nil
+}
+
+func (c *client) StreamPomodoro(ctx context.Context) (<-chan dinkur.StreamedPomodoro, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	stream, err := c.pomodoros.StreamPomodoro(ctx, &dinkurapiv1.StreamPomodoroRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	pomodoroChan := make(chan dinkur.StreamedPomodoro)
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					log.Error().
+						WithError(convError(err)).
+						Message("Error when streaming Pomodoro events. Closing stream.")
+				}
+				close(pomodoroChan)
+				return
+			}
+			if res == nil {
+				continue
+			}
+			const logWarnMsg = "Error when streaming Pomodoro events. Ignoring message."
+			pomodoro, err := fromgrpc.PomodoroPtr(res.Pomodoro)
+			if err != nil {
+				log.Warn().WithError(convError(err)).
+					Message(logWarnMsg)
+				continue
+			}
+			if pomodoro == nil {
+				log.Warn().WithError(ErrResponseIsNil).
+					Message(logWarnMsg)
+				continue
+			}
+			pomodoroChan <- dinkur.StreamedPomodoro{
+				Pomodoro: *pomodoro,
+				Event:    fromgrpc.PomodoroEvent(res.Event),
+			}
+		}
+	}()
+	return pomodoroChan, nil
+}
+
+func (c *client) CreatePomodoroCycle(ctx context.Context, cycle dinkur.NewPomodoroCycle) (dinkur.PomodoroCycle, error) {
+	res, err := invoke(ctx, c, c.pomodoros.CreatePomodoroCycle, &dinkurapiv1.CreatePomodoroCycleRequest{
+		EntryUuid: cycle.EntryUUID,
+		Start:     togrpc.Timestamp(cycle.Start),
+		End:       togrpc.Timestamp(cycle.End),
+	})
+	if err != nil {
+		return dinkur.PomodoroCycle{}, convError(err)
+	}
+	created, err := fromgrpc.PomodoroCyclePtrNoNil(res.Cycle)
+	if err != nil {
+		return dinkur.PomodoroCycle{}, convError(err)
+	}
+	return created, nil
+}
+
+func (c *client) GetPomodoroCycleList(ctx context.Context, search dinkur.SearchPomodoroCycle) ([]dinkur.PomodoroCycle, error) {
+	res, err := invoke(ctx, c, c.pomodoros.GetPomodoroCycleList, &dinkurapiv1.GetPomodoroCycleListRequest{
+		Start: togrpc.TimestampPtr(search.Start),
+		End:   togrpc.TimestampPtr(search.End),
+		Limit: uint64(search.Limit),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	cycles, err := fromgrpc.PomodoroCycleSlice(res.Cycles)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return cycles, nil
+}
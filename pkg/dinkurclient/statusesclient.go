@@ -21,7 +21,9 @@ package dinkurclient
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"time"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
 	v1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
@@ -71,8 +73,10 @@ func (c *client) StreamStatus(ctx context.Context) (<-chan dinkur.StreamedStatus
 
 func (c *client) SetStatus(ctx context.Context, edit dinkur.EditStatus) error {
 	_, err := invoke(ctx, c, c.statuses.SetStatus, &v1.SetStatusRequest{
-		AfkSince:  togrpc.TimestampPtr(edit.AFKSince),
-		BackSince: togrpc.TimestampPtr(edit.BackSince),
+		AfkSince:      togrpc.TimestampPtr(edit.AFKSince),
+		BackSince:     togrpc.TimestampPtr(edit.BackSince),
+		AfkHook:       edit.AFKHook,
+		AfkResolution: togrpc.AFKResolution(edit.AFKResolution),
 	})
 	return err
 }
@@ -84,3 +88,90 @@ func (c *client) GetStatus(ctx context.Context) (dinkur.Status, error) {
 	}
 	return fromgrpc.StatusPtrNoNil(res.Status)
 }
+
+func (c *client) SetHeartbeat(ctx context.Context, heartbeat time.Time) error {
+	_, err := invoke(ctx, c, c.statuses.SetHeartbeat, &v1.SetHeartbeatRequest{
+		Heartbeat: togrpc.Timestamp(heartbeat),
+	})
+	return err
+}
+
+func (c *client) SetNotTracking(ctx context.Context, edit dinkur.EditNotTracking) error {
+	_, err := invoke(ctx, c, c.statuses.SetNotTracking, &v1.SetNotTrackingRequest{
+		NotTrackingSince:   togrpc.TimestampPtr(edit.Since),
+		SuggestedEntryName: edit.SuggestedEntryName,
+	})
+	return err
+}
+
+func (c *client) GetAFKSettings(ctx context.Context) (dinkur.AFKSettings, error) {
+	res, err := invoke(ctx, c, c.statuses.GetAfkSettings, &v1.GetAfkSettingsRequest{})
+	if err != nil {
+		return dinkur.AFKSettings{}, convError(err)
+	}
+	return fromgrpc.AFKSettings(res.Settings), nil
+}
+
+func (c *client) UpdateAFKSettings(ctx context.Context, edit dinkur.EditAFKSettings) (dinkur.AFKSettings, error) {
+	res, err := invoke(ctx, c, c.statuses.UpdateAfkSettings, togrpc.EditAFKSettings(edit))
+	if err != nil {
+		return dinkur.AFKSettings{}, convError(err)
+	}
+	return fromgrpc.AFKSettings(res.Settings), nil
+}
+
+func (c *client) GetAFKPeriodList(ctx context.Context, search dinkur.SearchAFKPeriod) ([]dinkur.AFKPeriod, error) {
+	res, err := invoke(ctx, c, c.statuses.GetAfkPeriodList, &v1.GetAfkPeriodListRequest{
+		Start:          togrpc.TimestampPtr(search.Start),
+		End:            togrpc.TimestampPtr(search.End),
+		Limit:          uint64(search.Limit),
+		Shorthand:      togrpc.Shorthand(search.Shorthand),
+		ShorthandCount: uint64(search.ShorthandCount),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	periods, err := fromgrpc.AFKPeriodSlice(res.AfkPeriods)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return periods, nil
+}
+
+func (c *client) ResolveAFK(ctx context.Context, resolve dinkur.ResolveAFK) (dinkur.ResolvedAFK, error) {
+	newEntries := make([]*v1.AfkNewEntry, len(resolve.NewEntries))
+	for i, entry := range resolve.NewEntries {
+		newEntries[i] = &v1.AfkNewEntry{
+			Name:  entry.Name,
+			Start: togrpc.TimestampPtr(entry.Start),
+			End:   togrpc.TimestampPtr(entry.End),
+		}
+	}
+	res, err := invoke(ctx, c, c.statuses.ResolveAfk, &v1.ResolveAfkRequest{
+		Resolution: togrpc.AFKResolution(resolve.Resolution),
+		NewEntries: newEntries,
+	})
+	if err != nil {
+		return dinkur.ResolvedAFK{}, convError(err)
+	}
+	var resolved dinkur.ResolvedAFK
+	if res.EditedBefore != nil && res.EditedAfter != nil {
+		entryBefore, err := fromgrpc.EntryPtrNoNil(res.EditedBefore)
+		if err != nil {
+			return dinkur.ResolvedAFK{}, fmt.Errorf("edited entry before: %w", convError(err))
+		}
+		entryAfter, err := fromgrpc.EntryPtrNoNil(res.EditedAfter)
+		if err != nil {
+			return dinkur.ResolvedAFK{}, fmt.Errorf("edited entry after: %w", convError(err))
+		}
+		resolved.Edited = &dinkur.UpdatedEntry{
+			Before: entryBefore,
+			After:  entryAfter,
+		}
+	}
+	resolved.Created, err = fromgrpc.EntrySlice(res.Created)
+	if err != nil {
+		return dinkur.ResolvedAFK{}, fmt.Errorf("created entries: %w", convError(err))
+	}
+	return resolved, nil
+}
@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (c *client) GetSyncNode(ctx context.Context) (dinkur.SyncNode, error) {
+	res, err := invoke(ctx, c, c.syncer.GetSyncNode, &dinkurapiv1.GetSyncNodeRequest{})
+	if err != nil {
+		return dinkur.SyncNode{}, convError(err)
+	}
+	return dinkur.SyncNode{
+		NodeID: res.NodeId,
+		Clock:  dinkur.VectorClock(res.Clock),
+	}, nil
+}
+
+func (c *client) GetSyncChanges(ctx context.Context, search dinkur.SearchSyncChanges) ([]dinkur.EntryChange, error) {
+	res, err := invoke(ctx, c, c.syncer.GetSyncChanges, &dinkurapiv1.GetSyncChangesRequest{
+		Since: search.Since,
+		Limit: uint64(search.Limit),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	changes, err := fromgrpc.EntryChangeSlice(res.Changes)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return changes, nil
+}
+
+func (c *client) ApplySyncChanges(ctx context.Context, changes []dinkur.EntryChange) error {
+	_, err := invoke(ctx, c, c.syncer.ApplySyncChanges, &dinkurapiv1.ApplySyncChangesRequest{
+		Changes: togrpc.EntryChangeSlice(changes),
+	})
+	return convError(err)
+}
@@ -0,0 +1,164 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// ErrUnknownAFKRuleAction is returned when parsing an unknown AFK rule action.
+var ErrUnknownAFKRuleAction = errors.New("unknown AFK rule action")
+
+// AFKRuleAction is an enumeration of what to do with an AFK period that is
+// matched by an AFK rule.
+type AFKRuleAction byte
+
+const (
+	// AFKRuleActionPrompt leaves the AFK period for the user to resolve, which
+	// is the same as if no rule would have matched.
+	AFKRuleActionPrompt AFKRuleAction = iota
+	// AFKRuleActionKeep keeps the time spent away in the active entry.
+	AFKRuleActionKeep
+	// AFKRuleActionDiscard discards the time spent away by ending the active
+	// entry when the user went AFK.
+	AFKRuleActionDiscard
+)
+
+func (a AFKRuleAction) String() string {
+	switch a {
+	case AFKRuleActionPrompt:
+		return "prompt"
+	case AFKRuleActionKeep:
+		return "keep"
+	case AFKRuleActionDiscard:
+		return "discard"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAFKRuleAction parses an AFK rule action from its string
+// representation: "prompt", "keep", or "discard".
+func ParseAFKRuleAction(s string) (AFKRuleAction, error) {
+	switch strings.ToLower(s) {
+	case "prompt":
+		return AFKRuleActionPrompt, nil
+	case "keep":
+		return AFKRuleActionKeep, nil
+	case "discard":
+		return AFKRuleActionDiscard, nil
+	default:
+		return AFKRuleActionPrompt, fmt.Errorf("%w: %q", ErrUnknownAFKRuleAction, s)
+	}
+}
+
+// AFKRule is a rule for automatically resolving AFK periods when the user
+// returns, without prompting the user.
+type AFKRule struct {
+	// MinAway is the shortest time spent away that this rule matches. Zero
+	// means no lower bound.
+	MinAway time.Duration
+	// MaxAway is the longest time spent away that this rule matches. Zero
+	// means no upper bound.
+	MaxAway time.Duration
+	// From and To restricts the rule to only match AFK periods that started
+	// within a time of day, given as the duration since midnight. The range is
+	// inclusive of From but exclusive of To, and wraps past midnight if From
+	// is after To. The rule matches the whole day if both are zero.
+	From time.Duration
+	To   time.Duration
+	// Action is what to do with a matching AFK period.
+	Action AFKRuleAction
+}
+
+// Matches returns true if the AFK period between the two timestamps is
+// matched by this rule.
+func (r AFKRule) Matches(afkSince, backSince time.Time) bool {
+	away := backSince.Sub(afkSince)
+	if r.MinAway > 0 && away < r.MinAway {
+		return false
+	}
+	if r.MaxAway > 0 && away > r.MaxAway {
+		return false
+	}
+	if r.From == 0 && r.To == 0 {
+		return true
+	}
+	y, m, d := afkSince.Date()
+	timeOfDay := afkSince.Sub(time.Date(y, m, d, 0, 0, 0, 0, afkSince.Location()))
+	if r.From <= r.To {
+		return timeOfDay >= r.From && timeOfDay < r.To
+	}
+	return timeOfDay >= r.From || timeOfDay < r.To
+}
+
+func findAFKRule(rules []AFKRule, afkSince, backSince time.Time) (AFKRule, bool) {
+	for _, rule := range rules {
+		if rule.Matches(afkSince, backSince) {
+			return rule, true
+		}
+	}
+	return AFKRule{}, false
+}
+
+// resolveAFKByRulesLocked resolves the AFK status according to the first
+// matching AFK rule, if any. The AFK status is left as-is for the user to
+// resolve if no rule matches, or if the matching rule says to prompt. The
+// profile's mutex must be held.
+func (d *daemon) resolveAFKByRulesLocked(ctx context.Context, p *profile, afkSince, backSince time.Time) {
+	rule, ok := findAFKRule(d.AFKRules, afkSince, backSince)
+	if !ok || rule.Action == AFKRuleActionPrompt {
+		return
+	}
+	entry, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to get active entry when resolving AFK status by rules.")
+		return
+	}
+	if entry == nil {
+		d.markAsNotAFKLocked(ctx, p)
+		return
+	}
+	resolution := dinkur.AFKResolutionKeep
+	if rule.Action == AFKRuleActionDiscard {
+		resolution = dinkur.AFKResolutionDiscard
+	}
+	// ending the active entry and clearing the AFK status is done in a single
+	// transaction, so a failure cannot leave the entry cut while still AFK
+	if _, err := p.client.ResolveAFK(ctx, dinkur.ResolveAFK{Resolution: resolution}); err != nil {
+		log.Warn().WithError(err).WithUint("entry", entry.ID).
+			Message("Failed to resolve AFK status by rules. Leaving it for the user to resolve.")
+		return
+	}
+	p.lastStatus = dinkur.EditStatus{}
+	log.Info().
+		WithString("profile", p.name).
+		WithDuration("away", backSince.Sub(afkSince)).
+		WithStringer("action", rule.Action).
+		Message("Resolved AFK status by rule.")
+}
@@ -0,0 +1,136 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+//go:build fts5
+
+package dinkurd_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/afkdetect"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4"
+)
+
+func TestUpdateAFKSettings(t *testing.T) {
+	grpcClient, _ := startTestDaemon(t)
+	detector, ok := grpcClient.(dinkur.AFKDetector)
+	if !ok {
+		t.Fatal("gRPC client does not implement dinkur.AFKDetector")
+	}
+	ctx := context.Background()
+
+	settings, err := detector.GetAFKSettings(ctx)
+	if err != nil {
+		t.Fatalf("get AFK settings: %s", err)
+	}
+	if settings.Threshold != afkdetect.DefaultOptions.Threshold {
+		t.Errorf("want default threshold %s, got %s", afkdetect.DefaultOptions.Threshold, settings.Threshold)
+	}
+	if settings.PollInterval != afkdetect.DefaultOptions.PollInterval {
+		t.Errorf("want default poll interval %s, got %s", afkdetect.DefaultOptions.PollInterval, settings.PollInterval)
+	}
+
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		Threshold: typ.Ref(10 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("update threshold: %s", err)
+	}
+	if settings.Threshold != 10*time.Minute {
+		t.Errorf("want updated threshold %s, got %s", 10*time.Minute, settings.Threshold)
+	}
+
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		PollInterval: typ.Ref(time.Second),
+	})
+	if err != nil {
+		t.Fatalf("update poll interval: %s", err)
+	}
+	if settings.PollInterval != time.Second {
+		t.Errorf("want updated poll interval %s, got %s", time.Second, settings.PollInterval)
+	}
+	if settings.Threshold != 10*time.Minute {
+		t.Errorf("want threshold %s to be left unchanged, got %s", 10*time.Minute, settings.Threshold)
+	}
+
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		Threshold: typ.Ref(time.Duration(0)),
+	})
+	if err != nil {
+		t.Fatalf("reset threshold: %s", err)
+	}
+	if settings.Threshold != afkdetect.DefaultOptions.Threshold {
+		t.Errorf("want threshold reset to %s, got %s", afkdetect.DefaultOptions.Threshold, settings.Threshold)
+	}
+	if settings.PollInterval != time.Second {
+		t.Errorf("want poll interval %s to be left unchanged, got %s", time.Second, settings.PollInterval)
+	}
+
+	if _, err := detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		Threshold: typ.Ref(-time.Minute),
+	}); err == nil {
+		t.Error("want error when setting a negative threshold, got nil")
+	}
+
+	if len(settings.AvailableHooks) == 0 {
+		t.Skip("no AFK hooks available on this OS")
+	}
+	hooks := settings.AvailableHooks[:1]
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		AllowHooks: hooks,
+	})
+	if err != nil {
+		t.Fatalf("update allow hooks: %s", err)
+	}
+	if !reflect.DeepEqual(settings.AllowHooks, hooks) {
+		t.Errorf("want allow hooks %q, got %q", hooks, settings.AllowHooks)
+	}
+
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		DenyHooks: hooks,
+	})
+	if err != nil {
+		t.Fatalf("update deny hooks: %s", err)
+	}
+	if !reflect.DeepEqual(settings.AllowHooks, hooks) {
+		t.Errorf("want allow hooks %q to be left unchanged, got %q", hooks, settings.AllowHooks)
+	}
+	if !reflect.DeepEqual(settings.DenyHooks, hooks) {
+		t.Errorf("want deny hooks %q, got %q", hooks, settings.DenyHooks)
+	}
+
+	settings, err = detector.UpdateAFKSettings(ctx, dinkur.EditAFKSettings{
+		ClearAllowHooks: true,
+		ClearDenyHooks:  true,
+	})
+	if err != nil {
+		t.Fatalf("clear hooks: %s", err)
+	}
+	if len(settings.AllowHooks) != 0 {
+		t.Errorf("want allow hooks cleared, got %q", settings.AllowHooks)
+	}
+	if len(settings.DenyHooks) != 0 {
+		t.Errorf("want deny hooks cleared, got %q", settings.DenyHooks)
+	}
+}
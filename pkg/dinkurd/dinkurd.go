@@ -26,14 +26,24 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"os"
+	"strings"
 	"sync"
+	"time"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
 	"github.com/dinkur/dinkur/pkg/afkdetect"
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/discovery"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/notify"
+	"github.com/dinkur/dinkur/pkg/pairing"
+	"github.com/dinkur/dinkur/pkg/timeutil"
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -41,28 +51,65 @@ import (
 var (
 	ErrUintTooLarge   = fmt.Errorf("unsigned int value is too large, maximum: %d", uint64(math.MaxUint))
 	ErrDaemonIsNil    = errors.New("daemon is nil")
+	ErrUnknownProfile = errors.New("unknown profile")
 	ErrRequestIsNil   = errors.New("grpc request was nil")
 	ErrAlreadyServing = errors.New("daemon instance is already running")
+
+	ErrPairingDisabled       = errors.New("pairing is disabled")
+	ErrPairingRequiresTLS    = errors.New("pairing requires the daemon to use TLS")
+	ErrPairingRequestInvalid = errors.New("invalid pairing request")
+	ErrNotLocalRequest       = errors.New("request must come from the same computer as the daemon")
+	ErrPeerTokenRequired     = errors.New("only paired daemons are allowed to connect from other computers, and only to sync")
+	ErrPeerTokenInvalid      = errors.New("invalid or revoked peer token")
 )
 
 var log = logger.NewScoped("daemon")
 
+var heartbeatIntervalDur = 30 * time.Second
+
+// Names of the AFK "hooks" used by the daemon itself, as opposed to the hooks
+// in the afkdetect package, which are stored in the AFK period history.
+const (
+	afkHookShutdown = "shutdown"
+	afkHookDowntime = "downtime"
+)
+
 func convError(err error) error {
 	switch {
 	case status.Code(err) != codes.Unknown:
 		return err
-	case errors.Is(err, dinkur.ErrNotFound):
+	case errors.Is(err, dinkur.ErrNotFound),
+		errors.Is(err, ErrUnknownProfile),
+		errors.Is(err, pairing.ErrPeerNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, ErrRequestIsNil),
 		errors.Is(err, ErrUintTooLarge),
 		errors.Is(err, dinkur.ErrLimitTooLarge),
 		errors.Is(err, dinkur.ErrEntryEndBeforeStart),
-		errors.Is(err, dinkur.ErrEntryNameEmpty):
+		errors.Is(err, dinkur.ErrEntryNameEmpty),
+		errors.Is(err, dinkur.ErrAFKResolutionInvalid),
+		errors.Is(err, dinkur.ErrEntryChangeInvalid),
+		errors.Is(err, dinkur.ErrPomodoroSettingsInvalid),
+		errors.Is(err, dinkur.ErrPomodoroCycleEndBeforeStart),
+		errors.Is(err, fromgrpc.ErrUnexpectedNilEntryChange),
+		errors.Is(err, ErrPairingRequestInvalid),
+		errors.Is(err, afkdetect.ErrUnknownHook),
+		errors.Is(err, afkdetect.ErrNegativeDuration):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, dinkur.ErrNotConnected),
 		errors.Is(err, dinkur.ErrAlreadyConnected),
-		errors.Is(err, dinkur.ErrClientIsNil):
+		errors.Is(err, dinkur.ErrClientIsNil),
+		errors.Is(err, dinkur.ErrNotAFK),
+		errors.Is(err, dinkur.ErrNoPausedEntry),
+		errors.Is(err, ErrPairingDisabled),
+		errors.Is(err, ErrPairingRequiresTLS):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrNotLocalRequest):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, pairing.ErrCodeInvalid),
+		errors.Is(err, ErrPeerTokenRequired),
+		errors.Is(err, ErrPeerTokenInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
 	default:
 		return err
 	}
@@ -75,6 +122,50 @@ type Options struct {
 	Host string
 	// Port is the port the server will listen on.
 	Port uint16
+	// Listener is served on instead of binding to the Host and Port, if set,
+	// such as to serve on a random port. The daemon closes it when closed.
+	Listener net.Listener
+	// TLSCertFile and TLSKeyFile are paths to a PEM-encoded certificate and
+	// private key. TLS is only enabled when both are set.
+	TLSCertFile string
+	TLSKeyFile  string
+	// MDNS enables advertising the daemon on the local network using
+	// mDNS/DNS-SD, including the fingerprint of the TLS certificate, so it can
+	// be found using the discovery package.
+	MDNS bool
+	// MDNSInterface is the name of the network interface to advertise the
+	// daemon on. The system's default multicast interface is used if empty.
+	MDNSInterface string
+	// PeersFile is the path to the file of paired daemons, as used by the
+	// pairing package. Pairing is disabled if empty. Pairing also requires
+	// TLS to be enabled, as the daemons pin each other's certificates.
+	PeersFile string
+	// AllowRemoteWithoutPeerToken makes the daemon accept requests from other
+	// computers without a peer token. By default, only requests from the same
+	// computer as the daemon, and sync requests from paired daemons
+	// authenticated using their peer token, are accepted, apart from the
+	// requests used to pair.
+	AllowRemoteWithoutPeerToken bool
+	// AFK is the options for the daemon's AFK-detector. Any zero values are
+	// replaced by the values from afkdetect.DefaultOptions.
+	AFK afkdetect.Options
+	// AFKRules are used to automatically resolve AFK periods when the user
+	// returns. The first matching rule is used. AFK periods not matched by any
+	// rule are left for the user to resolve.
+	AFKRules []AFKRule
+	// NotTrackingThreshold is how long the user needs to be at the keyboard
+	// without any active entry before their status is set to "not tracking",
+	// together with a suggested entry name based on their most recent entries.
+	// Zero disables the "not tracking" status.
+	NotTrackingThreshold time.Duration
+	// Notifications is the options for the daemon's desktop notifications.
+	Notifications NotificationOptions
+	// Profiles are additional clients, such as to other database files,
+	// served by the daemon. Requests select a profile by name via the
+	// dinkurapiv1.ProfileMetadataKey gRPC metadata header. Requests without
+	// the header use the client passed to NewDaemon. Profile names are
+	// case-insensitive.
+	Profiles map[string]dinkur.Client
 }
 
 // DefaultOptions values are used for any zero values used when creating a new
@@ -99,6 +190,9 @@ type Daemon interface {
 // must be paired with a dinkur.Client such as the dinkurdb client to talk to an
 // Sqlite3 database file, or the dinkurclient client to act as a proxy.
 //
+// Additional clients can be served using the Options.Profiles field, where
+// each profile keeps track of its own AFK status.
+//
 // Both the global DefaultOptions and the opt parameter is used. The
 // DefaultOptions values are only used for any zero valued fields in the
 // opt parameter.
@@ -109,42 +203,127 @@ func NewDaemon(client dinkur.Client, opt Options) Daemon {
 	if opt.Port == 0 {
 		opt.Port = DefaultOptions.Port
 	}
-	return &daemon{
-		Options:     opt,
-		client:      client,
-		afkDetector: afkdetect.New(),
+	defaultProfile := &profile{client: client, pomodoro: newPomodoroTimer()}
+	d := &daemon{
+		Options:        opt,
+		profiles:       map[string]*profile{"": defaultProfile},
+		uniqueProfiles: []*profile{defaultProfile},
+		afkDetector:    afkdetect.New(opt.AFK),
+	}
+	if len(opt.Notifications.Calendar.Workdays) == 0 {
+		d.Notifications.Calendar = timeutil.DefaultCalendar
+	}
+	if opt.PeersFile != "" {
+		d.peers = pairing.NewStore(opt.PeersFile)
+	}
+	// profiles sharing the same client also share the same AFK status
+	byClient := map[dinkur.Client]*profile{client: defaultProfile}
+	for name, profileClient := range opt.Profiles {
+		name = strings.ToLower(name)
+		if name == "" {
+			continue
+		}
+		p, ok := byClient[profileClient]
+		if !ok {
+			p = &profile{name: name, client: profileClient, pomodoro: newPomodoroTimer()}
+			byClient[profileClient] = p
+			d.uniqueProfiles = append(d.uniqueProfiles, p)
+		}
+		d.profiles[name] = p
 	}
+	return d
 }
 
 type daemon struct {
 	Options
 	dinkurapiv1.UnimplementedEntriesServer
 	dinkurapiv1.UnimplementedStatusesServer
+	dinkurapiv1.UnimplementedSyncServer
+	dinkurapiv1.UnimplementedPairingServer
+	dinkurapiv1.UnimplementedPomodoroServer
 
-	client     dinkur.Client
-	grpcServer *grpc.Server
-	listener   net.Listener
+	// profiles always contains the default profile, using the empty name
+	profiles map[string]*profile
+	// uniqueProfiles contains each profile only once, even if it's referenced
+	// by multiple names in the profiles map
+	uniqueProfiles []*profile
+	grpcServer     *grpc.Server
+	listener       net.Listener
 
 	afkDetector afkdetect.Detector
-	closeMutex  sync.Mutex
+	// afkOptionsMutex guards reading and then changing the AFK-detector's
+	// options when merging in an update
+	afkOptionsMutex sync.Mutex
+	closeMutex      sync.Mutex
+	// notifier is nil if desktop notifications are disabled. It is guarded by
+	// notifierMutex, as it is closed while the daemon's tickers and timers may
+	// still be sending notifications
+	notifier      notify.Notifier
+	notifierMutex sync.RWMutex
 
+	// peers is nil if pairing is disabled
+	peers          *pairing.Store
+	pendingPairing *pendingPairing
+	pairingMutex   sync.Mutex
+}
+
+// profile is a client served by the daemon, together with its AFK status.
+type profile struct {
+	name   string
+	client dinkur.Client
+
+	// mutex guards the AFK, "not tracking", and notification state below,
+	// which is used both by gRPC handlers and by the daemon's tickers
+	mutex      sync.Mutex
 	lastStatus dinkur.EditStatus
+
+	notifiedLongEntryID    uint
+	notifiedUntrackedSince time.Time
+
+	// activeSince is zero if the user is AFK, or when tracking an entry
+	activeSince time.Time
+	notTracking bool
+
+	pomodoro *pomodoroTimer
 }
 
-func (d *daemon) onEntryMutation(ctx context.Context) {
-	d.markAsNotAFK(ctx)
+func (d *daemon) onEntryMutation(ctx context.Context, p *profile) {
+	p.mutex.Lock()
+	d.markAsNotAFKLocked(ctx, p)
+	d.markAsActiveLocked(p, time.Now())
+	d.clearNotTrackingLocked(ctx, p)
+	p.mutex.Unlock()
+	d.checkPomodoroEntry(ctx, p)
 }
 
 func (d *daemon) assertConnected() error {
 	if d == nil {
 		return ErrDaemonIsNil
 	}
-	if d.client == nil {
-		return dinkur.ErrClientIsNil
+	for _, p := range d.uniqueProfiles {
+		if p.client == nil {
+			return dinkur.ErrClientIsNil
+		}
 	}
 	return nil
 }
 
+// profileFromContext returns the profile selected by the request's gRPC
+// metadata, or the default profile if none was selected.
+func (d *daemon) profileFromContext(ctx context.Context) (*profile, error) {
+	var name string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(dinkurapiv1.ProfileMetadataKey); len(values) > 0 {
+			name = strings.ToLower(values[0])
+		}
+	}
+	p, ok := d.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProfile, name)
+	}
+	return p, nil
+}
+
 func (d *daemon) Serve(ctx context.Context) error {
 	if err := d.assertConnected(); err != nil {
 		return err
@@ -152,11 +331,36 @@ func (d *daemon) Serve(ctx context.Context) error {
 	if d.grpcServer != nil || d.listener != nil {
 		return ErrAlreadyServing
 	}
-	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", d.Host, d.Port))
-	if err != nil {
-		return fmt.Errorf("bind hostname and port: %w", err)
+	var mdnsService discovery.Service
+	var mdnsOpt discovery.Options
+	if d.MDNS {
+		var err error
+		mdnsService, mdnsOpt, err = d.mdnsServiceAndOptions()
+		if err != nil {
+			return fmt.Errorf("mDNS: %w", err)
+		}
 	}
-	grpcServer := grpc.NewServer()
+	lis := d.Listener
+	if lis == nil {
+		var err error
+		lis, err = net.Listen("tcp", fmt.Sprintf("%s:%d", d.Host, d.Port))
+		if err != nil {
+			return fmt.Errorf("bind hostname and port: %w", err)
+		}
+	}
+	var serverOpts []grpc.ServerOption
+	if d.TLSCertFile != "" && d.TLSKeyFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(d.TLSCertFile, d.TLSKeyFile)
+		if err != nil {
+			lis.Close()
+			return fmt.Errorf("load TLS certificate: %w", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	serverOpts = append(serverOpts,
+		grpc.UnaryInterceptor(d.unaryAuthInterceptor),
+		grpc.StreamInterceptor(d.streamAuthInterceptor))
+	grpcServer := grpc.NewServer(serverOpts...)
 	d.listener = lis
 	d.grpcServer = grpcServer
 	defer d.Close()
@@ -166,14 +370,98 @@ func (d *daemon) Serve(ctx context.Context) error {
 	}(ctx, d)
 	dinkurapiv1.RegisterEntriesServer(grpcServer, d)
 	dinkurapiv1.RegisterStatusesServer(grpcServer, d)
+	dinkurapiv1.RegisterSyncServer(grpcServer, d)
+	dinkurapiv1.RegisterPairingServer(grpcServer, d)
+	dinkurapiv1.RegisterPomodoroServer(grpcServer, d)
+	d.startNotifier()
 	d.updateAFKStatusAsWeAreStarting(ctx)
+	d.sendHeartbeat(ctx)
+	go d.sendHeartbeatsUntilDone(ctx)
 	go d.listenForAFK(ctx)
+	go d.notifyUntilDone(ctx)
+	go d.checkNotTrackingUntilDone(ctx)
 	if err := d.afkDetector.StartDetecting(); err != nil {
 		return fmt.Errorf("start afk detector: %w", err)
 	}
+	if d.MDNS {
+		mdnsService.Port = uint16(lis.Addr().(*net.TCPAddr).Port)
+		mdnsCtx, cancel := context.WithCancel(ctx)
+		mdnsDone := make(chan struct{})
+		go func() {
+			defer close(mdnsDone)
+			if err := discovery.Advertise(mdnsCtx, mdnsService, mdnsOpt); err != nil {
+				log.Error().WithError(err).Message("Failed to advertise daemon via mDNS.")
+			}
+		}()
+		// wait for the goodbye announcement before returning
+		defer func() {
+			cancel()
+			<-mdnsDone
+		}()
+	}
 	return grpcServer.Serve(lis)
 }
 
+func (d *daemon) mdnsServiceAndOptions() (discovery.Service, discovery.Options, error) {
+	iface, err := discovery.InterfaceByName(d.MDNSInterface)
+	if err != nil {
+		return discovery.Service{}, discovery.Options{}, fmt.Errorf("network interface: %w", err)
+	}
+	hostname, err := shortHostname()
+	if err != nil {
+		return discovery.Service{}, discovery.Options{}, err
+	}
+	svc := discovery.Service{
+		Instance: hostname,
+		Host:     hostname,
+		Addrs:    hostAddrs(d.Host),
+		TLS:      d.TLSCertFile != "" && d.TLSKeyFile != "",
+	}
+	if svc.TLS {
+		svc.TLSFingerprint, err = discovery.FingerprintFile(d.TLSCertFile)
+		if err != nil {
+			return discovery.Service{}, discovery.Options{}, fmt.Errorf("TLS certificate fingerprint: %w", err)
+		}
+	}
+	if len(svc.Addrs) > 0 && svc.Addrs[0].IsLoopback() {
+		log.Warn().WithString("host", d.Host).
+			Message("Daemon is bound to a loopback address, and can only be reached from this computer. Use a host such as 0.0.0.0 to allow other computers.")
+	}
+	return svc, discovery.Options{Interface: iface}, nil
+}
+
+// shortHostname returns the computer's hostname without any domain, used to
+// name the daemon when advertising and pairing.
+func shortHostname() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("get hostname: %w", err)
+	}
+	hostname, _, _ = strings.Cut(hostname, ".")
+	return hostname, nil
+}
+
+// hostAddrs returns the IPv4 addresses the host resolves to, or nil if the
+// daemon is bound to all addresses.
+func hostAddrs(host string) []net.IP {
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil
+	}
+	var addrs []net.IP
+	for _, ip := range ips {
+		ip4 := ip.To4()
+		if ip4 == nil {
+			continue
+		}
+		if ip4.IsUnspecified() {
+			return nil
+		}
+		addrs = append(addrs, ip4)
+	}
+	return addrs
+}
+
 func (d *daemon) Close() (finalErr error) {
 	d.closeMutex.Lock()
 	defer d.closeMutex.Unlock()
@@ -192,34 +480,93 @@ func (d *daemon) Close() (finalErr error) {
 		log.Error().WithError(err).Message("Stopping AFK detector in Dinkur daemon.")
 		finalErr = err
 	}
+	d.stopPomodoros()
 	d.updateAFKStatusAsWeAreClosing()
+	if err := d.stopNotifier(); err != nil {
+		log.Error().WithError(err).Message("Closing desktop notifier in Dinkur daemon.")
+		finalErr = err
+	}
 	return
 }
 
 func (d *daemon) updateAFKStatusAsWeAreStarting(ctx context.Context) {
-	status, err := d.client.GetStatus(ctx)
+	for _, p := range d.uniqueProfiles {
+		d.updateProfileAFKStatusAsWeAreStarting(ctx, p)
+	}
+}
+
+func (d *daemon) updateProfileAFKStatusAsWeAreStarting(ctx context.Context, p *profile) {
+	status, err := p.client.GetStatus(ctx)
 	if err != nil {
 		return
 	}
-	d.lastStatus = dinkur.EditStatus{
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	// clear any "not tracking" status left from when the daemon last ran
+	p.notTracking = status.NotTrackingSince != nil
+	d.clearNotTrackingLocked(ctx, p)
+	d.markAsActiveLocked(p, time.Now())
+	p.lastStatus = dinkur.EditStatus{
 		AFKSince:  status.AFKSince,
 		BackSince: status.BackSince,
 	}
-	entry, err := d.client.GetActiveEntry(ctx)
+	entry, err := p.client.GetActiveEntry(ctx)
 	if err != nil || entry == nil {
-		d.markAsNotAFK(ctx)
+		d.markAsNotAFKLocked(ctx, p)
 		return
 	}
-	d.markAsReturnedFromAFK(ctx)
+	// The last heartbeat tells us when the daemon was last running, which
+	// unlike the AFK status set when closing also covers the cases where the
+	// daemon never got to close gracefully, such as on power loss.
+	if hb := status.Heartbeat; hb != nil && p.lastStatus.BackSince == nil &&
+		!hb.Before(entry.Start) &&
+		(p.lastStatus.AFKSince == nil || hb.Before(*p.lastStatus.AFKSince)) {
+		p.lastStatus.AFKSince = hb
+		p.lastStatus.AFKHook = afkHookDowntime
+	}
+	d.markAsReturnedFromAFKLocked(ctx, p)
 }
 
 func (d *daemon) updateAFKStatusAsWeAreClosing() {
 	// must use new context as base context from Serve is cancelled by now
-	entry, err := d.client.GetActiveEntry(context.Background())
-	if err != nil || entry == nil {
-		return
+	ctx := context.Background()
+	for _, p := range d.uniqueProfiles {
+		entry, err := p.client.GetActiveEntry(ctx)
+		if err != nil || entry == nil {
+			continue
+		}
+		d.markAsAFK(ctx, p, time.Now(), afkHookShutdown)
+	}
+	// The heartbeat must not be before the AFK status set above, as an
+	// earlier heartbeat would replace it on the next start, as if the daemon
+	// never got to close gracefully.
+	d.sendHeartbeat(ctx)
+	for _, p := range d.uniqueProfiles {
+		d.markAsInactive(ctx, p)
+	}
+}
+
+func (d *daemon) sendHeartbeatsUntilDone(ctx context.Context) {
+	ticker := time.NewTicker(heartbeatIntervalDur)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		select {
+		case <-ticker.C:
+			d.sendHeartbeat(ctx)
+		case <-done:
+			return
+		}
+	}
+}
+
+func (d *daemon) sendHeartbeat(ctx context.Context) {
+	for _, p := range d.uniqueProfiles {
+		if err := p.client.SetHeartbeat(ctx, time.Now()); err != nil {
+			log.Warn().WithError(err).WithString("profile", p.name).
+				Message("Failed to persist daemon heartbeat.")
+		}
 	}
-	d.markAsAFK(context.Background())
 }
 
 func (d *daemon) listenForAFK(ctx context.Context) {
@@ -231,20 +578,26 @@ func (d *daemon) listenForAFK(ctx context.Context) {
 	done := ctx.Done()
 	for {
 		select {
-		case <-startedChan:
-			entry, err := d.client.GetActiveEntry(ctx)
-			if err != nil {
-				log.Warn().WithError(err).
-					Message("Failed to get active entry when marking status as AFK.")
-				continue
+		case ev := <-startedChan:
+			for _, p := range d.uniqueProfiles {
+				entry, err := p.client.GetActiveEntry(ctx)
+				if err != nil {
+					log.Warn().WithError(err).WithString("profile", p.name).
+						Message("Failed to get active entry when marking status as AFK.")
+					continue
+				}
+				d.markAsInactive(ctx, p)
+				if entry == nil {
+					d.markAsNotAFK(ctx, p)
+					continue
+				}
+				d.markAsAFK(ctx, p, ev.Since, ev.Hook)
 			}
-			if entry == nil {
-				d.markAsNotAFK(ctx)
-				continue
-			}
-			d.markAsAFK(ctx)
 		case <-stoppedChan:
-			d.markAsReturnedFromAFK(ctx)
+			for _, p := range d.uniqueProfiles {
+				d.markAsReturnedFromAFK(ctx, p)
+				d.markAsActive(p, time.Now())
+			}
 		case <-done:
 			return
 		}
@@ -37,7 +37,11 @@ func (d *daemon) Ping(ctx context.Context, req *dinkurapiv1.PingRequest) (*dinku
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	if err := d.client.Ping(ctx); err != nil {
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	if err := p.client.Ping(ctx); err != nil {
 		return nil, convError(err)
 	}
 	return &dinkurapiv1.PingResponse{}, nil
@@ -50,11 +54,15 @@ func (d *daemon) GetEntry(ctx context.Context, req *dinkurapiv1.GetEntryRequest)
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
 	id, err := conv.Uint64ToUint(req.Id)
 	if err != nil {
 		return nil, convError(err)
 	}
-	entry, err := d.client.GetEntry(ctx, id)
+	entry, err := p.client.GetEntry(ctx, id)
 	if err != nil {
 		if errors.Is(err, dinkur.ErrNotFound) {
 			return &dinkurapiv1.GetEntryResponse{}, nil
@@ -73,7 +81,11 @@ func (d *daemon) GetActiveEntry(ctx context.Context, req *dinkurapiv1.GetActiveE
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	entry, err := d.client.GetActiveEntry(ctx)
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	entry, err := p.client.GetActiveEntry(ctx)
 	if err != nil {
 		if errors.Is(err, dinkur.ErrNotFound) {
 			return &dinkurapiv1.GetActiveEntryResponse{}, nil
@@ -92,6 +104,10 @@ func (d *daemon) GetEntryList(ctx context.Context, req *dinkurapiv1.GetEntryList
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
 	search := dinkur.SearchEntry{
 		Start:              fromgrpc.TimePtr(req.Start),
 		End:                fromgrpc.TimePtr(req.End),
@@ -100,12 +116,15 @@ func (d *daemon) GetEntryList(ctx context.Context, req *dinkurapiv1.GetEntryList
 		NameHighlightStart: req.NameHighlightStart,
 		NameHighlightEnd:   req.NameHighlightEnd,
 	}
-	var err error
 	search.Limit, err = conv.Uint64ToUint(req.Limit)
 	if err != nil {
 		return nil, convError(err)
 	}
-	entries, err := d.client.GetEntryList(ctx, search)
+	search.ShorthandCount, err = conv.Uint64ToUint(req.ShorthandCount)
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := p.client.GetEntryList(ctx, search)
 	if err != nil {
 		return nil, convError(err)
 	}
@@ -121,6 +140,10 @@ func (d *daemon) CreateEntry(ctx context.Context, req *dinkurapiv1.CreateEntryRe
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
 	startAfterID, err := conv.Uint64ToUint(req.StartAfterIdOrZero)
 	if err != nil {
 		return nil, convError(err)
@@ -137,11 +160,11 @@ func (d *daemon) CreateEntry(ctx context.Context, req *dinkurapiv1.CreateEntryRe
 		EndBeforeIDOrZero:  endBeforeID,
 		StartAfterLast:     req.StartAfterLast,
 	}
-	startedEntry, err := d.client.CreateEntry(ctx, newEntry)
+	startedEntry, err := p.client.CreateEntry(ctx, newEntry)
 	if err != nil {
 		return nil, convError(err)
 	}
-	d.onEntryMutation(ctx)
+	d.onEntryMutation(ctx, p)
 	return &dinkurapiv1.CreateEntryResponse{
 		PreviouslyActiveEntry: togrpc.EntryPtr(startedEntry.Stopped),
 		CreatedEntry:          togrpc.EntryPtr(&startedEntry.Started),
@@ -155,6 +178,10 @@ func (d *daemon) UpdateEntry(ctx context.Context, req *dinkurapiv1.UpdateEntryRe
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
 	id, err := conv.Uint64ToUint(req.IdOrZero)
 	if err != nil {
 		return nil, convError(err)
@@ -179,11 +206,11 @@ func (d *daemon) UpdateEntry(ctx context.Context, req *dinkurapiv1.UpdateEntryRe
 		EndBeforeIDOrZero:  endBeforeID,
 		StartAfterLast:     req.StartAfterLast,
 	}
-	update, err := d.client.UpdateEntry(ctx, edit)
+	update, err := p.client.UpdateEntry(ctx, edit)
 	if err != nil {
 		return nil, convError(err)
 	}
-	d.onEntryMutation(ctx)
+	d.onEntryMutation(ctx, p)
 	return &dinkurapiv1.UpdateEntryResponse{
 		Before: togrpc.EntryPtr(&update.Before),
 		After:  togrpc.EntryPtr(&update.After),
@@ -197,15 +224,19 @@ func (d *daemon) DeleteEntry(ctx context.Context, req *dinkurapiv1.DeleteEntryRe
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
 	id, err := conv.Uint64ToUint(req.Id)
 	if err != nil {
 		return nil, convError(err)
 	}
-	deletedEntry, err := d.client.DeleteEntry(ctx, id)
+	deletedEntry, err := p.client.DeleteEntry(ctx, id)
 	if err != nil {
 		return nil, convError(err)
 	}
-	d.onEntryMutation(ctx)
+	d.onEntryMutation(ctx, p)
 	return &dinkurapiv1.DeleteEntryResponse{
 		DeletedEntry: togrpc.EntryPtr(&deletedEntry),
 	}, nil
@@ -218,16 +249,70 @@ func (d *daemon) StopActiveEntry(ctx context.Context, req *dinkurapiv1.StopActiv
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	stoppedEntry, err := d.client.StopActiveEntry(ctx, fromgrpc.TimeOrNow(req.End))
+	p, err := d.profileFromContext(ctx)
 	if err != nil {
 		return nil, convError(err)
 	}
-	d.onEntryMutation(ctx)
+	stoppedEntry, err := p.client.StopActiveEntry(ctx, fromgrpc.TimeOrNow(req.End))
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx, p)
 	return &dinkurapiv1.StopActiveEntryResponse{
 		StoppedEntry: togrpc.EntryPtr(stoppedEntry),
 	}, nil
 }
 
+func (d *daemon) PauseActiveEntry(ctx context.Context, req *dinkurapiv1.PauseActiveEntryRequest) (*dinkurapiv1.PauseActiveEntryResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	pausedEntry, err := p.client.PauseActiveEntry(ctx, fromgrpc.TimeOrNow(req.End))
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx, p)
+	return &dinkurapiv1.PauseActiveEntryResponse{
+		PausedEntry: togrpc.EntryPtr(pausedEntry),
+	}, nil
+}
+
+func (d *daemon) ResumeEntry(ctx context.Context, req *dinkurapiv1.ResumeEntryRequest) (*dinkurapiv1.ResumeEntryResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	id, err := conv.Uint64ToUint(req.IdOrZero)
+	if err != nil {
+		return nil, convError(err)
+	}
+	resumedEntry, err := p.client.ResumeEntry(ctx, dinkur.ResumeEntry{
+		IDOrZero: id,
+		Start:    fromgrpc.TimePtr(req.Start),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx, p)
+	return &dinkurapiv1.ResumeEntryResponse{
+		PreviouslyActiveEntry: togrpc.EntryPtr(resumedEntry.Stopped),
+		ResumedEntry:          togrpc.EntryPtr(&resumedEntry.Started),
+	}, nil
+}
+
 func (d *daemon) StreamEntry(req *dinkurapiv1.StreamEntryRequest, stream dinkurapiv1.Entries_StreamEntryServer) error {
 	if err := d.assertConnected(); err != nil {
 		return convError(err)
@@ -237,7 +322,11 @@ func (d *daemon) StreamEntry(req *dinkurapiv1.StreamEntryRequest, stream dinkura
 	}
 	ctx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
-	ch, err := d.client.StreamEntry(ctx)
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return convError(err)
+	}
+	ch, err := p.client.StreamEntry(ctx)
 	if err != nil {
 		return convError(err)
 	}
@@ -0,0 +1,254 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/notify"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+)
+
+var notificationCheckIntervalDur = time.Minute
+
+// NotificationOptions is the options for the daemon's desktop notifications.
+type NotificationOptions struct {
+	// Enabled turns on desktop notifications, sent when returning from AFK
+	// with time spent away that is left for the user to resolve, as well as
+	// for the other cases below that are enabled.
+	Enabled bool
+	// LongEntry is how long an entry may be active before notifying the user,
+	// in case they forgot to stop it. Zero disables this notification.
+	LongEntry time.Duration
+	// Untracked is how long no entry may be active during working hours
+	// before notifying the user, in case they forgot to start one. Zero
+	// disables this notification.
+	Untracked time.Duration
+	// WorkHoursFrom and WorkHoursTo is the time of day, given as the duration
+	// since midnight, that is considered working hours. The range wraps past
+	// midnight if WorkHoursFrom is after WorkHoursTo, and covers the whole day
+	// if both are zero.
+	WorkHoursFrom time.Duration
+	WorkHoursTo   time.Duration
+	// Calendar decides which days are workdays. The timeutil.DefaultCalendar
+	// is used if no workdays are set.
+	Calendar timeutil.Calendar
+}
+
+// workHoursStart returns the start of the working hours that the given time
+// is within, or false if it is outside working hours.
+func (o NotificationOptions) workHoursStart(t time.Time) (time.Time, bool) {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	timeOfDay := t.Sub(midnight)
+	var start time.Time
+	switch {
+	case o.WorkHoursFrom == 0 && o.WorkHoursTo == 0:
+		start = midnight
+	case o.WorkHoursFrom <= o.WorkHoursTo:
+		if timeOfDay < o.WorkHoursFrom || timeOfDay >= o.WorkHoursTo {
+			return time.Time{}, false
+		}
+		start = midnight.Add(o.WorkHoursFrom)
+	case timeOfDay >= o.WorkHoursFrom:
+		start = midnight.Add(o.WorkHoursFrom)
+	case timeOfDay < o.WorkHoursTo:
+		start = midnight.AddDate(0, 0, -1).Add(o.WorkHoursFrom)
+	default:
+		return time.Time{}, false
+	}
+	if !o.Calendar.IsWorkday(start) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+func (d *daemon) startNotifier() {
+	if !d.Notifications.Enabled {
+		return
+	}
+	n, err := notify.New("Dinkur")
+	if err != nil {
+		log.Warn().WithError(err).Message("Failed to set up desktop notifications. Continuing without them.")
+		return
+	}
+	d.notifierMutex.Lock()
+	d.notifier = n
+	d.notifierMutex.Unlock()
+}
+
+func (d *daemon) stopNotifier() error {
+	d.notifierMutex.Lock()
+	defer d.notifierMutex.Unlock()
+	if d.notifier == nil {
+		return nil
+	}
+	err := d.notifier.Close()
+	d.notifier = nil
+	return err
+}
+
+func (d *daemon) hasNotifier() bool {
+	d.notifierMutex.RLock()
+	defer d.notifierMutex.RUnlock()
+	return d.notifier != nil
+}
+
+func (d *daemon) notify(p *profile, kind string, n notify.Notification) {
+	// keep the notifier from being closed while sending
+	d.notifierMutex.RLock()
+	defer d.notifierMutex.RUnlock()
+	if d.notifier == nil {
+		return
+	}
+	if p.name != "" {
+		n.Summary = fmt.Sprintf("%s (%s)", n.Summary, p.name)
+	}
+	n.ReplacesKey = kind + "/" + p.name
+	if err := d.notifier.Notify(n); err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).WithString("kind", kind).
+			Message("Failed to send desktop notification.")
+		return
+	}
+	log.Debug().WithString("profile", p.name).WithString("kind", kind).
+		Message("Sent desktop notification.")
+}
+
+func (d *daemon) notifyReturnedFromAFK(ctx context.Context, p *profile, afkSince, backSince time.Time) {
+	if !d.hasNotifier() {
+		return
+	}
+	entry, err := p.client.GetActiveEntry(ctx)
+	if err != nil || entry == nil {
+		return
+	}
+	d.notify(p, "afk", notify.Notification{
+		Summary: "Welcome back",
+		Body: fmt.Sprintf("You were away for %s while tracking %q. Run \"dinkur away resolve\" to keep or discard the time spent away.",
+			formatNotificationDuration(backSince.Sub(afkSince)), entry.Name),
+		Urgency: notify.UrgencyNormal,
+	})
+}
+
+func (d *daemon) notifyUntilDone(ctx context.Context) {
+	if !d.hasNotifier() ||
+		(d.Notifications.LongEntry <= 0 && d.Notifications.Untracked <= 0) {
+		return
+	}
+	ticker := time.NewTicker(notificationCheckIntervalDur)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		select {
+		case <-ticker.C:
+			for _, p := range d.uniqueProfiles {
+				d.checkNotifications(ctx, p, time.Now())
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (d *daemon) checkNotifications(ctx context.Context, p *profile, now time.Time) {
+	entry, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get active entry when checking notifications.")
+		return
+	}
+	if entry != nil {
+		d.checkLongEntryNotification(p, *entry, now)
+	} else {
+		d.checkUntrackedNotification(ctx, p, now)
+	}
+}
+
+func (d *daemon) checkLongEntryNotification(p *profile, entry dinkur.Entry, now time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if d.Notifications.LongEntry <= 0 || p.notifiedLongEntryID == entry.ID {
+		return
+	}
+	if now.Sub(entry.Start) < d.Notifications.LongEntry {
+		return
+	}
+	p.notifiedLongEntryID = entry.ID
+	d.notify(p, "long-entry", notify.Notification{
+		Summary: fmt.Sprintf("Tracking for %s", formatNotificationDuration(now.Sub(entry.Start))),
+		Body: fmt.Sprintf("%q has been active since %s. Run \"dinkur out\" if you forgot to stop it.",
+			entry.Name, entry.Start.Local().Format("15:04")),
+		Urgency: notify.UrgencyNormal,
+	})
+}
+
+func (d *daemon) checkUntrackedNotification(ctx context.Context, p *profile, now time.Time) {
+	if d.Notifications.Untracked <= 0 {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.lastStatus.AFKSince != nil && p.lastStatus.BackSince == nil {
+		// no need to nag while the user is away
+		return
+	}
+	since, ok := d.Notifications.workHoursStart(now)
+	if !ok {
+		return
+	}
+	entries, err := p.client.GetEntryList(ctx, dinkur.SearchEntry{Limit: 1})
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get latest entry when checking notifications.")
+		return
+	}
+	if len(entries) > 0 && entries[0].End != nil && entries[0].End.After(since) {
+		since = *entries[0].End
+	}
+	if now.Sub(since) < d.Notifications.Untracked || p.notifiedUntrackedSince.Equal(since) {
+		return
+	}
+	p.notifiedUntrackedSince = since
+	d.notify(p, "untracked", notify.Notification{
+		Summary: "Not tracking any entry",
+		Body: fmt.Sprintf("Nothing has been tracked for %s. Run \"dinkur in\" to start a new entry.",
+			formatNotificationDuration(now.Sub(since))),
+		Urgency: notify.UrgencyLow,
+	})
+}
+
+// formatNotificationDuration formats a duration in whole minutes, such as
+// "1h 5m" or "45m".
+func formatNotificationDuration(dur time.Duration) string {
+	if dur < time.Minute {
+		return "under a minute"
+	}
+	dur = dur.Round(time.Minute)
+	hours := dur / time.Hour
+	minutes := (dur % time.Hour) / time.Minute
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
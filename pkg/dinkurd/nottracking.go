@@ -0,0 +1,151 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+var notTrackingCheckIntervalDur = 30 * time.Second
+
+// suggestedEntryNameHistory is how many of the most recent entries are
+// considered when suggesting an entry name in the "not tracking" status.
+const suggestedEntryNameHistory = 10
+
+// markAsActive keeps track of when the user was last seen at the keyboard,
+// for use in the "not tracking" status.
+func (d *daemon) markAsActive(p *profile, since time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	d.markAsActiveLocked(p, since)
+}
+
+// markAsActiveLocked is the same as markAsActive, but the profile's mutex must
+// be held.
+func (d *daemon) markAsActiveLocked(p *profile, since time.Time) {
+	p.activeSince = since
+}
+
+// markAsInactive is used when the user has gone AFK, or is tracking an entry,
+// and clears the "not tracking" status.
+func (d *daemon) markAsInactive(ctx context.Context, p *profile) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.activeSince = time.Time{}
+	d.clearNotTrackingLocked(ctx, p)
+}
+
+// clearNotTrackingLocked clears the "not tracking" status, if set. The
+// profile's mutex must be held.
+func (d *daemon) clearNotTrackingLocked(ctx context.Context, p *profile) {
+	if !p.notTracking {
+		return
+	}
+	if err := p.client.SetNotTracking(ctx, dinkur.EditNotTracking{}); err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to clear \"not tracking\" status.")
+		return
+	}
+	p.notTracking = false
+}
+
+func (d *daemon) checkNotTrackingUntilDone(ctx context.Context) {
+	if d.NotTrackingThreshold <= 0 {
+		return
+	}
+	ticker := time.NewTicker(notTrackingCheckIntervalDur)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		select {
+		case <-ticker.C:
+			for _, p := range d.uniqueProfiles {
+				d.checkNotTracking(ctx, p, time.Now())
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (d *daemon) checkNotTracking(ctx context.Context, p *profile, now time.Time) {
+	entry, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get active entry when checking \"not tracking\" status.")
+		return
+	}
+	if entry != nil {
+		d.markAsInactive(ctx, p)
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.notTracking || p.activeSince.IsZero() ||
+		now.Sub(p.activeSince) < d.NotTrackingThreshold {
+		return
+	}
+	var suggestedName string
+	entries, err := p.client.GetEntryList(ctx, dinkur.SearchEntry{Limit: suggestedEntryNameHistory})
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get recent entries when suggesting an entry name.")
+	} else {
+		suggestedName = suggestEntryName(entries)
+	}
+	since := p.activeSince
+	if err := p.client.SetNotTracking(ctx, dinkur.EditNotTracking{
+		Since:              &since,
+		SuggestedEntryName: suggestedName,
+	}); err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to set \"not tracking\" status.")
+		return
+	}
+	p.notTracking = true
+	log.Info().
+		WithString("profile", p.name).
+		WithTime("since", since).
+		WithString("suggestion", suggestedName).
+		Message("User is active but not tracking any entry.")
+}
+
+// suggestEntryName returns the most frequent entry name, where ties are won
+// by the most recent entry. The entries are expected to be sorted by start
+// time in ascending order.
+func suggestEntryName(entries []dinkur.Entry) string {
+	counts := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		counts[entry.Name]++
+	}
+	var best string
+	var bestCount int
+	for i := len(entries) - 1; i >= 0; i-- {
+		if name := entries[i].Name; counts[name] > bestCount {
+			best = name
+			bestCount = counts[name]
+		}
+	}
+	return best
+}
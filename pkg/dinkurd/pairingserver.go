@@ -0,0 +1,488 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/discovery"
+	"github.com/dinkur/dinkur/pkg/pairing"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pairingCodeTTL         = 5 * time.Minute
+	pairingCodeMaxAttempts = 3
+)
+
+// pendingPairing is a pairing code that is waiting for another daemon to
+// complete the pairing.
+type pendingPairing struct {
+	// code is the normalized pairing code
+	code     string
+	expires  time.Time
+	attempts int
+	// exchange is the key exchange started by the latest ExchangePairingKey
+	// call, waiting for the requesting daemon's key confirmation
+	exchange *pairing.KeyExchange
+	// requesterFingerprint is the TLS fingerprint of the daemon that started
+	// the latest key exchange
+	requesterFingerprint string
+	// paired receives the new peer, and is buffered so that completing the
+	// pairing does not block when no one is waiting
+	paired chan dinkur.Peer
+}
+
+func (d *daemon) StartPairing(ctx context.Context, req *dinkurapiv1.StartPairingRequest) (*dinkurapiv1.StartPairingResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if err := assertLocalRequest(ctx); err != nil {
+		return nil, convError(err)
+	}
+	fingerprint, err := d.pairingFingerprint()
+	if err != nil {
+		return nil, convError(err)
+	}
+	code, err := pairing.NewCode()
+	if err != nil {
+		return nil, convError(err)
+	}
+	expires := time.Now().Add(pairingCodeTTL)
+	d.pairingMutex.Lock()
+	d.pendingPairing = &pendingPairing{
+		code:    pairing.NormalizeCode(code),
+		expires: expires,
+		paired:  make(chan dinkur.Peer, 1),
+	}
+	d.pairingMutex.Unlock()
+	log.Info().Message("Started pairing. Waiting for another daemon to use the pairing code.")
+	return &dinkurapiv1.StartPairingResponse{
+		Code:           code,
+		Expires:        togrpc.Timestamp(expires),
+		TlsFingerprint: fingerprint,
+	}, nil
+}
+
+func (d *daemon) WaitForPairing(ctx context.Context, req *dinkurapiv1.WaitForPairingRequest) (*dinkurapiv1.WaitForPairingResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if err := assertLocalRequest(ctx); err != nil {
+		return nil, convError(err)
+	}
+	d.pairingMutex.Lock()
+	pending := d.pendingPairing
+	d.pairingMutex.Unlock()
+	if pending == nil || pending.code != pairing.NormalizeCode(req.Code) {
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	timer := time.NewTimer(time.Until(pending.expires))
+	defer timer.Stop()
+	select {
+	case p := <-pending.paired:
+		return &dinkurapiv1.WaitForPairingResponse{Peer: togrpc.Peer(p)}, nil
+	case <-timer.C:
+		return nil, convError(pairing.ErrCodeInvalid)
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (d *daemon) RequestPairing(ctx context.Context, req *dinkurapiv1.RequestPairingRequest) (*dinkurapiv1.RequestPairingResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if err := assertLocalRequest(ctx); err != nil {
+		return nil, convError(err)
+	}
+	if req.Address == "" || pairing.NormalizeCode(req.Code) == "" {
+		return nil, convError(fmt.Errorf("%w: address and code are required", ErrPairingRequestInvalid))
+	}
+	fingerprint, err := d.pairingFingerprint()
+	if err != nil {
+		return nil, convError(err)
+	}
+	name, err := shortHostname()
+	if err != nil {
+		return nil, convError(err)
+	}
+	remoteFingerprint, err := pairing.FetchFingerprint(ctx, req.Address)
+	if err != nil {
+		return nil, convError(fmt.Errorf("get TLS certificate of daemon: %w", err))
+	}
+	kx, err := pairing.NewKeyExchange(pairing.RoleRequester, req.Code, fingerprint, remoteFingerprint)
+	if err != nil {
+		return nil, convError(err)
+	}
+	token, err := pairing.NewToken()
+	if err != nil {
+		return nil, convError(err)
+	}
+	conn, err := grpc.DialContext(ctx, req.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(pairing.PinnedTLSConfig(remoteFingerprint))))
+	if err != nil {
+		return nil, convError(fmt.Errorf("connect to daemon: %w", err))
+	}
+	defer conn.Close()
+	pairingClient := dinkurapiv1.NewPairingClient(conn)
+	exchangeRes, err := pairingClient.ExchangePairingKey(ctx, &dinkurapiv1.ExchangePairingKeyRequest{
+		TlsFingerprint: fingerprint,
+		PakeMessage:    kx.Message(),
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, convError(pairing.ErrCodeInvalid)
+	} else if err != nil {
+		return nil, convError(fmt.Errorf("exchange pairing key with daemon: %w", err))
+	}
+	if err := kx.Finish(exchangeRes.PakeMessage); err != nil {
+		return nil, convError(fmt.Errorf("%w: %s", ErrPairingRequestInvalid, err))
+	}
+	// the other daemon must prove that it knows the code before we send our
+	// confirmation and token, or else we could be talking to a
+	// man-in-the-middle
+	if !kx.VerifyConfirmation(exchangeRes.Confirmation) {
+		log.Warn().WithString("address", req.Address).
+			Message("Daemon could not prove that it knows the pairing code. Either the code was mistyped, or someone is intercepting the connection.")
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	res, err := pairingClient.CompletePairing(ctx, &dinkurapiv1.CompletePairingRequest{
+		Name:           name,
+		Port:           uint32(d.Port),
+		TlsFingerprint: fingerprint,
+		Token:          token,
+		Confirmation:   kx.Confirmation(),
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, convError(pairing.ErrCodeInvalid)
+	} else if err != nil {
+		return nil, convError(fmt.Errorf("complete pairing with daemon: %w", err))
+	}
+	if res.Name == "" || res.Token == "" {
+		return nil, convError(fmt.Errorf("%w: daemon responded without name or token", ErrPairingRequestInvalid))
+	}
+	p := pairing.Peer{
+		Name:           res.Name,
+		Address:        req.Address,
+		TLSFingerprint: remoteFingerprint,
+		Token:          res.Token,
+		TokenHash:      pairing.HashToken(token),
+		Paired:         time.Now().UTC(),
+	}
+	if err := d.peers.Save(p); err != nil {
+		return nil, convError(err)
+	}
+	log.Info().WithString("peer", p.Name).WithString("address", p.Address).
+		Message("Paired with daemon.")
+	return &dinkurapiv1.RequestPairingResponse{Peer: togrpc.Peer(dinkurPeer(p))}, nil
+}
+
+func (d *daemon) ExchangePairingKey(ctx context.Context, req *dinkurapiv1.ExchangePairingKeyRequest) (*dinkurapiv1.ExchangePairingKeyResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.TlsFingerprint == "" || len(req.PakeMessage) == 0 {
+		return nil, convError(fmt.Errorf("%w: TLS fingerprint and key exchange message are required", ErrPairingRequestInvalid))
+	}
+	fingerprint, err := d.pairingFingerprint()
+	if err != nil {
+		return nil, convError(err)
+	}
+	host, err := remoteHost(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.pairingMutex.Lock()
+	defer d.pairingMutex.Unlock()
+	pending := d.pendingPairing
+	if pending == nil || time.Now().After(pending.expires) {
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	// each key exchange lets the other daemon guess the code once
+	if pending.attempts >= pairingCodeMaxAttempts {
+		d.pendingPairing = nil
+		log.Warn().WithString("host", host).
+			Message("Revoked pairing code after too many pairing attempts.")
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	pending.attempts++
+	kx, err := pairing.NewKeyExchange(pairing.RoleResponder, pending.code, req.TlsFingerprint, fingerprint)
+	if err != nil {
+		return nil, convError(err)
+	}
+	if err := kx.Finish(req.PakeMessage); err != nil {
+		return nil, convError(fmt.Errorf("%w: %s", ErrPairingRequestInvalid, err))
+	}
+	pending.exchange = kx
+	pending.requesterFingerprint = req.TlsFingerprint
+	return &dinkurapiv1.ExchangePairingKeyResponse{
+		PakeMessage:  kx.Message(),
+		Confirmation: kx.Confirmation(),
+	}, nil
+}
+
+func (d *daemon) CompletePairing(ctx context.Context, req *dinkurapiv1.CompletePairingRequest) (*dinkurapiv1.CompletePairingResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.Name == "" || req.TlsFingerprint == "" || req.Token == "" ||
+		req.Port == 0 || req.Port > 65535 {
+		return nil, convError(fmt.Errorf("%w: name, port, TLS fingerprint, and token are required", ErrPairingRequestInvalid))
+	}
+	if _, err := d.pairingFingerprint(); err != nil {
+		return nil, convError(err)
+	}
+	name, err := shortHostname()
+	if err != nil {
+		return nil, convError(err)
+	}
+	host, err := remoteHost(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.pairingMutex.Lock()
+	defer d.pairingMutex.Unlock()
+	pending := d.pendingPairing
+	if pending == nil || time.Now().After(pending.expires) {
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	kx := pending.exchange
+	// a key exchange can only be completed once
+	pending.exchange = nil
+	if kx == nil || !strings.EqualFold(req.TlsFingerprint, pending.requesterFingerprint) ||
+		!kx.VerifyConfirmation(req.Confirmation) {
+		if pending.attempts >= pairingCodeMaxAttempts {
+			d.pendingPairing = nil
+		}
+		log.Warn().WithString("peer", req.Name).WithString("host", host).
+			WithInt("attempts", pending.attempts).
+			Message("Rejected pairing attempt with wrong pairing code.")
+		return nil, convError(pairing.ErrCodeInvalid)
+	}
+	// codes are single-use
+	d.pendingPairing = nil
+	token, err := pairing.NewToken()
+	if err != nil {
+		return nil, convError(err)
+	}
+	p := pairing.Peer{
+		Name:           req.Name,
+		Address:        net.JoinHostPort(host, strconv.FormatUint(uint64(req.Port), 10)),
+		TLSFingerprint: req.TlsFingerprint,
+		Token:          req.Token,
+		TokenHash:      pairing.HashToken(token),
+		Paired:         time.Now().UTC(),
+	}
+	if err := d.peers.Save(p); err != nil {
+		return nil, convError(err)
+	}
+	log.Info().WithString("peer", p.Name).WithString("address", p.Address).
+		Message("Paired with daemon.")
+	pending.paired <- dinkurPeer(p)
+	return &dinkurapiv1.CompletePairingResponse{
+		Name:  name,
+		Token: token,
+	}, nil
+}
+
+func (d *daemon) GetPeerList(ctx context.Context, req *dinkurapiv1.GetPeerListRequest) (*dinkurapiv1.GetPeerListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if err := assertLocalRequest(ctx); err != nil {
+		return nil, convError(err)
+	}
+	if d.peers == nil {
+		return nil, convError(ErrPairingDisabled)
+	}
+	peers, err := d.peers.Peers()
+	if err != nil {
+		return nil, convError(err)
+	}
+	res := &dinkurapiv1.GetPeerListResponse{
+		Peers: make([]*dinkurapiv1.Peer, len(peers)),
+	}
+	for i, p := range peers {
+		res.Peers[i] = togrpc.Peer(dinkurPeer(p))
+	}
+	return res, nil
+}
+
+func (d *daemon) DeletePeer(ctx context.Context, req *dinkurapiv1.DeletePeerRequest) (*dinkurapiv1.DeletePeerResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if err := assertLocalRequest(ctx); err != nil {
+		return nil, convError(err)
+	}
+	if d.peers == nil {
+		return nil, convError(ErrPairingDisabled)
+	}
+	p, err := d.peers.Delete(req.Name)
+	if err != nil {
+		return nil, convError(err)
+	}
+	log.Info().WithString("peer", p.Name).Message("Revoked pairing with daemon.")
+	return &dinkurapiv1.DeletePeerResponse{DeletedPeer: togrpc.Peer(dinkurPeer(p))}, nil
+}
+
+// pairingFingerprint returns the fingerprint of the daemon's TLS certificate,
+// or an error if pairing is not possible.
+func (d *daemon) pairingFingerprint() (string, error) {
+	if d.peers == nil {
+		return "", ErrPairingDisabled
+	}
+	if d.TLSCertFile == "" || d.TLSKeyFile == "" {
+		return "", ErrPairingRequiresTLS
+	}
+	return discovery.FingerprintFile(d.TLSCertFile)
+}
+
+// authenticatePeer checks the peer token of a request. Requests without a
+// token are only allowed if they come from the same computer, or if the
+// daemon allows unauthenticated requests from other computers. Paired daemons
+// are only allowed to sync, so a peer token does not grant access to any other
+// gRPC service.
+func (d *daemon) authenticatePeer(ctx context.Context, fullMethod string) error {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(dinkurapiv1.PeerTokenMetadataKey); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	if token == "" || !strings.HasPrefix(fullMethod, peerTokenMethodPrefix) {
+		if !d.AllowRemoteWithoutPeerToken && assertLocalRequest(ctx) != nil {
+			return ErrPeerTokenRequired
+		}
+		return nil
+	}
+	if d.peers == nil {
+		return ErrPeerTokenInvalid
+	}
+	p, err := d.peers.PeerByToken(token)
+	if errors.Is(err, pairing.ErrPeerNotFound) {
+		return ErrPeerTokenInvalid
+	} else if err != nil {
+		return err
+	}
+	log.Debug().WithString("peer", p.Name).Message("Authenticated request from paired daemon.")
+	return nil
+}
+
+// peerTokenMethodPrefix is the prefix of the gRPC methods that paired daemons
+// are allowed to call using their peer token.
+const peerTokenMethodPrefix = "/dinkurapi.v1.Sync/"
+
+// unauthenticatedMethods are the gRPC methods that daemons on other computers
+// may call without a peer token, as they are used to obtain one.
+var unauthenticatedMethods = map[string]struct{}{
+	"/dinkurapi.v1.Pairing/ExchangePairingKey": {},
+	"/dinkurapi.v1.Pairing/CompletePairing":    {},
+}
+
+func (d *daemon) unaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := unauthenticatedMethods[info.FullMethod]; !ok {
+		if err := d.authenticatePeer(ctx, info.FullMethod); err != nil {
+			return nil, convError(err)
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (d *daemon) streamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := unauthenticatedMethods[info.FullMethod]; !ok {
+		if err := d.authenticatePeer(ss.Context(), info.FullMethod); err != nil {
+			return convError(err)
+		}
+	}
+	return handler(srv, ss)
+}
+
+// assertLocalRequest returns an error unless the request comes from a
+// loopback address, i.e from the same computer as the daemon.
+func assertLocalRequest(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ErrNotLocalRequest
+	}
+	addr, ok := p.Addr.(*net.TCPAddr)
+	if !ok || !addr.IP.IsLoopback() {
+		return ErrNotLocalRequest
+	}
+	return nil
+}
+
+// remoteHost returns the IP address of the client making the request.
+func remoteHost(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("%w: unknown remote address", ErrPairingRequestInvalid)
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrPairingRequestInvalid, err)
+	}
+	return host, nil
+}
+
+func dinkurPeer(p pairing.Peer) dinkur.Peer {
+	return dinkur.Peer{
+		Name:           p.Name,
+		Address:        p.Address,
+		TLSFingerprint: p.TLSFingerprint,
+		Paired:         p.Paired,
+	}
+}
@@ -0,0 +1,331 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/notify"
+	"gopkg.in/typ.v4/chans"
+)
+
+// pomodoroTimer is the Pomodoro timer of a profile. The timer does not
+// persist between daemon restarts, but the entries it creates and the cycles
+// it completes are stored using the profile's client.
+type pomodoroTimer struct {
+	mutex sync.Mutex
+	// state is nil if the timer is not running
+	state *dinkur.Pomodoro
+	// entryID is the ID of the entry started by the timer for the current
+	// phase, or zero if the timer expects no entry to be active
+	entryID   uint
+	entryUUID string
+	timer     *time.Timer
+	obs       chans.PubSub[dinkur.StreamedPomodoro]
+}
+
+func newPomodoroTimer() *pomodoroTimer {
+	return &pomodoroTimer{
+		obs: chans.PubSub[dinkur.StreamedPomodoro]{
+			PubTimeoutAfter: 10 * time.Second,
+			OnPubTimeout: func(ev dinkur.StreamedPomodoro) {
+				log.Warn().WithStringer("event", ev.Event).
+					Message("Timed out sending Pomodoro event.")
+			},
+		},
+	}
+}
+
+func (t *pomodoroTimer) get() *dinkur.Pomodoro {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.state == nil {
+		return nil
+	}
+	state := *t.state
+	return &state
+}
+
+// ownsEntry returns true if the active entry is the one the timer expects,
+// meaning the user has not started nor stopped any entry on their own.
+func (t *pomodoroTimer) ownsEntry(active *dinkur.Entry) bool {
+	if active == nil {
+		return t.entryID == 0
+	}
+	return active.ID == t.entryID
+}
+
+// stopLocked stops the timer and publishes the stopped event. The mutex must
+// be held by the caller.
+func (t *pomodoroTimer) stopLocked() *dinkur.Pomodoro {
+	if t.state == nil {
+		return nil
+	}
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	state := *t.state
+	t.state = nil
+	t.entryID = 0
+	t.entryUUID = ""
+	t.obs.Pub(dinkur.StreamedPomodoro{Pomodoro: state, Event: dinkur.PomodoroEventStopped})
+	return &state
+}
+
+func (d *daemon) startPomodoro(ctx context.Context, p *profile, start dinkur.StartPomodoro) (dinkur.Pomodoro, error) {
+	if start.EntryName == "" {
+		return dinkur.Pomodoro{}, dinkur.ErrEntryNameEmpty
+	}
+	settings := start.Settings.WithDefaults()
+	if settings.Work < 0 || settings.Break < 0 || settings.LongBreak < 0 {
+		return dinkur.Pomodoro{}, fmt.Errorf("%w: durations cannot be negative", dinkur.ErrPomodoroSettingsInvalid)
+	}
+	t := p.pomodoro
+	t.mutex.Lock()
+	t.stopLocked()
+	now := time.Now()
+	startedEntry, err := p.client.CreateEntry(ctx, dinkur.NewEntry{
+		Name:  start.EntryName,
+		Start: &now,
+	})
+	if err != nil {
+		t.mutex.Unlock()
+		return dinkur.Pomodoro{}, err
+	}
+	t.state = &dinkur.Pomodoro{
+		EntryName:  start.EntryName,
+		Settings:   settings,
+		Phase:      dinkur.PomodoroPhaseWork,
+		PhaseStart: now,
+		PhaseEnd:   now.Add(settings.Work),
+	}
+	t.entryID = startedEntry.Started.ID
+	t.entryUUID = startedEntry.Started.UUID
+	d.schedulePomodoroLocked(p)
+	state := *t.state
+	t.obs.Pub(dinkur.StreamedPomodoro{Pomodoro: state, Event: dinkur.PomodoroEventStarted})
+	t.mutex.Unlock()
+	log.Info().WithString("profile", p.name).WithString("entry", state.EntryName).
+		WithDuration("work", settings.Work).
+		Message("Started Pomodoro timer.")
+	d.onEntryMutation(ctx, p)
+	return state, nil
+}
+
+// stopPomodoro stops the timer, together with the active entry if it was
+// started by the timer.
+func (d *daemon) stopPomodoro(ctx context.Context, p *profile) (*dinkur.Pomodoro, error) {
+	t := p.pomodoro
+	t.mutex.Lock()
+	if t.state == nil {
+		t.mutex.Unlock()
+		return nil, nil
+	}
+	var stoppedEntry bool
+	active, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		t.mutex.Unlock()
+		return nil, err
+	}
+	if active != nil && t.ownsEntry(active) {
+		if _, err := p.client.StopActiveEntry(ctx, time.Now()); err != nil {
+			t.mutex.Unlock()
+			return nil, err
+		}
+		stoppedEntry = true
+	}
+	state := t.stopLocked()
+	t.mutex.Unlock()
+	log.Info().WithString("profile", p.name).
+		WithUint("completedCycles", state.CompletedCycles).
+		Message("Stopped Pomodoro timer.")
+	if stoppedEntry {
+		d.onEntryMutation(ctx, p)
+	}
+	return state, nil
+}
+
+// stopPomodoros stops all timers without touching any entries, such as when
+// the daemon is closing.
+func (d *daemon) stopPomodoros() {
+	for _, p := range d.uniqueProfiles {
+		p.pomodoro.mutex.Lock()
+		p.pomodoro.stopLocked()
+		p.pomodoro.mutex.Unlock()
+	}
+}
+
+// checkPomodoroEntry stops the timer if the user has changed the active entry,
+// as the user has then taken over from the timer.
+func (d *daemon) checkPomodoroEntry(ctx context.Context, p *profile) {
+	t := p.pomodoro
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.state == nil {
+		return
+	}
+	active, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get active entry when checking Pomodoro timer.")
+		return
+	}
+	if !t.ownsEntry(active) {
+		t.stopLocked()
+		log.Info().WithString("profile", p.name).
+			Message("Stopped Pomodoro timer, as the active entry was changed.")
+	}
+}
+
+// schedulePomodoroLocked starts the time.Timer for the end of the current
+// phase. The mutex must be held by the caller.
+func (d *daemon) schedulePomodoroLocked(p *profile) {
+	t := p.pomodoro
+	state := t.state
+	t.timer = time.AfterFunc(time.Until(state.PhaseEnd), func() {
+		d.advancePomodoro(p, state)
+	})
+}
+
+// advancePomodoro moves the timer to the next phase, where the state parameter
+// is used to ignore timers that were stopped or restarted after they fired.
+func (d *daemon) advancePomodoro(p *profile, state *dinkur.Pomodoro) {
+	t := p.pomodoro
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.state != state {
+		return
+	}
+	// must use new context as the timer outlives the request that started it
+	ctx := context.Background()
+	active, err := p.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to get active entry. Stopping Pomodoro timer.")
+		t.stopLocked()
+		return
+	}
+	if !t.ownsEntry(active) {
+		t.stopLocked()
+		log.Info().WithString("profile", p.name).
+			Message("Stopped Pomodoro timer, as the active entry was changed.")
+		return
+	}
+	now := time.Now()
+	next := *state
+	next.PhaseStart = now
+	var event dinkur.PomodoroEvent
+	var n notify.Notification
+	if state.Phase == dinkur.PomodoroPhaseWork {
+		d.completePomodoroCycle(ctx, p, state.PhaseStart, now)
+		next.CompletedCycles++
+		if next.CompletedCycles%next.Settings.LongBreakEvery == 0 {
+			next.Phase = dinkur.PomodoroPhaseLongBreak
+			next.PhaseEnd = now.Add(next.Settings.LongBreak)
+		} else {
+			next.Phase = dinkur.PomodoroPhaseBreak
+			next.PhaseEnd = now.Add(next.Settings.Break)
+		}
+		if err := d.startPomodoroBreakEntry(ctx, p, now); err != nil {
+			log.Warn().WithError(err).WithString("profile", p.name).
+				Message("Failed to start Pomodoro break. Stopping Pomodoro timer.")
+			t.stopLocked()
+			return
+		}
+		event = dinkur.PomodoroEventBreakStarted
+		n = notify.Notification{
+			Summary: fmt.Sprintf("Time for a %s", next.Phase),
+			Body: fmt.Sprintf("You have completed %d Pomodoro cycles. Next work period starts at %s.",
+				next.CompletedCycles, next.PhaseEnd.Format("15:04")),
+			Urgency: notify.UrgencyNormal,
+		}
+	} else {
+		startedEntry, err := p.client.CreateEntry(ctx, dinkur.NewEntry{
+			Name:  next.EntryName,
+			Start: &now,
+		})
+		if err != nil {
+			log.Warn().WithError(err).WithString("profile", p.name).
+				Message("Failed to start Pomodoro work entry. Stopping Pomodoro timer.")
+			t.stopLocked()
+			return
+		}
+		t.entryID = startedEntry.Started.ID
+		t.entryUUID = startedEntry.Started.UUID
+		next.Phase = dinkur.PomodoroPhaseWork
+		next.PhaseEnd = now.Add(next.Settings.Work)
+		event = dinkur.PomodoroEventWorkStarted
+		n = notify.Notification{
+			Summary: "Back to work",
+			Body: fmt.Sprintf("Started tracking %q until %s.",
+				next.EntryName, next.PhaseEnd.Format("15:04")),
+			Urgency: notify.UrgencyNormal,
+		}
+	}
+	t.state = &next
+	d.schedulePomodoroLocked(p)
+	t.obs.Pub(dinkur.StreamedPomodoro{Pomodoro: next, Event: event})
+	d.notify(p, "pomodoro", n)
+	log.Info().WithString("profile", p.name).
+		WithStringer("phase", next.Phase).
+		WithUint("completedCycles", next.CompletedCycles).
+		Message("Pomodoro timer changed phase.")
+}
+
+func (d *daemon) completePomodoroCycle(ctx context.Context, p *profile, start, end time.Time) {
+	if _, err := p.client.CreatePomodoroCycle(ctx, dinkur.NewPomodoroCycle{
+		EntryUUID: p.pomodoro.entryUUID,
+		Start:     start,
+		End:       end,
+	}); err != nil {
+		log.Warn().WithError(err).WithString("profile", p.name).
+			Message("Failed to store completed Pomodoro cycle.")
+	}
+}
+
+// startPomodoroBreakEntry starts the break entry, or stops the active entry if
+// the timer has no break entry name. The mutex must be held by the caller.
+func (d *daemon) startPomodoroBreakEntry(ctx context.Context, p *profile, now time.Time) error {
+	t := p.pomodoro
+	if t.state.Settings.BreakEntryName == "" {
+		if _, err := p.client.StopActiveEntry(ctx, now); err != nil {
+			return err
+		}
+		t.entryID = 0
+		t.entryUUID = ""
+		return nil
+	}
+	startedEntry, err := p.client.CreateEntry(ctx, dinkur.NewEntry{
+		Name:  t.state.Settings.BreakEntryName,
+		Start: &now,
+	})
+	if err != nil {
+		return err
+	}
+	t.entryID = startedEntry.Started.ID
+	t.entryUUID = startedEntry.Started.UUID
+	return nil
+}
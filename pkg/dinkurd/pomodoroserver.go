@@ -0,0 +1,184 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (d *daemon) StartPomodoro(ctx context.Context, req *dinkurapiv1.StartPomodoroRequest) (*dinkurapiv1.StartPomodoroResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	settings, err := fromgrpc.PomodoroSettings(req.Settings)
+	if err != nil {
+		return nil, convError(err)
+	}
+	pomodoro, err := d.startPomodoro(ctx, p, dinkur.StartPomodoro{
+		EntryName: req.EntryName,
+		Settings:  settings,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.StartPomodoroResponse{
+		Pomodoro: togrpc.PomodoroPtr(&pomodoro),
+	}, nil
+}
+
+func (d *daemon) StopPomodoro(ctx context.Context, req *dinkurapiv1.StopPomodoroRequest) (*dinkurapiv1.StopPomodoroResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	pomodoro, err := d.stopPomodoro(ctx, p)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.StopPomodoroResponse{
+		Pomodoro: togrpc.PomodoroPtr(pomodoro),
+	}, nil
+}
+
+func (d *daemon) GetPomodoro(ctx context.Context, req *dinkurapiv1.GetPomodoroRequest) (*dinkurapiv1.GetPomodoroResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetPomodoroResponse{
+		Pomodoro: togrpc.PomodoroPtr(p.pomodoro.get()),
+	}, nil
+}
+
+func (d *daemon) StreamPomodoro(req *dinkurapiv1.StreamPomodoroRequest, stream dinkurapiv1.Pomodoro_StreamPomodoroServer) error {
+	if err := d.assertConnected(); err != nil {
+		return convError(err)
+	}
+	if req == nil {
+		return convError(ErrRequestIsNil)
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return convError(err)
+	}
+	ch := p.pomodoro.obs.Sub()
+	defer func() {
+		if err := p.pomodoro.obs.Unsub(ch); err != nil {
+			log.Warn().WithError(err).Message("Failed to unsub Pomodoro events.")
+		}
+	}()
+	done := ctx.Done()
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&dinkurapiv1.StreamPomodoroResponse{
+				Pomodoro: togrpc.PomodoroPtr(&ev.Pomodoro),
+				Event:    togrpc.PomodoroEvent(ev.Event),
+			}); err != nil {
+				return convError(err)
+			}
+		case <-done:
+			return nil
+		}
+	}
+}
+
+func (d *daemon) CreatePomodoroCycle(ctx context.Context, req *dinkurapiv1.CreatePomodoroCycleRequest) (*dinkurapiv1.CreatePomodoroCycleResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	cycle, err := p.client.CreatePomodoroCycle(ctx, dinkur.NewPomodoroCycle{
+		EntryUUID: req.EntryUuid,
+		Start:     fromgrpc.TimeOrZero(req.Start),
+		End:       fromgrpc.TimeOrZero(req.End),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.CreatePomodoroCycleResponse{
+		Cycle: togrpc.PomodoroCyclePtr(&cycle),
+	}, nil
+}
+
+func (d *daemon) GetPomodoroCycleList(ctx context.Context, req *dinkurapiv1.GetPomodoroCycleListRequest) (*dinkurapiv1.GetPomodoroCycleListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	search := dinkur.SearchPomodoroCycle{
+		Start: fromgrpc.TimePtr(req.Start),
+		End:   fromgrpc.TimePtr(req.End),
+	}
+	search.Limit, err = conv.Uint64ToUint(req.Limit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	cycles, err := p.client.GetPomodoroCycleList(ctx, search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetPomodoroCycleListResponse{
+		Cycles: togrpc.PomodoroCycleSlice(cycles),
+	}, nil
+}
@@ -24,6 +24,8 @@ import (
 	"time"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/afkdetect"
+	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/dinkur/dinkur/pkg/fromgrpc"
 	"github.com/dinkur/dinkur/pkg/togrpc"
@@ -39,7 +41,11 @@ func (d *daemon) StreamStatus(req *dinkurapiv1.StreamStatusRequest, stream dinku
 	}
 	ctx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
-	ch, err := d.client.StreamStatus(ctx)
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return convError(err)
+	}
+	ch, err := p.client.StreamStatus(ctx)
 	if err != nil {
 		return convError(err)
 	}
@@ -60,13 +66,25 @@ func (d *daemon) SetStatus(ctx context.Context, req *dinkurapiv1.SetStatusReques
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	err := d.client.SetStatus(ctx, dinkur.EditStatus{
-		AFKSince:  fromgrpc.TimePtr(req.AfkSince),
-		BackSince: fromgrpc.TimePtr(req.BackSince),
-	})
+	p, err := d.profileFromContext(ctx)
 	if err != nil {
 		return nil, convError(err)
 	}
+	edit := dinkur.EditStatus{
+		AFKSince:      fromgrpc.TimePtr(req.AfkSince),
+		BackSince:     fromgrpc.TimePtr(req.BackSince),
+		AFKHook:       req.AfkHook,
+		AFKResolution: fromgrpc.AFKResolution(req.AfkResolution),
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.client.SetStatus(ctx, edit); err != nil {
+		return nil, convError(err)
+	}
+	// Keep track of the status set by the clients, such as when they resolve
+	// the AFK status, so the daemon does not later act on an outdated status.
+	edit.AFKResolution = dinkur.AFKResolutionNone
+	p.lastStatus = edit
 	return &dinkurapiv1.SetStatusResponse{}, nil
 }
 
@@ -77,7 +95,11 @@ func (d *daemon) GetStatus(ctx context.Context, req *dinkurapiv1.GetStatusReques
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	status, err := d.client.GetStatus(ctx)
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	status, err := p.client.GetStatus(ctx)
 	if err != nil {
 		return nil, convError(err)
 	}
@@ -86,8 +108,191 @@ func (d *daemon) GetStatus(ctx context.Context, req *dinkurapiv1.GetStatusReques
 	}, nil
 }
 
-func (d *daemon) markAsNotAFK(ctx context.Context) {
-	lastStatus := d.lastStatus
+func (d *daemon) SetHeartbeat(ctx context.Context, req *dinkurapiv1.SetHeartbeatRequest) (*dinkurapiv1.SetHeartbeatResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	if err := p.client.SetHeartbeat(ctx, fromgrpc.TimeOrNow(req.Heartbeat)); err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.SetHeartbeatResponse{}, nil
+}
+
+func (d *daemon) GetAfkPeriodList(ctx context.Context, req *dinkurapiv1.GetAfkPeriodListRequest) (*dinkurapiv1.GetAfkPeriodListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	search := dinkur.SearchAFKPeriod{
+		Start:     fromgrpc.TimePtr(req.Start),
+		End:       fromgrpc.TimePtr(req.End),
+		Shorthand: fromgrpc.Shorthand(req.Shorthand),
+	}
+	search.Limit, err = conv.Uint64ToUint(req.Limit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	search.ShorthandCount, err = conv.Uint64ToUint(req.ShorthandCount)
+	if err != nil {
+		return nil, convError(err)
+	}
+	periods, err := p.client.GetAFKPeriodList(ctx, search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetAfkPeriodListResponse{
+		AfkPeriods: togrpc.AFKPeriodSlice(periods),
+	}, nil
+}
+
+func (d *daemon) ResolveAfk(ctx context.Context, req *dinkurapiv1.ResolveAfkRequest) (*dinkurapiv1.ResolveAfkResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	resolve := dinkur.ResolveAFK{
+		Resolution: fromgrpc.AFKResolution(req.Resolution),
+		NewEntries: make([]dinkur.NewEntry, 0, len(req.NewEntries)),
+	}
+	for _, entry := range req.NewEntries {
+		if entry == nil {
+			continue
+		}
+		resolve.NewEntries = append(resolve.NewEntries, dinkur.NewEntry{
+			Name:  entry.Name,
+			Start: fromgrpc.TimePtr(entry.Start),
+			End:   fromgrpc.TimePtr(entry.End),
+		})
+	}
+	p.mutex.Lock()
+	resolved, err := p.client.ResolveAFK(ctx, resolve)
+	if err != nil {
+		p.mutex.Unlock()
+		return nil, convError(err)
+	}
+	p.lastStatus = dinkur.EditStatus{}
+	p.mutex.Unlock()
+	res := &dinkurapiv1.ResolveAfkResponse{
+		Created: togrpc.EntrySlice(resolved.Created),
+	}
+	if resolved.Edited != nil {
+		res.EditedBefore = togrpc.EntryPtr(&resolved.Edited.Before)
+		res.EditedAfter = togrpc.EntryPtr(&resolved.Edited.After)
+	}
+	return res, nil
+}
+
+func (d *daemon) SetNotTracking(ctx context.Context, req *dinkurapiv1.SetNotTrackingRequest) (*dinkurapiv1.SetNotTrackingResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	edit := dinkur.EditNotTracking{
+		Since:              fromgrpc.TimePtr(req.NotTrackingSince),
+		SuggestedEntryName: req.SuggestedEntryName,
+	}
+	if err := p.client.SetNotTracking(ctx, edit); err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.SetNotTrackingResponse{}, nil
+}
+
+func (d *daemon) GetAfkSettings(ctx context.Context, req *dinkurapiv1.GetAfkSettingsRequest) (*dinkurapiv1.GetAfkSettingsResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	return &dinkurapiv1.GetAfkSettingsResponse{
+		Settings: togrpc.AFKSettings(afkSettingsFromOptions(d.afkDetector.Options())),
+	}, nil
+}
+
+func (d *daemon) UpdateAfkSettings(ctx context.Context, req *dinkurapiv1.UpdateAfkSettingsRequest) (*dinkurapiv1.UpdateAfkSettingsResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	opt, err := d.updateAFKOptions(fromgrpc.EditAFKSettings(req))
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.UpdateAfkSettingsResponse{
+		Settings: togrpc.AFKSettings(afkSettingsFromOptions(opt)),
+	}, nil
+}
+
+// updateAFKOptions merges the edit into the AFK-detector's current options.
+// The afkOptionsMutex is held so concurrent edits are not lost.
+func (d *daemon) updateAFKOptions(edit dinkur.EditAFKSettings) (afkdetect.Options, error) {
+	d.afkOptionsMutex.Lock()
+	defer d.afkOptionsMutex.Unlock()
+	opt := d.afkDetector.Options()
+	if edit.Threshold != nil {
+		opt.Threshold = *edit.Threshold
+	}
+	if edit.PollInterval != nil {
+		opt.PollInterval = *edit.PollInterval
+	}
+	if len(edit.AllowHooks) > 0 || edit.ClearAllowHooks {
+		opt.AllowHooks = edit.AllowHooks
+	}
+	if len(edit.DenyHooks) > 0 || edit.ClearDenyHooks {
+		opt.DenyHooks = edit.DenyHooks
+	}
+	if err := d.afkDetector.SetOptions(opt); err != nil {
+		return afkdetect.Options{}, err
+	}
+	return d.afkDetector.Options(), nil
+}
+
+func afkSettingsFromOptions(opt afkdetect.Options) dinkur.AFKSettings {
+	return dinkur.AFKSettings{
+		Threshold:      opt.Threshold,
+		PollInterval:   opt.PollInterval,
+		AllowHooks:     opt.AllowHooks,
+		DenyHooks:      opt.DenyHooks,
+		AvailableHooks: afkdetect.HookNames(),
+	}
+}
+
+func (d *daemon) markAsNotAFK(ctx context.Context, p *profile) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	d.markAsNotAFKLocked(ctx, p)
+}
+
+// markAsNotAFKLocked clears the AFK status. The profile's mutex must be held.
+func (d *daemon) markAsNotAFKLocked(ctx context.Context, p *profile) {
+	lastStatus := p.lastStatus
 	if lastStatus.AFKSince == nil && lastStatus.BackSince == nil {
 		return
 	}
@@ -95,28 +300,44 @@ func (d *daemon) markAsNotAFK(ctx context.Context) {
 		AFKSince:  nil,
 		BackSince: nil,
 	}
-	d.client.SetStatus(ctx, newStatus)
-	d.lastStatus = newStatus
+	p.client.SetStatus(ctx, newStatus)
+	p.lastStatus = newStatus
 }
 
-func (d *daemon) markAsReturnedFromAFK(ctx context.Context) {
-	lastStatus := d.lastStatus
+func (d *daemon) markAsReturnedFromAFK(ctx context.Context, p *profile) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	d.markAsReturnedFromAFKLocked(ctx, p)
+}
+
+// markAsReturnedFromAFKLocked sets the AFK status as returned, and resolves
+// it using the AFK rules. The profile's mutex must be held.
+func (d *daemon) markAsReturnedFromAFKLocked(ctx context.Context, p *profile) {
+	lastStatus := p.lastStatus
 	if lastStatus.AFKSince != nil && lastStatus.BackSince != nil {
 		return
 	}
 	newStatus := dinkur.EditStatus{
 		AFKSince:  lastStatus.AFKSince,
 		BackSince: typ.Ref(time.Now()),
+		AFKHook:   lastStatus.AFKHook,
 	}
 	if newStatus.AFKSince == nil {
 		newStatus.AFKSince = typ.Ref(time.Now())
 	}
-	d.client.SetStatus(ctx, newStatus)
-	d.lastStatus = newStatus
+	p.client.SetStatus(ctx, newStatus)
+	p.lastStatus = newStatus
+	d.resolveAFKByRulesLocked(ctx, p, *newStatus.AFKSince, *newStatus.BackSince)
+	if lastStatus.AFKSince != nil && p.lastStatus.AFKSince != nil && p.lastStatus.BackSince != nil {
+		// left for the user to resolve
+		d.notifyReturnedFromAFK(ctx, p, *newStatus.AFKSince, *newStatus.BackSince)
+	}
 }
 
-func (d *daemon) markAsAFK(ctx context.Context) {
-	lastStatus := d.lastStatus
+func (d *daemon) markAsAFK(ctx context.Context, p *profile, afkSince time.Time, hook string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	lastStatus := p.lastStatus
 	if lastStatus.AFKSince != nil && lastStatus.BackSince == nil {
 		return
 	}
@@ -125,8 +346,9 @@ func (d *daemon) markAsAFK(ctx context.Context) {
 		BackSince: nil,
 	}
 	if newStatus.AFKSince == nil {
-		newStatus.AFKSince = typ.Ref(time.Now())
+		newStatus.AFKSince = &afkSince
+		newStatus.AFKHook = hook
 	}
-	d.client.SetStatus(ctx, newStatus)
-	d.lastStatus = newStatus
+	p.client.SetStatus(ctx, newStatus)
+	p.lastStatus = newStatus
 }
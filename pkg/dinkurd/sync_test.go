@@ -0,0 +1,250 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build fts5
+
+package dinkurd_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/dinkurclient"
+	"github.com/dinkur/dinkur/pkg/dinkurd"
+	"github.com/dinkur/dinkur/pkg/dinkurdb"
+)
+
+// startTestDaemon starts a daemon on a random loopback port, using a new
+// database file, and returns a gRPC client connected to it together with the
+// daemon's database client.
+func startTestDaemon(t *testing.T) (grpcClient, dbClient dinkur.Client) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	dbClient = dinkurdb.NewClient(filepath.Join(t.TempDir(), "dinkur.db"), dinkurdb.Options{})
+	if err := dbClient.Connect(ctx); err != nil {
+		cancel()
+		t.Fatalf("connect to database: %s", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		cancel()
+		dbClient.Close()
+		t.Fatalf("listen: %s", err)
+	}
+	d := dinkurd.NewDaemon(dbClient, dinkurd.Options{Listener: lis})
+	served := make(chan error, 1)
+	go func() {
+		served <- d.Serve(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-served; err != nil {
+			t.Errorf("serve daemon: %s", err)
+		}
+		dbClient.Close()
+	})
+	grpcClient = dinkurclient.NewClient(lis.Addr().String(), dinkurclient.Options{})
+	if err := grpcClient.Connect(ctx); err != nil {
+		t.Fatalf("connect to daemon: %s", err)
+	}
+	t.Cleanup(func() { grpcClient.Close() })
+	return grpcClient, dbClient
+}
+
+func createTestEntry(t *testing.T, c dinkur.Client, name string, start, end time.Time) dinkur.Entry {
+	t.Helper()
+	started, err := c.CreateEntry(context.Background(), dinkur.NewEntry{
+		Name:  name,
+		Start: &start,
+		End:   &end,
+	})
+	if err != nil {
+		t.Fatalf("create entry %q: %s", name, err)
+	}
+	return started.Started
+}
+
+func findTestEntry(t *testing.T, c dinkur.Client, uuid string) dinkur.Entry {
+	t.Helper()
+	entries, err := c.GetEntryList(context.Background(), dinkur.SearchEntry{})
+	if err != nil {
+		t.Fatalf("list entries: %s", err)
+	}
+	for _, entry := range entries {
+		if entry.UUID == uuid {
+			return entry
+		}
+	}
+	t.Fatalf("entry %s not found", uuid)
+	return dinkur.Entry{}
+}
+
+func syncTestClients(t *testing.T, local, remote dinkur.Client) {
+	t.Helper()
+	if _, err := dinkur.SyncClients(context.Background(), local, remote); err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+}
+
+type syncedTestEntry struct {
+	uuid  string
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+func listSyncedTestEntries(t *testing.T, c dinkur.Client) []syncedTestEntry {
+	t.Helper()
+	entries, err := c.GetEntryList(context.Background(), dinkur.SearchEntry{})
+	if err != nil {
+		t.Fatalf("list entries: %s", err)
+	}
+	synced := make([]syncedTestEntry, len(entries))
+	for i, entry := range entries {
+		synced[i] = syncedTestEntry{uuid: entry.UUID, name: entry.Name, start: entry.Start.UTC()}
+		if entry.End != nil {
+			synced[i].end = entry.End.UTC()
+		}
+	}
+	sort.Slice(synced, func(i, j int) bool { return synced[i].uuid < synced[j].uuid })
+	return synced
+}
+
+func assertTestEntriesConverged(t *testing.T, a, b dinkur.Client) {
+	t.Helper()
+	entriesA := listSyncedTestEntries(t, a)
+	entriesB := listSyncedTestEntries(t, b)
+	if len(entriesA) != len(entriesB) {
+		t.Fatalf("want same number of entries, got %d and %d", len(entriesA), len(entriesB))
+	}
+	for i := range entriesA {
+		ea, eb := entriesA[i], entriesB[i]
+		if ea.uuid != eb.uuid || ea.name != eb.name || !ea.start.Equal(eb.start) || !ea.end.Equal(eb.end) {
+			t.Errorf("entries differ:\n  %+v\n  %+v", ea, eb)
+		}
+	}
+}
+
+func TestSyncClients(t *testing.T) {
+	a, _ := startTestDaemon(t)
+	b, _ := startTestDaemon(t)
+	ctx := context.Background()
+	start := time.Date(2022, 7, 1, 8, 0, 0, 0, time.UTC)
+
+	renamed := createTestEntry(t, a, "Code review", start, start.Add(time.Hour))
+	conflicting := createTestEntry(t, a, "Meeting", start.Add(time.Hour), start.Add(2*time.Hour))
+	deleted := createTestEntry(t, a, "Lunch", start.Add(2*time.Hour), start.Add(3*time.Hour))
+	syncTestClients(t, a, b)
+	assertTestEntriesConverged(t, a, b)
+
+	// concurrent edits to different fields of the same entry
+	newName := "Code review of sync"
+	if _, err := a.UpdateEntry(ctx, dinkur.EditEntry{IDOrZero: renamed.ID, Name: &newName}); err != nil {
+		t.Fatalf("rename entry in A: %s", err)
+	}
+	newEnd := start.Add(90 * time.Minute)
+	if _, err := b.UpdateEntry(ctx, dinkur.EditEntry{IDOrZero: findTestEntry(t, b, renamed.UUID).ID, End: &newEnd}); err != nil {
+		t.Fatalf("update entry end in B: %s", err)
+	}
+
+	// concurrent edits to the same field, where the last write wins
+	nameA, nameB := "Meeting with A", "Meeting with B"
+	if _, err := a.UpdateEntry(ctx, dinkur.EditEntry{IDOrZero: conflicting.ID, Name: &nameA}); err != nil {
+		t.Fatalf("rename entry in A: %s", err)
+	}
+	time.Sleep(time.Millisecond)
+	if _, err := b.UpdateEntry(ctx, dinkur.EditEntry{IDOrZero: findTestEntry(t, b, conflicting.UUID).ID, Name: &nameB}); err != nil {
+		t.Fatalf("rename entry in B: %s", err)
+	}
+
+	// concurrent edit and deletion, where the edited entry is kept
+	if _, err := a.DeleteEntry(ctx, deleted.ID); err != nil {
+		t.Fatalf("delete entry in A: %s", err)
+	}
+	lunchName := "Long lunch"
+	if _, err := b.UpdateEntry(ctx, dinkur.EditEntry{IDOrZero: findTestEntry(t, b, deleted.UUID).ID, Name: &lunchName}); err != nil {
+		t.Fatalf("rename entry in B: %s", err)
+	}
+
+	syncTestClients(t, a, b)
+	syncTestClients(t, b, a)
+	assertTestEntriesConverged(t, a, b)
+
+	if got := findTestEntry(t, a, renamed.UUID); got.Name != newName || got.End == nil || !got.End.Equal(newEnd) {
+		t.Errorf("want both concurrent edits to different fields kept, got name %q and end %v", got.Name, got.End)
+	}
+	if got := findTestEntry(t, a, conflicting.UUID); got.Name != nameB {
+		t.Errorf("want last written name %q, got %q", nameB, got.Name)
+	}
+	if got := findTestEntry(t, a, deleted.UUID); got.Name != lunchName {
+		t.Errorf("want entry edited concurrently with deletion kept as %q, got %q", lunchName, got.Name)
+	}
+
+	// deletions that observed all edits are synced
+	if _, err := b.DeleteEntry(ctx, findTestEntry(t, b, deleted.UUID).ID); err != nil {
+		t.Fatalf("delete entry in B: %s", err)
+	}
+	syncTestClients(t, b, a)
+	assertTestEntriesConverged(t, a, b)
+	for _, entry := range listSyncedTestEntries(t, a) {
+		if entry.uuid == deleted.UUID {
+			t.Error("want deleted entry to be removed from A")
+		}
+	}
+
+	// already synced
+	result, err := dinkur.SyncClients(ctx, a, b)
+	if err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+	if result.Pulled != 0 || result.Pushed != 0 {
+		t.Errorf("want no changes when already synced, got %+v", result)
+	}
+}
+
+func TestSyncClientsPaged(t *testing.T) {
+	a, aDB := startTestDaemon(t)
+	b, _ := startTestDaemon(t)
+	start := time.Date(2022, 7, 1, 8, 0, 0, 0, time.UTC)
+
+	// each entry adds changes for its name, start, and end, so this spans
+	// multiple pages
+	const entryCount = dinkur.SyncChangesPageSize/3 + 100
+	for i := 0; i < entryCount; i++ {
+		entryStart := start.Add(time.Duration(i) * time.Minute)
+		createTestEntry(t, aDB, "Entry", entryStart, entryStart.Add(time.Minute))
+	}
+	result, err := dinkur.SyncClients(context.Background(), b, a)
+	if err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+	if want := entryCount * 3; result.Pulled != want {
+		t.Errorf("want %d pulled changes, got %d", want, result.Pulled)
+	}
+	assertTestEntriesConverged(t, a, b)
+	if got := len(listSyncedTestEntries(t, b)); got != entryCount {
+		t.Errorf("want %d entries, got %d", entryCount, got)
+	}
+}
@@ -0,0 +1,100 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (d *daemon) GetSyncNode(ctx context.Context, req *dinkurapiv1.GetSyncNodeRequest) (*dinkurapiv1.GetSyncNodeResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	node, err := p.client.GetSyncNode(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetSyncNodeResponse{
+		NodeId: node.NodeID,
+		Clock:  node.Clock,
+	}, nil
+}
+
+func (d *daemon) GetSyncChanges(ctx context.Context, req *dinkurapiv1.GetSyncChangesRequest) (*dinkurapiv1.GetSyncChangesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	limit, err := conv.Uint64ToUint(req.Limit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	changes, err := p.client.GetSyncChanges(ctx, dinkur.SearchSyncChanges{
+		Since: dinkur.VectorClock(req.Since),
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetSyncChangesResponse{
+		Changes: togrpc.EntryChangeSlice(changes),
+	}, nil
+}
+
+func (d *daemon) ApplySyncChanges(ctx context.Context, req *dinkurapiv1.ApplySyncChangesRequest) (*dinkurapiv1.ApplySyncChangesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	p, err := d.profileFromContext(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	changes, err := fromgrpc.EntryChangeSlice(req.Changes)
+	if err != nil {
+		return nil, convError(err)
+	}
+	if err := p.client.ApplySyncChanges(ctx, changes); err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.ApplySyncChangesResponse{}, nil
+}
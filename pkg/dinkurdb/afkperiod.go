@@ -0,0 +1,154 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4"
+	"gopkg.in/typ.v4/slices"
+)
+
+var (
+	afkPeriodSQLBetweenStart = fmt.Sprintf(
+		"(%[2]s IS NULL OR %[2]s >= @start)",
+		dbmodel.AFKPeriodColumnStart, dbmodel.AFKPeriodColumnEnd,
+	)
+
+	afkPeriodSQLBetweenEnd = fmt.Sprintf(
+		"(%[1]s <= @end)",
+		dbmodel.AFKPeriodColumnStart, dbmodel.AFKPeriodColumnEnd,
+	)
+)
+
+func (c *client) GetAFKPeriodList(ctx context.Context, search dinkur.SearchAFKPeriod) ([]dinkur.AFKPeriod, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbPeriods, err := c.withContext(ctx).listDBAFKPeriods(search)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Map(dbPeriods, fromdb.AFKPeriod), nil
+}
+
+func (c *client) listDBAFKPeriods(search dinkur.SearchAFKPeriod) ([]dbmodel.AFKPeriod, error) {
+	span := c.calendar().Span(search.Shorthand, time.Now(), search.ShorthandCount)
+	if search.Start == nil {
+		search.Start = span.Start
+	}
+	if search.End == nil {
+		search.End = span.End
+	}
+	if search.Limit > math.MaxInt {
+		return nil, dinkur.ErrLimitTooLarge
+	}
+	var dbPeriods []dbmodel.AFKPeriod
+	q := c.db.Model(&dbmodel.AFKPeriod{}).
+		Order(dbmodel.AFKPeriodColumnStart + " DESC").
+		Limit(int(search.Limit))
+	// adding/subtracting 1s to resolve rounding issues, as Sqlite's
+	// smallest time unit is a second.
+	if search.Start != nil {
+		start := (*search.Start).UTC().Add(-time.Second)
+		q = q.Where(afkPeriodSQLBetweenStart, sql.Named("start", start))
+	}
+	if search.End != nil {
+		end := (*search.End).UTC().Add(time.Second)
+		q = q.Where(afkPeriodSQLBetweenEnd, sql.Named("end", end))
+	}
+	if err := q.Find(&dbPeriods).Error; err != nil {
+		return nil, err
+	}
+	// we sorted in descending order to get the last periods.
+	// fix this by reversing "again"
+	slices.Reverse(dbPeriods)
+	return dbPeriods, nil
+}
+
+func (c *client) unresolvedDBAFKPeriodNoTran() (*dbmodel.AFKPeriod, error) {
+	var dbPeriod dbmodel.AFKPeriod
+	err := c.db.
+		Where(dbmodel.AFKPeriodColumnResolution+" = ?", dbmodel.AFKResolutionNone).
+		Last(&dbPeriod).Error
+	if err != nil {
+		return nil, nilNotFoundError(err)
+	}
+	return &dbPeriod, nil
+}
+
+// updateAFKPeriodNoTran keeps the AFK period history in sync with the changes
+// made to the status, where the before and after are the status values before
+// and after the edit was applied.
+func (c *client) updateAFKPeriodNoTran(before, after dbmodel.Status, edit dinkur.EditStatus) error {
+	if before.AFKSince == nil && after.AFKSince == nil {
+		return nil
+	}
+	dbPeriod, err := c.unresolvedDBAFKPeriodNoTran()
+	if err != nil {
+		return fmt.Errorf("get unresolved AFK period: %w", err)
+	}
+	if after.AFKSince == nil {
+		if dbPeriod == nil {
+			return nil
+		}
+		if dbPeriod.End == nil {
+			dbPeriod.End = conv.TimePtrUTC(before.BackSince)
+		}
+		if dbPeriod.End == nil {
+			dbPeriod.End = typ.Ref(time.Now().UTC())
+		}
+		dbPeriod.Resolution = convAFKResolutionToDB(edit.AFKResolution)
+		return c.db.Save(dbPeriod).Error
+	}
+	if before.AFKSince == nil || dbPeriod == nil {
+		dbPeriod = &dbmodel.AFKPeriod{}
+	}
+	dbPeriod.Start = *after.AFKSince
+	dbPeriod.End = after.BackSince
+	if edit.AFKHook != "" {
+		dbPeriod.Hook = edit.AFKHook
+	}
+	return c.db.Save(dbPeriod).Error
+}
+
+func convAFKResolutionToDB(r dinkur.AFKResolution) dbmodel.AFKResolution {
+	switch r {
+	case dinkur.AFKResolutionKeep:
+		return dbmodel.AFKResolutionKeep
+	case dinkur.AFKResolutionDiscard:
+		return dbmodel.AFKResolutionDiscard
+	case dinkur.AFKResolutionNewEntry:
+		return dbmodel.AFKResolutionNewEntry
+	case dinkur.AFKResolutionSplit:
+		return dbmodel.AFKResolutionSplit
+	default:
+		return dbmodel.AFKResolutionDismissed
+	}
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/dinkur/dinkur/pkg/dbmodel"
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
 	"github.com/iver-wharf/wharf-core/v2/pkg/gormutil"
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"gopkg.in/typ.v4/chans"
@@ -61,6 +62,9 @@ type Options struct {
 	// DebugLogging enables logging of SQL queries and warnings issued by
 	// GORM.
 	DebugLogging bool
+	// Calendar is used when resolving time span shorthands, such as which
+	// weekday a week starts on. If nil, then timeutil.DefaultCalendar is used.
+	Calendar *timeutil.Calendar
 }
 
 // NewClient creates a new dinkur.Client-compatible client that uses an Sqlite3
@@ -149,6 +153,13 @@ func (c *client) Connect(ctx context.Context) error {
 	return nil
 }
 
+func (c *client) calendar() timeutil.Calendar {
+	if c.Calendar == nil {
+		return timeutil.DefaultCalendar
+	}
+	return *c.Calendar
+}
+
 func getLogger(opt Options) gormlogger.Interface {
 	if opt.DebugLogging {
 		return gormutil.DefaultLogger
@@ -113,7 +113,7 @@ func (c *client) listDBEntries(search dinkur.SearchEntry) ([]dbmodel.Entry, erro
 	if err := c.assertConnected(); err != nil {
 		return nil, err
 	}
-	span := search.Shorthand.Span(time.Now())
+	span := c.calendar().Span(search.Shorthand, time.Now(), search.ShorthandCount)
 	if search.Start == nil {
 		search.Start = span.Start
 	}
@@ -145,7 +145,8 @@ func (c *client) listDBEntries(search dinkur.SearchEntry) ([]dbmodel.Entry, erro
 		if search.NameHighlightStart != "" || search.NameHighlightEnd != "" {
 			q = q.Joins("INNER JOIN entries_idx ON entries.id = entries_idx.rowid").
 				Select(
-					"id, created_at, updated_at, highlight(entries_idx, 0, ?, ?) AS name, start, end",
+					"id, created_at, updated_at, uuid, highlight(entries_idx, 0, ?, ?) AS name, start, end, "+
+						dbmodel.EntryColumnContinuesUUID,
 					search.NameHighlightStart, search.NameHighlightEnd).
 				Where(dbmodel.EntryFTS5ColumnName+" MATCH ?", search.NameFuzzy)
 		} else {
@@ -264,6 +265,9 @@ func (c *client) editDBEntryNoTran(edit dinkur.EditEntry) (updatedDBEntry, error
 		if err := c.db.Save(&dbEntry).Error; err != nil {
 			return updatedDBEntry{}, fmt.Errorf("save updated entry: %w", err)
 		}
+		if err := c.recordDBEntryChangesNoTran(&entryBeforeEdit, dbEntry); err != nil {
+			return updatedDBEntry{}, err
+		}
 	}
 	return updatedDBEntry{
 		before: entryBeforeEdit,
@@ -364,35 +368,52 @@ func (c *client) DeleteEntry(ctx context.Context, id uint) (dinkur.Entry, error)
 	if err := c.assertConnected(); err != nil {
 		return dinkur.Entry{}, err
 	}
-	dbEntry, err := c.withContext(ctx).deleteDBEntry(id)
+	deleted, err := c.withContext(ctx).deleteDBEntry(id)
 	if err != nil {
 		return dinkur.Entry{}, err
 	}
 	c.entryObs.PubWait(entryEvent{
-		dbEntry: dbEntry,
+		dbEntry: deleted.entry,
 		event:   dinkur.EventDeleted,
 	})
-	return fromdb.Entry(dbEntry), err
+	if deleted.status != nil {
+		c.statusObs.PubWait(statusEvent{*deleted.status})
+	}
+	return fromdb.Entry(deleted.entry), err
 }
 
-func (c *client) deleteDBEntry(id uint) (dbmodel.Entry, error) {
-	var dbEntry dbmodel.Entry
+type deletedDBEntry struct {
+	entry dbmodel.Entry
+	// status is set if the paused entry was deleted, which clears it from
+	// the status
+	status *dbmodel.Status
+}
+
+func (c *client) deleteDBEntry(id uint) (deletedDBEntry, error) {
+	var deleted deletedDBEntry
 	err := c.transaction(func(tx *client) (tranErr error) {
-		dbEntry, tranErr = tx.deleteDBEntryNoTran(id)
+		deleted, tranErr = tx.deleteDBEntryNoTran(id)
 		return
 	})
-	return dbEntry, err
+	return deleted, err
 }
 
-func (c *client) deleteDBEntryNoTran(id uint) (dbmodel.Entry, error) {
+func (c *client) deleteDBEntryNoTran(id uint) (deletedDBEntry, error) {
 	dbEntry, err := c.getDBEntry(id)
 	if err != nil {
-		return dbmodel.Entry{}, fmt.Errorf("get entry to delete: %w", err)
+		return deletedDBEntry{}, fmt.Errorf("get entry to delete: %w", err)
 	}
 	if err := c.db.Delete(&dbmodel.Entry{}, id).Error; err != nil {
-		return dbmodel.Entry{}, fmt.Errorf("delete entry: %w", err)
+		return deletedDBEntry{}, fmt.Errorf("delete entry: %w", err)
 	}
-	return dbEntry, nil
+	if err := c.recordDBEntryDeletedNoTran(dbEntry); err != nil {
+		return deletedDBEntry{}, err
+	}
+	dbStatus, err := c.clearPausedDBEntryNoTran(id)
+	if err != nil {
+		return deletedDBEntry{}, err
+	}
+	return deletedDBEntry{entry: dbEntry, status: dbStatus}, nil
 }
 
 func (c *client) CreateEntry(ctx context.Context, entry dinkur.NewEntry) (dinkur.StartedEntry, error) {
@@ -481,10 +502,14 @@ func (c *client) startDBEntryNoTran(newEntry newEntry) (startedDBEntry, error) {
 	if err != nil {
 		return startedDBEntry{}, fmt.Errorf("stop previously active entry: %w", err)
 	}
+	newEntry.UUID = newULID()
 	err = c.db.Create(&newEntry.Entry).Error
 	if err != nil {
 		return startedDBEntry{}, fmt.Errorf("create new active entry: %w", err)
 	}
+	if err := c.recordDBEntryChangesNoTran(nil, newEntry.Entry); err != nil {
+		return startedDBEntry{}, err
+	}
 	return startedDBEntry{
 		stopped: previousDBEntry,
 		started: newEntry.Entry,
@@ -525,10 +550,12 @@ func (c *client) stopActiveDBEntryNoTran(endTime time.Time) (*dbmodel.Entry, err
 	if len(entries) == 0 {
 		return nil, nil
 	}
+	entriesBeforeStop := make([]dbmodel.Entry, len(entries))
 	for i, entry := range entries {
 		if endTime.Before(entry.Start) {
 			return nil, dinkur.ErrEntryEndBeforeStart
 		}
+		entriesBeforeStop[i] = entry
 		entries[i].End = &endTime
 	}
 	err := c.db.Model(&dbmodel.Entry{}).
@@ -538,9 +565,167 @@ func (c *client) stopActiveDBEntryNoTran(endTime time.Time) (*dbmodel.Entry, err
 	if err != nil {
 		return nil, err
 	}
+	for i, entry := range entries {
+		if err := c.recordDBEntryChangesNoTran(&entriesBeforeStop[i], entry); err != nil {
+			return nil, err
+		}
+	}
 	return &entries[0], nil
 }
 
+func (c *client) PauseActiveEntry(ctx context.Context, endTime time.Time) (*dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	paused, err := c.withContext(ctx).pauseActiveDBEntry(endTime)
+	if err != nil {
+		return nil, err
+	}
+	if paused.entry == nil {
+		return nil, nil
+	}
+	c.entryObs.PubWait(entryEvent{
+		dbEntry: *paused.entry,
+		event:   dinkur.EventUpdated,
+	})
+	c.statusObs.PubWait(statusEvent{paused.status})
+	return fromdb.EntryPtr(paused.entry), nil
+}
+
+type pausedDBEntry struct {
+	entry  *dbmodel.Entry
+	status dbmodel.Status
+}
+
+func (c *client) pauseActiveDBEntry(endTime time.Time) (pausedDBEntry, error) {
+	var paused pausedDBEntry
+	err := c.transaction(func(tx *client) (tranErr error) {
+		paused, tranErr = tx.pauseActiveDBEntryNoTran(endTime)
+		return
+	})
+	return paused, err
+}
+
+func (c *client) pauseActiveDBEntryNoTran(endTime time.Time) (pausedDBEntry, error) {
+	stoppedDBEntry, err := c.stopActiveDBEntryNoTran(endTime)
+	if err != nil {
+		return pausedDBEntry{}, err
+	}
+	if stoppedDBEntry == nil {
+		return pausedDBEntry{}, nil
+	}
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return pausedDBEntry{}, err
+	}
+	dbStatus.PausedEntryID = stoppedDBEntry.ID
+	if err := c.db.Save(&dbStatus).Error; err != nil {
+		return pausedDBEntry{}, fmt.Errorf("save paused entry in status: %w", err)
+	}
+	return pausedDBEntry{
+		entry:  stoppedDBEntry,
+		status: dbStatus,
+	}, nil
+}
+
+func (c *client) ResumeEntry(ctx context.Context, resume dinkur.ResumeEntry) (dinkur.StartedEntry, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.StartedEntry{}, err
+	}
+	resumed, err := c.withContext(ctx).resumeDBEntry(resume)
+	if err != nil {
+		return dinkur.StartedEntry{}, err
+	}
+	if resumed.stopped != nil {
+		c.entryObs.PubWait(entryEvent{
+			dbEntry: *resumed.stopped,
+			event:   dinkur.EventUpdated,
+		})
+	}
+	c.entryObs.PubWait(entryEvent{
+		dbEntry: resumed.started,
+		event:   dinkur.EventCreated,
+	})
+	if resumed.status != nil {
+		c.statusObs.PubWait(statusEvent{*resumed.status})
+	}
+	return dinkur.StartedEntry{
+		Started: fromdb.Entry(resumed.started),
+		Stopped: fromdb.EntryPtr(resumed.stopped),
+	}, nil
+}
+
+type resumedDBEntry struct {
+	startedDBEntry
+	// status is set if the paused entry was resumed, which clears it from
+	// the status
+	status *dbmodel.Status
+}
+
+func (c *client) resumeDBEntry(resume dinkur.ResumeEntry) (resumedDBEntry, error) {
+	var resumed resumedDBEntry
+	err := c.transaction(func(tx *client) (tranErr error) {
+		resumed, tranErr = tx.resumeDBEntryNoTran(resume)
+		return
+	})
+	return resumed, err
+}
+
+func (c *client) resumeDBEntryNoTran(resume dinkur.ResumeEntry) (resumedDBEntry, error) {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return resumedDBEntry{}, err
+	}
+	id := resume.IDOrZero
+	if id == 0 {
+		if dbStatus.PausedEntryID == 0 {
+			return resumedDBEntry{}, dinkur.ErrNoPausedEntry
+		}
+		id = dbStatus.PausedEntryID
+	}
+	prevDBEntry, err := c.getDBEntry(id)
+	if err != nil {
+		return resumedDBEntry{}, fmt.Errorf("get entry to resume: %w", err)
+	}
+	start := time.Now()
+	if resume.Start != nil {
+		start = *resume.Start
+	}
+	startedEntry, err := c.startDBEntryNoTran(newEntry{
+		Entry: dbmodel.Entry{
+			Name:          prevDBEntry.Name,
+			Start:         start.UTC(),
+			ContinuesUUID: prevDBEntry.UUID,
+		},
+	})
+	if err != nil {
+		return resumedDBEntry{}, err
+	}
+	clearedStatus, err := c.clearPausedDBEntryNoTran(id)
+	if err != nil {
+		return resumedDBEntry{}, err
+	}
+	return resumedDBEntry{startedDBEntry: startedEntry, status: clearedStatus}, nil
+}
+
+// clearPausedDBEntryNoTran clears the paused entry from the status if it is
+// the entry with the given ID, such as when it is resumed or deleted. Returns
+// the updated status, or nil if the status was left unchanged.
+func (c *client) clearPausedDBEntryNoTran(id uint) (*dbmodel.Status, error) {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return nil, err
+	}
+	if dbStatus.PausedEntryID != id {
+		return nil, nil
+	}
+	dbStatus.PausedEntryID = 0
+	if err := c.db.Save(&dbStatus).Error; err != nil {
+		return nil, fmt.Errorf("clear paused entry from status: %w", err)
+	}
+	return &dbStatus, nil
+}
+
 func (c *client) StreamEntry(ctx context.Context) (<-chan dinkur.StreamedEntry, error) {
 	if err := c.assertConnected(); err != nil {
 		return nil, err
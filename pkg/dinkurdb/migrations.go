@@ -122,6 +122,10 @@ func (c *client) migrateNoTran() error {
 		dbmodel.Migration{},
 		dbmodel.Entry{},
 		dbmodel.Status{},
+		dbmodel.AFKPeriod{},
+		dbmodel.EntryChange{},
+		dbmodel.SyncNode{},
+		dbmodel.PomodoroCycle{},
 		// Note: Do not add EntryFTS5 to auto migration! It is created separately
 		// through manual SQL queries down below.
 	}
@@ -161,6 +165,11 @@ INSERT INTO entries_idx (rowid, name) SELECT id, name FROM entries;
 			return err
 		}
 	}
+	if oldVersion < 11 {
+		if err := c.initSyncNoTran(); err != nil {
+			return err
+		}
+	}
 	var migration dbmodel.Migration
 	if err := c.db.FirstOrCreate(&migration).Error; err != nil &&
 		!errors.Is(err, gorm.ErrRecordNotFound) {
@@ -0,0 +1,102 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+)
+
+var (
+	pomodoroCycleSQLBetweenStart = fmt.Sprintf(
+		"(%s >= @start)",
+		dbmodel.PomodoroCycleColumnEnd,
+	)
+
+	pomodoroCycleSQLBetweenEnd = fmt.Sprintf(
+		"(%s <= @end)",
+		dbmodel.PomodoroCycleColumnStart,
+	)
+)
+
+func (c *client) CreatePomodoroCycle(ctx context.Context, cycle dinkur.NewPomodoroCycle) (dinkur.PomodoroCycle, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.PomodoroCycle{}, err
+	}
+	if cycle.End.Before(cycle.Start) {
+		return dinkur.PomodoroCycle{}, dinkur.ErrPomodoroCycleEndBeforeStart
+	}
+	dbCycle := dbmodel.PomodoroCycle{
+		EntryUUID: cycle.EntryUUID,
+		Start:     cycle.Start.UTC(),
+		End:       cycle.End.UTC(),
+	}
+	if err := c.withContext(ctx).db.Create(&dbCycle).Error; err != nil {
+		return dinkur.PomodoroCycle{}, err
+	}
+	return fromdb.PomodoroCycle(dbCycle), nil
+}
+
+func (c *client) GetPomodoroCycleList(ctx context.Context, search dinkur.SearchPomodoroCycle) ([]dinkur.PomodoroCycle, error) {
+	dbCycles, err := c.withContext(ctx).listDBPomodoroCycles(search)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Map(dbCycles, fromdb.PomodoroCycle), nil
+}
+
+func (c *client) listDBPomodoroCycles(search dinkur.SearchPomodoroCycle) ([]dbmodel.PomodoroCycle, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	if search.Limit > math.MaxInt {
+		return nil, dinkur.ErrLimitTooLarge
+	}
+	var dbCycles []dbmodel.PomodoroCycle
+	q := c.db.Model(&dbmodel.PomodoroCycle{}).
+		Order(dbmodel.PomodoroCycleColumnStart + " DESC").
+		Limit(int(search.Limit))
+	// adding/subtracting 1s to resolve rounding issues, as Sqlite's
+	// smallest time unit is a second.
+	if search.Start != nil {
+		start := (*search.Start).UTC().Add(-time.Second)
+		q = q.Where(pomodoroCycleSQLBetweenStart, sql.Named("start", start))
+	}
+	if search.End != nil {
+		end := (*search.End).UTC().Add(time.Second)
+		q = q.Where(pomodoroCycleSQLBetweenEnd, sql.Named("end", end))
+	}
+	if err := q.Find(&dbCycles).Error; err != nil {
+		return nil, err
+	}
+	// we sorted in descending order to get the last cycles.
+	// fix this by reversing "again"
+	slices.Reverse(dbCycles)
+	return dbCycles, nil
+}
@@ -22,8 +22,10 @@ package dinkurdb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dbmodel"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/dinkur/dinkur/pkg/fromdb"
@@ -99,6 +101,7 @@ func (c *client) setStatusNoTran(edit dinkur.EditStatus) error {
 	if err != nil {
 		return err
 	}
+	dbStatusBefore := dbStatus
 	var changed bool
 	if updateTimePtrUTC(&dbStatus.AFKSince, edit.AFKSince) {
 		changed = true
@@ -112,10 +115,81 @@ func (c *client) setStatusNoTran(edit dinkur.EditStatus) error {
 	if err := c.db.Save(&dbStatus).Error; err != nil {
 		return err
 	}
+	if err := c.updateAFKPeriodNoTran(dbStatusBefore, dbStatus, edit); err != nil {
+		return fmt.Errorf("update AFK period history: %w", err)
+	}
+	c.statusObs.PubWait(statusEvent{dbStatus})
+	return nil
+}
+
+func (c *client) SetNotTracking(ctx context.Context, edit dinkur.EditNotTracking) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	return c.withContext(ctx).setNotTracking(edit)
+}
+
+func (c *client) setNotTracking(edit dinkur.EditNotTracking) error {
+	return c.transaction(func(tx *client) error {
+		return tx.setNotTrackingNoTran(edit)
+	})
+}
+
+func (c *client) setNotTrackingNoTran(edit dinkur.EditNotTracking) error {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return err
+	}
+	suggestedName := edit.SuggestedEntryName
+	if edit.Since == nil {
+		suggestedName = ""
+	}
+	changed := updateTimePtrUTC(&dbStatus.NotTrackingSince, edit.Since)
+	if dbStatus.SuggestedEntryName != suggestedName {
+		dbStatus.SuggestedEntryName = suggestedName
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if err := c.db.Save(&dbStatus).Error; err != nil {
+		return err
+	}
 	c.statusObs.PubWait(statusEvent{dbStatus})
 	return nil
 }
 
+func (c *client) SetHeartbeat(ctx context.Context, heartbeat time.Time) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	return c.withContext(ctx).setHeartbeat(heartbeat)
+}
+
+func (c *client) setHeartbeat(heartbeat time.Time) error {
+	return c.transaction(func(tx *client) error {
+		return tx.setHeartbeatNoTran(heartbeat)
+	})
+}
+
+func (c *client) setHeartbeatNoTran(heartbeat time.Time) error {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return err
+	}
+	// Not publishing any status event here, as the heartbeat is of no
+	// interest to status subscribers and would only flood them.
+	if dbStatus.ID == 0 {
+		dbStatus.Heartbeat = typ.Ref(heartbeat.UTC())
+		return c.db.Create(&dbStatus).Error
+	}
+	// Only updating the heartbeat column, so the periodic heartbeats never
+	// overwrite any other status changes.
+	return c.db.Model(&dbmodel.Status{}).
+		Where(dbmodel.CommonFieldsColumnID+" = ?", dbStatus.ID).
+		UpdateColumn(dbmodel.StatusColumnHeartbeat, heartbeat.UTC()).Error
+}
+
 func updateTimePtrUTC(ptr **time.Time, newValue *time.Time) bool {
 	if (*ptr == nil) != (newValue == nil) || newValue != nil {
 		if newValue != nil {
@@ -127,3 +201,118 @@ func updateTimePtrUTC(ptr **time.Time, newValue *time.Time) bool {
 	}
 	return false
 }
+
+func (c *client) ResolveAFK(ctx context.Context, resolve dinkur.ResolveAFK) (dinkur.ResolvedAFK, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.ResolvedAFK{}, err
+	}
+	resolved, err := c.withContext(ctx).resolveAFK(resolve)
+	if err != nil {
+		return dinkur.ResolvedAFK{}, err
+	}
+	var res dinkur.ResolvedAFK
+	if resolved.edited != nil {
+		c.entryObs.PubWait(entryEvent{
+			dbEntry: resolved.edited.after,
+			event:   dinkur.EventUpdated,
+		})
+		res.Edited = &dinkur.UpdatedEntry{
+			Before: fromdb.Entry(resolved.edited.before),
+			After:  fromdb.Entry(resolved.edited.after),
+		}
+	}
+	for _, dbEntry := range resolved.created {
+		c.entryObs.PubWait(entryEvent{
+			dbEntry: dbEntry,
+			event:   dinkur.EventCreated,
+		})
+		res.Created = append(res.Created, fromdb.Entry(dbEntry))
+	}
+	return res, nil
+}
+
+type resolvedDBAFK struct {
+	edited  *updatedDBEntry
+	created []dbmodel.Entry
+}
+
+func (c *client) resolveAFK(resolve dinkur.ResolveAFK) (resolvedDBAFK, error) {
+	switch resolve.Resolution {
+	case dinkur.AFKResolutionKeep,
+		dinkur.AFKResolutionDiscard,
+		dinkur.AFKResolutionDismissed:
+	case dinkur.AFKResolutionNewEntry,
+		dinkur.AFKResolutionSplit:
+		if len(resolve.NewEntries) == 0 {
+			return resolvedDBAFK{}, dinkur.ErrEntryNameEmpty
+		}
+	default:
+		return resolvedDBAFK{}, dinkur.ErrAFKResolutionInvalid
+	}
+	var resolved resolvedDBAFK
+	err := c.transaction(func(tx *client) (tranErr error) {
+		resolved, tranErr = tx.resolveAFKNoTran(resolve)
+		return
+	})
+	return resolved, err
+}
+
+func (c *client) resolveAFKNoTran(resolve dinkur.ResolveAFK) (resolvedDBAFK, error) {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return resolvedDBAFK{}, err
+	}
+	if dbStatus.AFKSince == nil {
+		return resolvedDBAFK{}, dinkur.ErrNotAFK
+	}
+	afkSince := *dbStatus.AFKSince
+	var resolved resolvedDBAFK
+	if resolve.Resolution != dinkur.AFKResolutionKeep &&
+		resolve.Resolution != dinkur.AFKResolutionDismissed {
+		activeEntry, err := c.activeDBEntry()
+		if err != nil {
+			return resolvedDBAFK{}, fmt.Errorf("get active entry: %w", err)
+		}
+		if activeEntry != nil {
+			update, err := c.editDBEntryNoTran(dinkur.EditEntry{
+				IDOrZero: activeEntry.ID,
+				End:      &afkSince,
+			})
+			if err != nil {
+				return resolvedDBAFK{}, fmt.Errorf("end active entry: %w", err)
+			}
+			resolved.edited = &update
+		}
+	}
+	if resolve.Resolution == dinkur.AFKResolutionNewEntry ||
+		resolve.Resolution == dinkur.AFKResolutionSplit {
+		start := afkSince
+		for i, entry := range resolve.NewEntries {
+			if entry.Name == "" {
+				return resolvedDBAFK{}, dinkur.ErrEntryNameEmpty
+			}
+			if entry.Start != nil {
+				start = *entry.Start
+			}
+			if entry.End != nil && entry.End.Before(start) {
+				return resolvedDBAFK{}, dinkur.ErrEntryEndBeforeStart
+			}
+			started, err := c.startDBEntryNoTran(newEntry{
+				Entry: dbmodel.Entry{
+					Name:  entry.Name,
+					Start: start.UTC(),
+					End:   conv.TimePtrUTC(entry.End),
+				},
+			})
+			if err != nil {
+				return resolvedDBAFK{}, fmt.Errorf("create new entry #%d: %w", i+1, err)
+			}
+			resolved.created = append(resolved.created, started.started)
+			start = conv.TimeOrNow(entry.End)
+		}
+	}
+	if err := c.setStatusNoTran(dinkur.EditStatus{AFKResolution: resolve.Resolution}); err != nil {
+		return resolvedDBAFK{}, fmt.Errorf("clear AFK status: %w", err)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,547 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func newULID() string {
+	return ulid.Make().String()
+}
+
+func (c *client) GetSyncNode(ctx context.Context) (dinkur.SyncNode, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.SyncNode{}, err
+	}
+	return c.withContext(ctx).getSyncNode()
+}
+
+func (c *client) getSyncNode() (dinkur.SyncNode, error) {
+	nodeID, err := c.localNodeID()
+	if err != nil {
+		return dinkur.SyncNode{}, err
+	}
+	clock, err := c.vectorClock()
+	if err != nil {
+		return dinkur.SyncNode{}, err
+	}
+	return dinkur.SyncNode{
+		NodeID: nodeID,
+		Clock:  clock,
+	}, nil
+}
+
+func (c *client) localNodeID() (string, error) {
+	var dbNode dbmodel.SyncNode
+	if err := c.db.First(&dbNode).Error; err != nil {
+		return "", fmt.Errorf("get sync node: %w", err)
+	}
+	return dbNode.NodeID, nil
+}
+
+func (c *client) vectorClock() (dinkur.VectorClock, error) {
+	return scanVectorClock(c.db.Model(&dbmodel.EntryChange{}))
+}
+
+func (c *client) entryVectorClock(entryUUID string) (dinkur.VectorClock, error) {
+	return scanVectorClock(c.db.Model(&dbmodel.EntryChange{}).
+		Where(dbmodel.EntryChangeColumnEntryUUID+" = ?", entryUUID))
+}
+
+func scanVectorClock(q *gorm.DB) (dinkur.VectorClock, error) {
+	var rows []struct {
+		NodeID string
+		Seq    uint64
+	}
+	err := q.Select(fmt.Sprintf("%[1]s, MAX(%[2]s) AS %[2]s",
+		dbmodel.EntryChangeColumnNodeID, dbmodel.EntryChangeColumnSeq)).
+		Group(dbmodel.EntryChangeColumnNodeID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("get vector clock: %w", err)
+	}
+	clock := make(dinkur.VectorClock, len(rows))
+	for _, row := range rows {
+		clock[row.NodeID] = row.Seq
+	}
+	return clock, nil
+}
+
+func (c *client) GetSyncChanges(ctx context.Context, search dinkur.SearchSyncChanges) ([]dinkur.EntryChange, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	if search.Limit > math.MaxInt {
+		return nil, dinkur.ErrLimitTooLarge
+	}
+	dbChanges, err := c.withContext(ctx).listDBEntryChanges(search)
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.EntryChangeSlice(dbChanges), nil
+}
+
+func (c *client) listDBEntryChanges(search dinkur.SearchSyncChanges) ([]dbmodel.EntryChange, error) {
+	since := search.Since
+	q := c.db.Model(&dbmodel.EntryChange{}).
+		Order(dbmodel.EntryChangeColumnNodeID + ", " + dbmodel.EntryChangeColumnSeq)
+	if search.Limit > 0 {
+		q = q.Limit(int(search.Limit))
+	}
+	if len(since) > 0 {
+		nodeIDs := make([]string, 0, len(since))
+		for nodeID := range since {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+		sort.Strings(nodeIDs)
+		q = q.Where(dbmodel.EntryChangeColumnNodeID+" NOT IN ?", nodeIDs)
+		for _, nodeID := range nodeIDs {
+			q = q.Or(fmt.Sprintf("(%s = ? AND %s > ?)",
+				dbmodel.EntryChangeColumnNodeID, dbmodel.EntryChangeColumnSeq),
+				nodeID, since[nodeID])
+		}
+	}
+	var dbChanges []dbmodel.EntryChange
+	if err := q.Find(&dbChanges).Error; err != nil {
+		return nil, fmt.Errorf("list entry changes: %w", err)
+	}
+	return dbChanges, nil
+}
+
+func (c *client) ApplySyncChanges(ctx context.Context, changes []dinkur.EntryChange) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	applied, err := c.withContext(ctx).applyDBEntryChanges(changes)
+	if err != nil {
+		return err
+	}
+	for _, ev := range applied.events {
+		c.entryObs.PubWait(ev)
+	}
+	if applied.status != nil {
+		c.statusObs.PubWait(statusEvent{*applied.status})
+	}
+	return nil
+}
+
+type appliedDBEntryChanges struct {
+	events []entryEvent
+	// status is set if the paused entry was deleted, which clears it from
+	// the status
+	status *dbmodel.Status
+}
+
+func (c *client) applyDBEntryChanges(changes []dinkur.EntryChange) (appliedDBEntryChanges, error) {
+	dbChanges := make([]dbmodel.EntryChange, len(changes))
+	for i, change := range changes {
+		dbChange, err := convEntryChangeToDB(change)
+		if err != nil {
+			return appliedDBEntryChanges{}, err
+		}
+		dbChanges[i] = dbChange
+	}
+	var applied appliedDBEntryChanges
+	err := c.transaction(func(tx *client) (tranErr error) {
+		applied, tranErr = tx.applyDBEntryChangesNoTran(dbChanges)
+		return
+	})
+	return applied, err
+}
+
+func (c *client) applyDBEntryChangesNoTran(dbChanges []dbmodel.EntryChange) (appliedDBEntryChanges, error) {
+	var entryUUIDs []string
+	changedEntries := make(map[string]struct{})
+	for i := range dbChanges {
+		res := c.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbChanges[i])
+		if res.Error != nil {
+			return appliedDBEntryChanges{}, fmt.Errorf("add entry change: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			// already known
+			continue
+		}
+		entryUUID := dbChanges[i].EntryUUID
+		if _, ok := changedEntries[entryUUID]; !ok {
+			changedEntries[entryUUID] = struct{}{}
+			entryUUIDs = append(entryUUIDs, entryUUID)
+		}
+	}
+	var applied appliedDBEntryChanges
+	for _, entryUUID := range entryUUIDs {
+		ev, err := c.resolveDBEntryNoTran(entryUUID)
+		if err != nil {
+			return appliedDBEntryChanges{}, fmt.Errorf("resolve entry %s: %w", entryUUID, err)
+		}
+		if ev == nil {
+			continue
+		}
+		applied.events = append(applied.events, *ev)
+		if ev.event == dinkur.EventDeleted {
+			dbStatus, err := c.clearPausedDBEntryNoTran(ev.dbEntry.ID)
+			if err != nil {
+				return appliedDBEntryChanges{}, err
+			}
+			if dbStatus != nil {
+				applied.status = dbStatus
+			}
+		}
+	}
+	return applied, nil
+}
+
+// resolveDBEntryNoTran updates the entries table to match the resolved state
+// of the entry's changes.
+func (c *client) resolveDBEntryNoTran(entryUUID string) (*entryEvent, error) {
+	var dbChanges []dbmodel.EntryChange
+	err := c.db.Where(dbmodel.EntryChangeColumnEntryUUID+" = ?", entryUUID).
+		Find(&dbChanges).Error
+	if err != nil {
+		return nil, fmt.Errorf("list entry changes: %w", err)
+	}
+	resolved, err := resolveDBEntryChanges(dbChanges)
+	if err != nil {
+		return nil, err
+	}
+	var dbEntry dbmodel.Entry
+	found := true
+	err = c.db.Where(dbmodel.EntryColumnUUID+" = ?", entryUUID).First(&dbEntry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		found = false
+	} else if err != nil {
+		return nil, fmt.Errorf("get entry: %w", err)
+	}
+	switch {
+	case resolved.deleted:
+		if !found {
+			return nil, nil
+		}
+		if err := c.db.Delete(&dbmodel.Entry{}, dbEntry.ID).Error; err != nil {
+			return nil, fmt.Errorf("delete entry: %w", err)
+		}
+		return &entryEvent{dbEntry: dbEntry, event: dinkur.EventDeleted}, nil
+	case !resolved.complete:
+		// The changes that created the entry have not yet arrived.
+		return nil, nil
+	case !found:
+		dbEntry = resolved.entry
+		dbEntry.UUID = entryUUID
+		if err := c.db.Create(&dbEntry).Error; err != nil {
+			return nil, fmt.Errorf("create entry: %w", err)
+		}
+		return &entryEvent{dbEntry: dbEntry, event: dinkur.EventCreated}, nil
+	case dbEntry.Name == resolved.entry.Name &&
+		dbEntry.Start.Equal(resolved.entry.Start) &&
+		timePtrEqual(dbEntry.End, resolved.entry.End) &&
+		dbEntry.ContinuesUUID == resolved.entry.ContinuesUUID:
+		return nil, nil
+	default:
+		dbEntry.Name = resolved.entry.Name
+		dbEntry.Start = resolved.entry.Start
+		dbEntry.End = resolved.entry.End
+		dbEntry.ContinuesUUID = resolved.entry.ContinuesUUID
+		if err := c.db.Save(&dbEntry).Error; err != nil {
+			return nil, fmt.Errorf("save entry: %w", err)
+		}
+		return &entryEvent{dbEntry: dbEntry, event: dinkur.EventUpdated}, nil
+	}
+}
+
+type resolvedDBEntry struct {
+	entry dbmodel.Entry
+	// complete is true if both the name and start time are known.
+	complete bool
+	deleted  bool
+}
+
+// resolveDBEntryChanges resolves each entry field to its last written value.
+// The entry is only resolved as deleted if any of its deletions observed all of
+// the other changes, so concurrent edits and deletions keep the edited entry.
+func resolveDBEntryChanges(dbChanges []dbmodel.EntryChange) (resolvedDBEntry, error) {
+	latest := make(map[dbmodel.EntryChangeField]dbmodel.EntryChange)
+	var deletions []dbmodel.EntryChange
+	for _, change := range dbChanges {
+		if change.Field == dbmodel.EntryChangeFieldDeleted {
+			deletions = append(deletions, change)
+			continue
+		}
+		if prev, ok := latest[change.Field]; !ok || isDBEntryChangeAfter(change, prev) {
+			latest[change.Field] = change
+		}
+	}
+	var resolved resolvedDBEntry
+	for _, deletion := range deletions {
+		var observed dinkur.VectorClock
+		if err := json.Unmarshal([]byte(deletion.Value), &observed); err != nil {
+			return resolvedDBEntry{}, fmt.Errorf("parse observed changes of deletion: %w", err)
+		}
+		if observedAllDBEntryChanges(observed, dbChanges) {
+			resolved.deleted = true
+			return resolved, nil
+		}
+	}
+	name, hasName := latest[dbmodel.EntryChangeFieldName]
+	start, hasStart := latest[dbmodel.EntryChangeFieldStart]
+	if !hasName || !hasStart {
+		return resolved, nil
+	}
+	resolved.complete = true
+	resolved.entry.Name = name.Value
+	startTime, err := parseEntryChangeTime(start.Value)
+	if err != nil || startTime == nil {
+		return resolvedDBEntry{}, fmt.Errorf("parse start time: %w", dinkur.ErrEntryChangeInvalid)
+	}
+	resolved.entry.Start = *startTime
+	if end, ok := latest[dbmodel.EntryChangeFieldEnd]; ok {
+		resolved.entry.End, err = parseEntryChangeTime(end.Value)
+		if err != nil {
+			return resolvedDBEntry{}, fmt.Errorf("parse end time: %w", dinkur.ErrEntryChangeInvalid)
+		}
+	}
+	if continues, ok := latest[dbmodel.EntryChangeFieldContinues]; ok {
+		resolved.entry.ContinuesUUID = continues.Value
+	}
+	return resolved, nil
+}
+
+func isDBEntryChangeAfter(a, b dbmodel.EntryChange) bool {
+	if a.Timestamp != b.Timestamp {
+		return a.Timestamp > b.Timestamp
+	}
+	if a.NodeID != b.NodeID {
+		return a.NodeID > b.NodeID
+	}
+	return a.Seq > b.Seq
+}
+
+func observedAllDBEntryChanges(observed dinkur.VectorClock, dbChanges []dbmodel.EntryChange) bool {
+	for _, change := range dbChanges {
+		if change.Field == dbmodel.EntryChangeFieldDeleted {
+			continue
+		}
+		if observed[change.NodeID] < change.Seq {
+			return false
+		}
+	}
+	return true
+}
+
+// recordDBEntryChangesNoTran adds a change for each field that differs
+// between the before and after states of the entry. A nil before value means
+// the entry was just created, and a change is added for every field.
+func (c *client) recordDBEntryChangesNoTran(before *dbmodel.Entry, after dbmodel.Entry) error {
+	var dbChanges []dbmodel.EntryChange
+	if before == nil || before.Name != after.Name {
+		dbChanges = append(dbChanges, dbmodel.EntryChange{
+			Field: dbmodel.EntryChangeFieldName,
+			Value: after.Name,
+		})
+	}
+	if before == nil || !before.Start.Equal(after.Start) {
+		dbChanges = append(dbChanges, dbmodel.EntryChange{
+			Field: dbmodel.EntryChangeFieldStart,
+			Value: formatEntryChangeTime(&after.Start),
+		})
+	}
+	if before == nil || !timePtrEqual(before.End, after.End) {
+		dbChanges = append(dbChanges, dbmodel.EntryChange{
+			Field: dbmodel.EntryChangeFieldEnd,
+			Value: formatEntryChangeTime(after.End),
+		})
+	}
+	if (before == nil && after.ContinuesUUID != "") ||
+		(before != nil && before.ContinuesUUID != after.ContinuesUUID) {
+		dbChanges = append(dbChanges, dbmodel.EntryChange{
+			Field: dbmodel.EntryChangeFieldContinues,
+			Value: after.ContinuesUUID,
+		})
+	}
+	return c.addLocalDBEntryChangesNoTran(after.UUID, dbChanges)
+}
+
+// recordDBEntryDeletedNoTran adds a deletion change that only applies to the
+// changes of the entry known at the time of deletion.
+func (c *client) recordDBEntryDeletedNoTran(dbEntry dbmodel.Entry) error {
+	observed, err := c.entryVectorClock(dbEntry.UUID)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(observed)
+	if err != nil {
+		return fmt.Errorf("encode observed changes: %w", err)
+	}
+	return c.addLocalDBEntryChangesNoTran(dbEntry.UUID, []dbmodel.EntryChange{{
+		Field: dbmodel.EntryChangeFieldDeleted,
+		Value: string(value),
+	}})
+}
+
+func (c *client) addLocalDBEntryChangesNoTran(entryUUID string, dbChanges []dbmodel.EntryChange) error {
+	if len(dbChanges) == 0 {
+		return nil
+	}
+	nodeID, err := c.localNodeID()
+	if err != nil {
+		return err
+	}
+	var latestSeq sql.NullInt64
+	err = c.db.Model(&dbmodel.EntryChange{}).
+		Select("MAX("+dbmodel.EntryChangeColumnSeq+")").
+		Where(dbmodel.EntryChangeColumnNodeID+" = ?", nodeID).
+		Scan(&latestSeq).Error
+	if err != nil {
+		return fmt.Errorf("get latest change sequence number: %w", err)
+	}
+	timestamp, err := c.nextChangeTimestamp()
+	if err != nil {
+		return err
+	}
+	for i := range dbChanges {
+		dbChanges[i].EntryUUID = entryUUID
+		dbChanges[i].NodeID = nodeID
+		dbChanges[i].Seq = uint64(latestSeq.Int64) + uint64(i) + 1
+		dbChanges[i].Timestamp = timestamp
+	}
+	if err := c.db.Create(&dbChanges).Error; err != nil {
+		return fmt.Errorf("add entry changes: %w", err)
+	}
+	return nil
+}
+
+// nextChangeTimestamp returns the current time, or a later time if any known
+// change is from the future, such as due to clock drift between nodes. This
+// makes sure local changes always win over the changes they overwrite.
+func (c *client) nextChangeTimestamp() (int64, error) {
+	var latest sql.NullInt64
+	err := c.db.Model(&dbmodel.EntryChange{}).
+		Select("MAX(" + dbmodel.EntryChangeColumnTimestamp + ")").
+		Scan(&latest).Error
+	if err != nil {
+		return 0, fmt.Errorf("get latest change timestamp: %w", err)
+	}
+	now := time.Now().UnixNano()
+	if latest.Valid && latest.Int64 >= now {
+		return latest.Int64 + 1, nil
+	}
+	return now, nil
+}
+
+// initSyncNoTran creates the sync node identity of this database, and assigns
+// UUIDs and initial changes to any entries created before syncing was added.
+func (c *client) initSyncNoTran() error {
+	var dbNode dbmodel.SyncNode
+	if err := c.db.Attrs(dbmodel.SyncNode{NodeID: newULID()}).
+		FirstOrCreate(&dbNode).Error; err != nil {
+		return fmt.Errorf("create sync node: %w", err)
+	}
+	var dbEntries []dbmodel.Entry
+	if err := c.db.Where(dbmodel.EntryColumnUUID + " = ''").
+		Find(&dbEntries).Error; err != nil {
+		return fmt.Errorf("list entries without UUID: %w", err)
+	}
+	for _, dbEntry := range dbEntries {
+		dbEntry.UUID = newULID()
+		if err := c.db.Model(&dbEntry).
+			UpdateColumn(dbmodel.EntryColumnUUID, dbEntry.UUID).Error; err != nil {
+			return fmt.Errorf("set entry UUID: %w", err)
+		}
+		if err := c.recordDBEntryChangesNoTran(nil, dbEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convEntryChangeToDB(change dinkur.EntryChange) (dbmodel.EntryChange, error) {
+	if change.EntryUUID == "" || change.NodeID == "" || change.Seq == 0 {
+		return dbmodel.EntryChange{}, dinkur.ErrEntryChangeInvalid
+	}
+	field, ok := convEntryChangeFieldToDB(change.Field)
+	if !ok {
+		return dbmodel.EntryChange{}, fmt.Errorf("%w: unknown field", dinkur.ErrEntryChangeInvalid)
+	}
+	return dbmodel.EntryChange{
+		EntryUUID: change.EntryUUID,
+		NodeID:    change.NodeID,
+		Seq:       change.Seq,
+		Timestamp: change.Timestamp.UnixNano(),
+		Field:     field,
+		Value:     change.Value,
+	}, nil
+}
+
+func convEntryChangeFieldToDB(f dinkur.EntryChangeField) (dbmodel.EntryChangeField, bool) {
+	switch f {
+	case dinkur.EntryChangeFieldName:
+		return dbmodel.EntryChangeFieldName, true
+	case dinkur.EntryChangeFieldStart:
+		return dbmodel.EntryChangeFieldStart, true
+	case dinkur.EntryChangeFieldEnd:
+		return dbmodel.EntryChangeFieldEnd, true
+	case dinkur.EntryChangeFieldDeleted:
+		return dbmodel.EntryChangeFieldDeleted, true
+	case dinkur.EntryChangeFieldContinues:
+		return dbmodel.EntryChangeFieldContinues, true
+	default:
+		return "", false
+	}
+}
+
+func formatEntryChangeTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func parseEntryChangeTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, err
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
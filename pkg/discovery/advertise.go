@@ -0,0 +1,290 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Advertise announces the Dinkur daemon service on the local network and
+// responds to queries from browsers until the context is cancelled, after
+// which a goodbye is announced so browsers forget the service.
+//
+// If the service has no addresses, then the IPv4 addresses of the network
+// interface are advertised.
+func Advertise(ctx context.Context, svc Service, opt Options) error {
+	if len(svc.Addrs) == 0 {
+		addrs, err := interfaceAddrs(opt.Interface)
+		if err != nil {
+			return fmt.Errorf("get network interface addresses: %w", err)
+		}
+		svc.Addrs = addrs
+	}
+	conn, err := listen(opt)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	r := responder{
+		conn:     conn,
+		group:    opt.group(),
+		svc:      svc,
+		instance: instanceLabel(svc.Instance) + "." + serviceName,
+		host:     instanceLabel(svc.Host) + "." + domain,
+	}
+	log.Info().
+		WithString("instance", svc.Instance).
+		WithUint("port", uint(svc.Port)).
+		WithStringf("addrs", "%v", svc.Addrs).
+		Message("Advertising daemon on local network.")
+	if err := r.announce(recordTTL); err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.announce(0); err != nil {
+			log.Warn().WithError(err).Message("Failed to announce goodbye.")
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		conn.SetReadDeadline(time.Now())
+	}()
+	buf := make([]byte, 9000)
+	for {
+		n, from, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("read mDNS query: %w", err)
+		}
+		if err := r.respond(buf[:n], from); err != nil {
+			log.Warn().WithError(err).WithStringer("from", from).
+				Message("Failed to respond to mDNS query.")
+		}
+	}
+}
+
+type responder struct {
+	conn     *net.UDPConn
+	group    *net.UDPAddr
+	svc      Service
+	instance string
+	host     string
+}
+
+func (r *responder) announce(ttl uint32) error {
+	msg := dnsmessage.Message{
+		Header:      dnsmessage.Header{Response: true, Authoritative: true},
+		Answers:     []dnsmessage.Resource{r.ptrRecord(ttl)},
+		Additionals: r.instanceRecords(ttl),
+	}
+	msg.Additionals = append(msg.Additionals, r.addrRecords(ttl)...)
+	return r.send(msg, r.group)
+}
+
+func (r *responder) respond(packet []byte, from *net.UDPAddr) error {
+	var query dnsmessage.Message
+	if err := query.Unpack(packet); err != nil {
+		return fmt.Errorf("parse mDNS query: %w", err)
+	}
+	if query.Header.Response {
+		return nil
+	}
+	var answers, additionals []dnsmessage.Resource
+	unicast := from.Port != r.group.Port
+	for _, q := range query.Questions {
+		class := q.Class &^ unicastResponseBit
+		if class != dnsmessage.ClassINET && class != dnsmessage.ClassANY {
+			continue
+		}
+		if q.Class&unicastResponseBit != 0 {
+			unicast = true
+		}
+		name := q.Name.String()
+		switch {
+		case strings.EqualFold(name, serviceName) && isQuestionType(q, dnsmessage.TypePTR):
+			answers = append(answers, r.ptrRecord(recordTTL))
+			additionals = append(additionals, r.instanceRecords(recordTTL)...)
+			additionals = append(additionals, r.addrRecords(recordTTL)...)
+		case strings.EqualFold(name, "_services._dns-sd._udp."+domain) && isQuestionType(q, dnsmessage.TypePTR):
+			answers = append(answers, dnsmessage.Resource{
+				Header: dnsmessage.ResourceHeader{
+					Name:  q.Name,
+					Type:  dnsmessage.TypePTR,
+					Class: dnsmessage.ClassINET,
+					TTL:   recordTTL,
+				},
+				Body: &dnsmessage.PTRResource{PTR: mustNewName(serviceName)},
+			})
+		case strings.EqualFold(name, r.instance) &&
+			(isQuestionType(q, dnsmessage.TypeSRV) || isQuestionType(q, dnsmessage.TypeTXT)):
+			answers = append(answers, r.instanceRecords(recordTTL)...)
+			additionals = append(additionals, r.addrRecords(recordTTL)...)
+		case strings.EqualFold(name, r.host) && isQuestionType(q, dnsmessage.TypeA):
+			answers = append(answers, r.addrRecords(recordTTL)...)
+		}
+	}
+	if len(answers) == 0 {
+		return nil
+	}
+	msg := dnsmessage.Message{
+		Header:      dnsmessage.Header{Response: true, Authoritative: true},
+		Answers:     answers,
+		Additionals: additionals,
+	}
+	if !unicast {
+		return r.send(msg, r.group)
+	}
+	// unicast responses must echo the query ID and questions, as they may
+	// come from regular DNS resolvers
+	msg.Header.ID = query.Header.ID
+	msg.Questions = query.Questions
+	return r.send(msg, from)
+}
+
+func isQuestionType(q dnsmessage.Question, t dnsmessage.Type) bool {
+	return q.Type == t || q.Type == dnsmessage.TypeALL
+}
+
+func (r *responder) send(msg dnsmessage.Message, to *net.UDPAddr) error {
+	packet, err := msg.Pack()
+	if err != nil {
+		return fmt.Errorf("pack mDNS response: %w", err)
+	}
+	if _, err := r.conn.WriteToUDP(packet, to); err != nil {
+		return fmt.Errorf("send mDNS response: %w", err)
+	}
+	return nil
+}
+
+func (r *responder) ptrRecord(ttl uint32) dnsmessage.Resource {
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{
+			Name:  mustNewName(serviceName),
+			Type:  dnsmessage.TypePTR,
+			Class: dnsmessage.ClassINET,
+			TTL:   ttl,
+		},
+		Body: &dnsmessage.PTRResource{PTR: mustNewName(r.instance)},
+	}
+}
+
+func (r *responder) instanceRecords(ttl uint32) []dnsmessage.Resource {
+	txt := []string{txtKeyVersion + "=1", fmt.Sprintf("%s=%t", txtKeyTLS, r.svc.TLS)}
+	if r.svc.TLSFingerprint != "" {
+		txt = append(txt, txtKeyFingerprint+"="+r.svc.TLSFingerprint)
+	}
+	return []dnsmessage.Resource{
+		{
+			Header: dnsmessage.ResourceHeader{
+				Name:  mustNewName(r.instance),
+				Type:  dnsmessage.TypeSRV,
+				Class: dnsmessage.ClassINET | cacheFlushBit,
+				TTL:   ttl,
+			},
+			Body: &dnsmessage.SRVResource{
+				Port:   r.svc.Port,
+				Target: mustNewName(r.host),
+			},
+		},
+		{
+			Header: dnsmessage.ResourceHeader{
+				Name:  mustNewName(r.instance),
+				Type:  dnsmessage.TypeTXT,
+				Class: dnsmessage.ClassINET | cacheFlushBit,
+				TTL:   ttl,
+			},
+			Body: &dnsmessage.TXTResource{TXT: txt},
+		},
+	}
+}
+
+func (r *responder) addrRecords(ttl uint32) []dnsmessage.Resource {
+	var records []dnsmessage.Resource
+	for _, ip := range r.svc.Addrs {
+		ip4 := ip.To4()
+		if ip4 == nil {
+			continue
+		}
+		var a dnsmessage.AResource
+		copy(a.A[:], ip4)
+		records = append(records, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{
+				Name:  mustNewName(r.host),
+				Type:  dnsmessage.TypeA,
+				Class: dnsmessage.ClassINET | cacheFlushBit,
+				TTL:   ttl,
+			},
+			Body: &a,
+		})
+	}
+	return records
+}
+
+// interfaceAddrs returns the IPv4 addresses of the network interface, or of
+// all multicast-capable interfaces that are up if nil. Loopback addresses are
+// only included when the interface is a loopback interface.
+func interfaceAddrs(iface *net.Interface) ([]net.IP, error) {
+	var ifaces []net.Interface
+	if iface != nil {
+		ifaces = []net.Interface{*iface}
+	} else {
+		all, err := net.Interfaces()
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range all {
+			if i.Flags&net.FlagUp != 0 && i.Flags&net.FlagMulticast != 0 &&
+				i.Flags&net.FlagLoopback == 0 {
+				ifaces = append(ifaces, i)
+			}
+		}
+	}
+	var ips []net.IP
+	for _, i := range ifaces {
+		addrs, err := i.Addrs()
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok || ipNet.IP.To4() == nil {
+				continue
+			}
+			if ipNet.IP.IsLoopback() && i.Flags&net.FlagLoopback == 0 {
+				continue
+			}
+			ips = append(ips, ipNet.IP.To4())
+		}
+	}
+	if len(ips) == 0 {
+		return nil, errors.New("no IPv4 addresses found")
+	}
+	return ips, nil
+}
@@ -0,0 +1,191 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// queryInterval is how often the query is resent while browsing, in case
+// the query or responses were lost.
+var queryInterval = time.Second
+
+// Browse queries the local network for Dinkur daemons until the context is
+// cancelled, such as via a timeout, and then returns all daemons found.
+func Browse(ctx context.Context, opt Options) ([]Service, error) {
+	conn, err := listen(opt)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	query, err := (&dnsmessage.Message{
+		Questions: []dnsmessage.Question{{
+			Name:  mustNewName(serviceName),
+			Type:  dnsmessage.TypePTR,
+			Class: dnsmessage.ClassINET,
+		}},
+	}).Pack()
+	if err != nil {
+		return nil, fmt.Errorf("pack mDNS query: %w", err)
+	}
+	group := opt.group()
+	if _, err := conn.WriteToUDP(query, group); err != nil {
+		return nil, fmt.Errorf("send mDNS query: %w", err)
+	}
+	// stop resending before the connection is closed, also when returning
+	// due to an error
+	resendCtx, cancelResend := context.WithCancel(ctx)
+	resendDone := make(chan struct{})
+	defer func() {
+		cancelResend()
+		<-resendDone
+	}()
+	go func() {
+		defer close(resendDone)
+		ticker := time.NewTicker(queryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := conn.WriteToUDP(query, group); err != nil {
+					log.Debug().WithError(err).Message("Failed to resend mDNS query.")
+				}
+			case <-resendCtx.Done():
+				conn.SetReadDeadline(time.Now())
+				return
+			}
+		}
+	}()
+	records := newBrowseRecords()
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return nil, fmt.Errorf("read mDNS response: %w", err)
+		}
+		records.add(buf[:n])
+	}
+	return records.services(), nil
+}
+
+// browseRecords collects the records of all responses, as the records of a
+// single service may be spread across multiple responses. All names are
+// stored in lowercase, as DNS names are case-insensitive.
+type browseRecords struct {
+	instances map[string]string
+	srv       map[string]dnsmessage.SRVResource
+	txt       map[string][]string
+	addrs     map[string][]net.IP
+}
+
+func newBrowseRecords() browseRecords {
+	return browseRecords{
+		instances: make(map[string]string),
+		srv:       make(map[string]dnsmessage.SRVResource),
+		txt:       make(map[string][]string),
+		addrs:     make(map[string][]net.IP),
+	}
+}
+
+func (b browseRecords) add(packet []byte) {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(packet); err != nil {
+		log.Debug().WithError(err).Message("Ignoring invalid mDNS packet.")
+		return
+	}
+	if !msg.Header.Response {
+		return
+	}
+	for _, res := range append(msg.Answers, msg.Additionals...) {
+		name := strings.ToLower(res.Header.Name.String())
+		switch body := res.Body.(type) {
+		case *dnsmessage.PTRResource:
+			if name != serviceName {
+				continue
+			}
+			instance := body.PTR.String()
+			if res.Header.TTL == 0 {
+				delete(b.instances, strings.ToLower(instance))
+			} else {
+				b.instances[strings.ToLower(instance)] = instance
+			}
+		case *dnsmessage.SRVResource:
+			b.srv[name] = *body
+		case *dnsmessage.TXTResource:
+			b.txt[name] = body.TXT
+		case *dnsmessage.AResource:
+			ip := net.IP(body.A[:])
+			if !containsIP(b.addrs[name], ip) {
+				b.addrs[name] = append(b.addrs[name], net.IPv4(ip[0], ip[1], ip[2], ip[3]))
+			}
+		}
+	}
+}
+
+func containsIP(ips []net.IP, ip net.IP) bool {
+	for _, other := range ips {
+		if other.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b browseRecords) services() []Service {
+	var services []Service
+	for key, instance := range b.instances {
+		srv, ok := b.srv[key]
+		if !ok {
+			continue
+		}
+		target := srv.Target.String()
+		svc := Service{
+			Instance: strings.TrimSuffix(instance, "."+serviceName),
+			Host:     strings.TrimSuffix(strings.TrimSuffix(target, "."), ".local"),
+			Addrs:    b.addrs[strings.ToLower(target)],
+			Port:     srv.Port,
+		}
+		for _, kv := range b.txt[key] {
+			k, v, _ := strings.Cut(kv, "=")
+			switch k {
+			case txtKeyTLS:
+				svc.TLS = v == "true"
+			case txtKeyFingerprint:
+				svc.TLSFingerprint = v
+			}
+		}
+		services = append(services, svc)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Instance < services[j].Instance
+	})
+	return services
+}
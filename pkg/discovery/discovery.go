@@ -0,0 +1,203 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package discovery contains a minimal mDNS/DNS-SD (multicast DNS service
+// discovery) implementation, used to advertise and find Dinkur daemons on the
+// local network.
+package discovery
+
+import (
+	"crypto/sha256"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Errors specific to Dinkur daemon discovery.
+var (
+	ErrNoCertificate    = errors.New("no PEM-encoded certificate found")
+	ErrInvalidInterface = errors.New("network interface does not support multicast")
+)
+
+var log = logger.NewScoped("mDNS")
+
+// ServiceType is the DNS-SD service type advertised by Dinkur daemons.
+const ServiceType = "_dinkur._tcp"
+
+const (
+	domain      = "local."
+	serviceName = ServiceType + "." + domain
+	// recordTTL is the time-to-live, in seconds, of the advertised records.
+	recordTTL = 120
+	// cacheFlushBit is set on the class of records that are unique to the
+	// responder, as opposed to shared records such as PTR records.
+	cacheFlushBit = 1 << 15
+	// unicastResponseBit is set on the class of questions that prefer a
+	// unicast response.
+	unicastResponseBit = 1 << 15
+)
+
+// TXT record keys of advertised Dinkur daemons.
+const (
+	txtKeyVersion     = "txtvers"
+	txtKeyTLS         = "tls"
+	txtKeyFingerprint = "fingerprint"
+)
+
+// Service is a Dinkur daemon advertised on the local network.
+type Service struct {
+	// Instance is the name of this daemon, such as the computer's hostname.
+	Instance string
+	// Host is the hostname of the computer running the daemon, without the
+	// ".local." suffix.
+	Host string
+	// Addrs are the IP addresses the daemon can be reached on.
+	Addrs []net.IP
+	// Port is the port of the daemon's gRPC API.
+	Port uint16
+	// TLS is true if the daemon's gRPC API requires TLS.
+	TLS bool
+	// TLSFingerprint is the SHA-256 fingerprint of the daemon's TLS
+	// certificate, as returned by the Fingerprint function. Empty if TLS is
+	// not enabled.
+	TLSFingerprint string
+}
+
+// Address returns the "host:port" address of the daemon, preferring the IP
+// address over the hostname, to be used as the gRPC address.
+func (s Service) Address() string {
+	host := s.Host + ".local"
+	if len(s.Addrs) > 0 {
+		host = s.Addrs[0].String()
+	}
+	return net.JoinHostPort(host, fmt.Sprint(s.Port))
+}
+
+// Options for advertising and browsing.
+type Options struct {
+	// Interface is the network interface to use. The system's default
+	// multicast interface is used if nil.
+	Interface *net.Interface
+	// Group is the multicast group address to use. Defaults to the mDNS group
+	// 224.0.0.251:5353 if nil, which only needs to be changed when testing.
+	Group *net.UDPAddr
+}
+
+// DefaultGroup is the IPv4 mDNS multicast group address.
+var DefaultGroup = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+func (opt Options) group() *net.UDPAddr {
+	if opt.Group == nil {
+		return DefaultGroup
+	}
+	return opt.Group
+}
+
+// InterfaceByName returns the network interface by name, or nil if the name
+// is empty.
+func InterfaceByName(name string) (*net.Interface, error) {
+	if name == "" {
+		return nil, nil
+	}
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if iface.Flags&net.FlagMulticast == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidInterface, name)
+	}
+	return iface, nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the DER-encoded certificate,
+// formatted as colon-separated uppercase hex, the same as the output of:
+//
+//	openssl x509 -noout -fingerprint -sha256
+func Fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	var sb strings.Builder
+	for i, b := range sum {
+		if i > 0 {
+			sb.WriteByte(':')
+		}
+		fmt.Fprintf(&sb, "%02X", b)
+	}
+	return sb.String()
+}
+
+// FingerprintFile returns the Fingerprint of the first certificate in a
+// PEM-encoded certificate file.
+func FingerprintFile(certFile string) (string, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return "", err
+	}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return "", fmt.Errorf("%w: %s", ErrNoCertificate, certFile)
+		}
+		if block.Type == "CERTIFICATE" {
+			return Fingerprint(block.Bytes), nil
+		}
+	}
+}
+
+// listen joins the multicast group, with multicast loopback enabled so that
+// daemons on the same computer can be found.
+func listen(opt Options) (*net.UDPConn, error) {
+	conn, err := net.ListenMulticastUDP("udp4", opt.Interface, opt.group())
+	if err != nil {
+		return nil, fmt.Errorf("join multicast group: %w", err)
+	}
+	// ListenMulticastUDP disables multicast loopback, but also sets the
+	// interface used for outgoing multicast packets
+	if err := enableMulticastLoopback(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("enable multicast loopback: %w", err)
+	}
+	return conn, nil
+}
+
+func mustNewName(s string) dnsmessage.Name {
+	name, err := dnsmessage.NewName(s)
+	if err != nil {
+		// only happens for names longer than 255 bytes
+		panic(err)
+	}
+	return name
+}
+
+// instanceLabel turns a name into a single DNS label, as dots would otherwise
+// split it into multiple labels.
+func instanceLabel(name string) string {
+	name = strings.ReplaceAll(name, ".", "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return name
+}
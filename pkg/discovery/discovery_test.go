@@ -0,0 +1,110 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package discovery
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+// loopbackInterface returns the loopback network interface, or skips the test
+// if it does not support multicast.
+func loopbackInterface(t *testing.T) *net.Interface {
+	t.Helper()
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		t.Fatalf("list network interfaces: %s", err)
+	}
+	for i, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 &&
+			iface.Flags&net.FlagMulticast != 0 &&
+			iface.Flags&net.FlagUp != 0 {
+			return &ifaces[i]
+		}
+	}
+	t.Skip("no loopback network interface with multicast support")
+	return nil
+}
+
+func TestAdvertiseAndBrowse(t *testing.T) {
+	opt := Options{
+		Interface: loopbackInterface(t),
+		// not the mDNS group, to not advertise to or get responses from
+		// other daemons
+		Group: &net.UDPAddr{IP: net.IPv4(239, 255, 70, 122), Port: 15353},
+	}
+	prevQueryInterval := queryInterval
+	queryInterval = 50 * time.Millisecond
+	t.Cleanup(func() { queryInterval = prevQueryInterval })
+
+	want := Service{
+		Instance:       "test.daemon",
+		Host:           "testhost",
+		Addrs:          []net.IP{net.IPv4(127, 0, 0, 1)},
+		Port:           59122,
+		TLS:            true,
+		TLSFingerprint: "AA:BB:CC",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	advertised := make(chan error, 1)
+	go func() {
+		advertised <- Advertise(ctx, want, opt)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-advertised; err != nil {
+			t.Errorf("advertise: %s", err)
+		}
+	})
+
+	browseCtx, cancelBrowse := context.WithTimeout(context.Background(), time.Second)
+	defer cancelBrowse()
+	services, err := Browse(browseCtx, opt)
+	if err != nil {
+		t.Fatalf("browse: %s", err)
+	}
+	if len(services) != 1 {
+		t.Fatalf("want 1 service, got %d: %+v", len(services), services)
+	}
+	got := services[0]
+	// dots in the instance name are replaced, as they would split the label
+	if got.Instance != "test-daemon" {
+		t.Errorf("want instance %q, got %q", "test-daemon", got.Instance)
+	}
+	if got.Host != want.Host {
+		t.Errorf("want host %q, got %q", want.Host, got.Host)
+	}
+	if got.Port != want.Port {
+		t.Errorf("want port %d, got %d", want.Port, got.Port)
+	}
+	if got.TLS != want.TLS || got.TLSFingerprint != want.TLSFingerprint {
+		t.Errorf("want TLS %t with fingerprint %q, got %t with %q",
+			want.TLS, want.TLSFingerprint, got.TLS, got.TLSFingerprint)
+	}
+	if len(got.Addrs) != 1 || !got.Addrs[0].Equal(want.Addrs[0]) {
+		t.Errorf("want addresses %v, got %v", want.Addrs, got.Addrs)
+	}
+	if addr := got.Address(); addr != "127.0.0.1:59122" {
+		t.Errorf("want address %q, got %q", "127.0.0.1:59122", addr)
+	}
+}
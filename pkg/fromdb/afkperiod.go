@@ -0,0 +1,55 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AFKPeriod converts a dbmodel AFK period to a dinkur AFK period.
+func AFKPeriod(p dbmodel.AFKPeriod) dinkur.AFKPeriod {
+	return dinkur.AFKPeriod{
+		CommonFields: CommonFields(p.CommonFields),
+		Start:        p.Start.Local(),
+		End:          conv.TimePtrLocal(p.End),
+		Hook:         p.Hook,
+		Resolution:   AFKResolution(p.Resolution),
+	}
+}
+
+// AFKResolution converts a dbmodel AFK resolution to a dinkur AFK resolution.
+func AFKResolution(r dbmodel.AFKResolution) dinkur.AFKResolution {
+	switch r {
+	case dbmodel.AFKResolutionKeep:
+		return dinkur.AFKResolutionKeep
+	case dbmodel.AFKResolutionDiscard:
+		return dinkur.AFKResolutionDiscard
+	case dbmodel.AFKResolutionNewEntry:
+		return dinkur.AFKResolutionNewEntry
+	case dbmodel.AFKResolutionDismissed:
+		return dinkur.AFKResolutionDismissed
+	case dbmodel.AFKResolutionSplit:
+		return dinkur.AFKResolutionSplit
+	default:
+		return dinkur.AFKResolutionNone
+	}
+}
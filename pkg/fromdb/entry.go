@@ -31,9 +31,12 @@ import (
 func Entry(t dbmodel.Entry) dinkur.Entry {
 	return dinkur.Entry{
 		CommonFields: CommonFields(t.CommonFields),
+		UUID:         t.UUID,
 		Name:         t.Name,
 		Start:        t.Start.Local(),
 		End:          conv.TimePtrLocal(t.End),
+
+		ContinuesUUID: t.ContinuesUUID,
 	}
 }
 
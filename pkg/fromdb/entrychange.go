@@ -0,0 +1,66 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4/slices"
+)
+
+// EntryChange converts a dbmodel entry change to a dinkur entry change.
+func EntryChange(c dbmodel.EntryChange) dinkur.EntryChange {
+	return dinkur.EntryChange{
+		EntryUUID: c.EntryUUID,
+		NodeID:    c.NodeID,
+		Seq:       c.Seq,
+		Timestamp: time.Unix(0, c.Timestamp),
+		Field:     EntryChangeField(c.Field),
+		Value:     c.Value,
+	}
+}
+
+// EntryChangeSlice converts a slice of dbmodel entry changes to dinkur entry
+// changes.
+func EntryChangeSlice(changes []dbmodel.EntryChange) []dinkur.EntryChange {
+	return slices.Map(changes, EntryChange)
+}
+
+// EntryChangeField converts a dbmodel entry change field to a dinkur entry
+// change field.
+func EntryChangeField(f dbmodel.EntryChangeField) dinkur.EntryChangeField {
+	switch f {
+	case dbmodel.EntryChangeFieldName:
+		return dinkur.EntryChangeFieldName
+	case dbmodel.EntryChangeFieldStart:
+		return dinkur.EntryChangeFieldStart
+	case dbmodel.EntryChangeFieldEnd:
+		return dinkur.EntryChangeFieldEnd
+	case dbmodel.EntryChangeFieldDeleted:
+		return dinkur.EntryChangeFieldDeleted
+	case dbmodel.EntryChangeFieldContinues:
+		return dinkur.EntryChangeFieldContinues
+	default:
+		return dinkur.EntryChangeFieldUnknown
+	}
+}
@@ -0,0 +1,36 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// PomodoroCycle converts a dbmodel Pomodoro cycle to a dinkur Pomodoro cycle.
+func PomodoroCycle(c dbmodel.PomodoroCycle) dinkur.PomodoroCycle {
+	return dinkur.PomodoroCycle{
+		CommonFields: CommonFields(c.CommonFields),
+		EntryUUID:    c.EntryUUID,
+		Start:        c.Start.Local(),
+		End:          c.End.Local(),
+	}
+}
@@ -31,5 +31,10 @@ func Status(status dbmodel.Status) dinkur.Status {
 		TimeFields: TimeFields(status.CommonFields),
 		AFKSince:   conv.TimePtrLocal(status.AFKSince),
 		BackSince:  conv.TimePtrLocal(status.BackSince),
+		Heartbeat:  conv.TimePtrLocal(status.Heartbeat),
+
+		NotTrackingSince:   conv.TimePtrLocal(status.NotTrackingSince),
+		SuggestedEntryName: status.SuggestedEntryName,
+		PausedEntryID:      status.PausedEntryID,
 	}
 }
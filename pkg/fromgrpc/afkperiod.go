@@ -0,0 +1,92 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"errors"
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// ErrUnexpectedNilAFKPeriod is returned when an AFK period was unexpectedly
+// nil.
+var ErrUnexpectedNilAFKPeriod = errors.New("unexpected nil AFK period")
+
+// AFKPeriodPtr converts a gRPC AFK period to a Go AFK period, or nil.
+func AFKPeriodPtr(period *dinkurapiv1.AfkPeriod) (*dinkur.AFKPeriod, error) {
+	if period == nil {
+		return nil, nil
+	}
+	id, err := conv.Uint64ToUint(period.Id)
+	if err != nil {
+		return nil, fmt.Errorf("convert AFK period ID: %w", err)
+	}
+	return &dinkur.AFKPeriod{
+		CommonFields: dinkur.CommonFields{
+			TimeFields: dinkur.TimeFields{
+				CreatedAt: TimeOrZero(period.Created),
+				UpdatedAt: TimeOrZero(period.Updated),
+			},
+			ID: id,
+		},
+		Start:      TimeOrZero(period.Start),
+		End:        TimePtr(period.End),
+		Hook:       period.Hook,
+		Resolution: AFKResolution(period.Resolution),
+	}, nil
+}
+
+// AFKPeriodSlice converts a slice of gRPC AFK periods to Go AFK periods. Nils
+// are skipped.
+func AFKPeriodSlice(slice []*dinkurapiv1.AfkPeriod) ([]dinkur.AFKPeriod, error) {
+	periods := make([]dinkur.AFKPeriod, 0, len(slice))
+	for _, p := range slice {
+		p2, err := AFKPeriodPtr(p)
+		if err != nil {
+			return nil, fmt.Errorf("AFK period #%d: %w", p.Id, err)
+		}
+		if p2 == nil {
+			continue
+		}
+		periods = append(periods, *p2)
+	}
+	return periods, nil
+}
+
+// AFKResolution converts a gRPC AFK resolution to a Go AFK resolution.
+func AFKResolution(r dinkurapiv1.AfkResolution) dinkur.AFKResolution {
+	switch r {
+	case dinkurapiv1.AfkResolution_AFK_RESOLUTION_KEEP:
+		return dinkur.AFKResolutionKeep
+	case dinkurapiv1.AfkResolution_AFK_RESOLUTION_DISCARD:
+		return dinkur.AFKResolutionDiscard
+	case dinkurapiv1.AfkResolution_AFK_RESOLUTION_NEW_ENTRY:
+		return dinkur.AFKResolutionNewEntry
+	case dinkurapiv1.AfkResolution_AFK_RESOLUTION_DISMISSED:
+		return dinkur.AFKResolutionDismissed
+	case dinkurapiv1.AfkResolution_AFK_RESOLUTION_SPLIT:
+		return dinkur.AFKResolutionSplit
+	default:
+		return dinkur.AFKResolutionNone
+	}
+}
@@ -0,0 +1,60 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AFKSettings converts gRPC AFK settings to Go AFK settings. Nil is treated as
+// all zero values.
+func AFKSettings(s *dinkurapiv1.AfkSettings) dinkur.AFKSettings {
+	if s == nil {
+		return dinkur.AFKSettings{}
+	}
+	return dinkur.AFKSettings{
+		Threshold:      DurationOrZero(s.Threshold),
+		PollInterval:   DurationOrZero(s.PollInterval),
+		AllowHooks:     s.AllowHooks,
+		DenyHooks:      s.DenyHooks,
+		AvailableHooks: s.AvailableHooks,
+	}
+}
+
+// EditAFKSettings converts a gRPC request to update the AFK settings to a Go
+// AFK settings edit. Nil is treated as no changes.
+func EditAFKSettings(req *dinkurapiv1.UpdateAfkSettingsRequest) dinkur.EditAFKSettings {
+	if req == nil {
+		return dinkur.EditAFKSettings{}
+	}
+	edit := dinkur.EditAFKSettings{
+		ClearAllowHooks: req.ClearAllowHooks,
+		ClearDenyHooks:  req.ClearDenyHooks,
+	}
+	if s := req.Settings; s != nil {
+		edit.Threshold = DurationPtr(s.Threshold)
+		edit.PollInterval = DurationPtr(s.PollInterval)
+		edit.AllowHooks = s.AllowHooks
+		edit.DenyHooks = s.DenyHooks
+	}
+	return edit
+}
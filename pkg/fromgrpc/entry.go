@@ -51,9 +51,12 @@ func EntryPtr(entry *dinkurapiv1.Entry) (*dinkur.Entry, error) {
 			},
 			ID: id,
 		},
+		UUID:  entry.Uuid,
 		Name:  entry.Name,
 		Start: TimeOrZero(entry.Start),
 		End:   TimePtr(entry.End),
+
+		ContinuesUUID: entry.ContinuesUuid,
 	}, nil
 }
 
@@ -0,0 +1,60 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"errors"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Errors that are specific to converting gRPC peers to Go.
+var (
+	ErrUnexpectedNilPeer = errors.New("unexpected nil peer")
+)
+
+// PeerPtrNoNil converts a gRPC peer to a Go peer, or error if nil.
+func PeerPtrNoNil(peer *dinkurapiv1.Peer) (dinkur.Peer, error) {
+	if peer == nil {
+		return dinkur.Peer{}, ErrUnexpectedNilPeer
+	}
+	return dinkur.Peer{
+		Name:           peer.Name,
+		Address:        peer.Address,
+		TLSFingerprint: peer.TlsFingerprint,
+		Paired:         TimeOrZero(peer.Paired),
+	}, nil
+}
+
+// PeerSlice converts a slice of gRPC peers to Go peers, or error if any of
+// them are nil.
+func PeerSlice(slice []*dinkurapiv1.Peer) ([]dinkur.Peer, error) {
+	peers := make([]dinkur.Peer, len(slice))
+	for i, p := range slice {
+		peer, err := PeerPtrNoNil(p)
+		if err != nil {
+			return nil, err
+		}
+		peers[i] = peer
+	}
+	return peers, nil
+}
@@ -0,0 +1,161 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"errors"
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// ErrUnexpectedNilPomodoroCycle is returned when a Pomodoro cycle was
+// unexpectedly nil.
+var ErrUnexpectedNilPomodoroCycle = errors.New("unexpected nil Pomodoro cycle")
+
+// PomodoroPtr converts a gRPC Pomodoro timer state to a Go Pomodoro timer
+// state, or nil.
+func PomodoroPtr(p *dinkurapiv1.PomodoroState) (*dinkur.Pomodoro, error) {
+	if p == nil {
+		return nil, nil
+	}
+	completed, err := conv.Uint64ToUint(p.CompletedCycles)
+	if err != nil {
+		return nil, fmt.Errorf("convert completed cycles: %w", err)
+	}
+	settings, err := PomodoroSettings(p.Settings)
+	if err != nil {
+		return nil, err
+	}
+	return &dinkur.Pomodoro{
+		EntryName:       p.EntryName,
+		Settings:        settings,
+		Phase:           PomodoroPhase(p.Phase),
+		PhaseStart:      TimeOrZero(p.PhaseStart),
+		PhaseEnd:        TimeOrZero(p.PhaseEnd),
+		CompletedCycles: completed,
+	}, nil
+}
+
+// PomodoroSettings converts gRPC Pomodoro settings to Go Pomodoro settings.
+// Nil is treated as all zero values.
+func PomodoroSettings(s *dinkurapiv1.PomodoroSettings) (dinkur.PomodoroSettings, error) {
+	if s == nil {
+		return dinkur.PomodoroSettings{}, nil
+	}
+	every, err := conv.Uint64ToUint(s.LongBreakEvery)
+	if err != nil {
+		return dinkur.PomodoroSettings{}, fmt.Errorf("convert long break every: %w", err)
+	}
+	return dinkur.PomodoroSettings{
+		Work:           DurationOrZero(s.Work),
+		Break:          DurationOrZero(s.Break),
+		LongBreak:      DurationOrZero(s.LongBreak),
+		LongBreakEvery: every,
+		BreakEntryName: s.BreakEntryName,
+	}, nil
+}
+
+// PomodoroPhase converts a gRPC Pomodoro phase to a Go Pomodoro phase.
+func PomodoroPhase(p dinkurapiv1.PomodoroPhase) dinkur.PomodoroPhase {
+	switch p {
+	case dinkurapiv1.PomodoroPhase_POMODORO_PHASE_WORK:
+		return dinkur.PomodoroPhaseWork
+	case dinkurapiv1.PomodoroPhase_POMODORO_PHASE_BREAK:
+		return dinkur.PomodoroPhaseBreak
+	case dinkurapiv1.PomodoroPhase_POMODORO_PHASE_LONG_BREAK:
+		return dinkur.PomodoroPhaseLongBreak
+	default:
+		return dinkur.PomodoroPhaseNone
+	}
+}
+
+// PomodoroEvent converts a gRPC Pomodoro event to a Go Pomodoro event.
+func PomodoroEvent(e dinkurapiv1.PomodoroEvent) dinkur.PomodoroEvent {
+	switch e {
+	case dinkurapiv1.PomodoroEvent_POMODORO_EVENT_STARTED:
+		return dinkur.PomodoroEventStarted
+	case dinkurapiv1.PomodoroEvent_POMODORO_EVENT_BREAK_STARTED:
+		return dinkur.PomodoroEventBreakStarted
+	case dinkurapiv1.PomodoroEvent_POMODORO_EVENT_WORK_STARTED:
+		return dinkur.PomodoroEventWorkStarted
+	case dinkurapiv1.PomodoroEvent_POMODORO_EVENT_STOPPED:
+		return dinkur.PomodoroEventStopped
+	default:
+		return dinkur.PomodoroEventUnknown
+	}
+}
+
+// PomodoroCyclePtr converts a gRPC Pomodoro cycle to a Go Pomodoro cycle, or
+// nil.
+func PomodoroCyclePtr(cycle *dinkurapiv1.PomodoroCycle) (*dinkur.PomodoroCycle, error) {
+	if cycle == nil {
+		return nil, nil
+	}
+	id, err := conv.Uint64ToUint(cycle.Id)
+	if err != nil {
+		return nil, fmt.Errorf("convert Pomodoro cycle ID: %w", err)
+	}
+	return &dinkur.PomodoroCycle{
+		CommonFields: dinkur.CommonFields{
+			TimeFields: dinkur.TimeFields{
+				CreatedAt: TimeOrZero(cycle.Created),
+				UpdatedAt: TimeOrZero(cycle.Updated),
+			},
+			ID: id,
+		},
+		EntryUUID: cycle.EntryUuid,
+		Start:     TimeOrZero(cycle.Start),
+		End:       TimeOrZero(cycle.End),
+	}, nil
+}
+
+// PomodoroCyclePtrNoNil converts a gRPC Pomodoro cycle to a Go Pomodoro
+// cycle, or error on nil.
+func PomodoroCyclePtrNoNil(cycle *dinkurapiv1.PomodoroCycle) (dinkur.PomodoroCycle, error) {
+	c, err := PomodoroCyclePtr(cycle)
+	if err != nil {
+		return dinkur.PomodoroCycle{}, err
+	}
+	if c == nil {
+		return dinkur.PomodoroCycle{}, ErrUnexpectedNilPomodoroCycle
+	}
+	return *c, nil
+}
+
+// PomodoroCycleSlice converts a slice of gRPC Pomodoro cycles to Go Pomodoro
+// cycles. Nils are skipped.
+func PomodoroCycleSlice(slice []*dinkurapiv1.PomodoroCycle) ([]dinkur.PomodoroCycle, error) {
+	cycles := make([]dinkur.PomodoroCycle, 0, len(slice))
+	for _, c := range slice {
+		c2, err := PomodoroCyclePtr(c)
+		if err != nil {
+			return nil, fmt.Errorf("Pomodoro cycle #%d: %w", c.Id, err)
+		}
+		if c2 == nil {
+			continue
+		}
+		cycles = append(cycles, *c2)
+	}
+	return cycles, nil
+}
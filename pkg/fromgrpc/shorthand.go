@@ -43,6 +43,26 @@ func Shorthand(s dinkurapiv1.GetEntryListRequest_Shorthand) timeutil.TimeSpanSho
 		return timeutil.TimeSpanNextDay
 	case dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_MON_TO_SUN:
 		return timeutil.TimeSpanNextWeek
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_MONTH:
+		return timeutil.TimeSpanThisMonth
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_MONTH:
+		return timeutil.TimeSpanPrevMonth
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_MONTH:
+		return timeutil.TimeSpanNextMonth
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_QUARTER:
+		return timeutil.TimeSpanThisQuarter
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_QUARTER:
+		return timeutil.TimeSpanPrevQuarter
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_QUARTER:
+		return timeutil.TimeSpanNextQuarter
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_YEAR:
+		return timeutil.TimeSpanThisYear
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_YEAR:
+		return timeutil.TimeSpanPrevYear
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_YEAR:
+		return timeutil.TimeSpanNextYear
+	case dinkurapiv1.GetEntryListRequest_SHORTHAND_LAST_DAYS:
+		return timeutil.TimeSpanLastDays
 	default:
 		return timeutil.TimeSpanNone
 	}
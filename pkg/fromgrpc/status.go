@@ -20,7 +20,10 @@
 package fromgrpc
 
 import (
+	"fmt"
+
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 )
 
@@ -29,6 +32,10 @@ func StatusPtrNoNil(status *dinkurapiv1.Status) (dinkur.Status, error) {
 	if status == nil {
 		return dinkur.Status{}, ErrUnexpectedNilStatus
 	}
+	pausedEntryID, err := conv.Uint64ToUint(status.PausedEntryId)
+	if err != nil {
+		return dinkur.Status{}, fmt.Errorf("convert paused entry ID: %w", err)
+	}
 	return dinkur.Status{
 		TimeFields: dinkur.TimeFields{
 			CreatedAt: TimeOrZero(status.Created),
@@ -36,5 +43,10 @@ func StatusPtrNoNil(status *dinkurapiv1.Status) (dinkur.Status, error) {
 		},
 		AFKSince:  TimePtr(status.AfkSince),
 		BackSince: TimePtr(status.BackSince),
+		Heartbeat: TimePtr(status.Heartbeat),
+
+		NotTrackingSince:   TimePtr(status.NotTrackingSince),
+		SuggestedEntryName: status.SuggestedEntryName,
+		PausedEntryID:      pausedEntryID,
 	}, nil
 }
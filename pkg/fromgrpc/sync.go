@@ -0,0 +1,82 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"errors"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Errors that are specific to converting gRPC entry changes to Go.
+var (
+	ErrUnexpectedNilEntryChange = errors.New("unexpected nil entry change")
+)
+
+// EntryChangePtrNoNil converts a gRPC entry change to a Go entry change, or
+// error if nil.
+func EntryChangePtrNoNil(change *dinkurapiv1.EntryChange) (dinkur.EntryChange, error) {
+	if change == nil {
+		return dinkur.EntryChange{}, ErrUnexpectedNilEntryChange
+	}
+	return dinkur.EntryChange{
+		EntryUUID: change.EntryUuid,
+		NodeID:    change.NodeId,
+		Seq:       change.Seq,
+		Timestamp: TimeOrZero(change.Timestamp),
+		Field:     EntryChangeField(change.Field),
+		Value:     change.Value,
+	}, nil
+}
+
+// EntryChangeSlice converts a slice of gRPC entry changes to Go entry changes,
+// or error if any of them are nil.
+func EntryChangeSlice(slice []*dinkurapiv1.EntryChange) ([]dinkur.EntryChange, error) {
+	changes := make([]dinkur.EntryChange, len(slice))
+	for i, c := range slice {
+		change, err := EntryChangePtrNoNil(c)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = change
+	}
+	return changes, nil
+}
+
+// EntryChangeField converts a gRPC entry change field to a Go entry change
+// field.
+func EntryChangeField(f dinkurapiv1.EntryChangeField) dinkur.EntryChangeField {
+	switch f {
+	case dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_NAME:
+		return dinkur.EntryChangeFieldName
+	case dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_START:
+		return dinkur.EntryChangeFieldStart
+	case dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_END:
+		return dinkur.EntryChangeFieldEnd
+	case dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_DELETED:
+		return dinkur.EntryChangeFieldDeleted
+	case dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_CONTINUES:
+		return dinkur.EntryChangeFieldContinues
+	default:
+		return dinkur.EntryChangeFieldUnknown
+	}
+}
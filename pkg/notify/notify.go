@@ -0,0 +1,64 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package notify sends desktop notifications, such as via the freedesktop.org
+// notification specification on GNU/Linux.
+package notify
+
+import "errors"
+
+// ErrUnsupported is returned when creating a notifier on a platform that does
+// not yet support desktop notifications.
+var ErrUnsupported = errors.New("desktop notifications are not supported on this platform")
+
+// Urgency of a notification. Notification servers may use it to decide how to
+// present the notification, such as keeping critical notifications on screen
+// until dismissed.
+type Urgency byte
+
+const (
+	// UrgencyLow is for notifications that can be ignored.
+	UrgencyLow Urgency = iota
+	// UrgencyNormal is the default urgency.
+	UrgencyNormal
+	// UrgencyCritical is for notifications that should not be missed.
+	UrgencyCritical
+)
+
+// Notification is a desktop notification.
+type Notification struct {
+	// Summary is a single line overview of the notification.
+	Summary string
+	// Body is the optional detailed text of the notification.
+	Body string
+	// Urgency of the notification.
+	Urgency Urgency
+	// ReplacesKey is an optional key used to replace any previously sent
+	// notification with the same key, instead of showing both.
+	ReplacesKey string
+}
+
+// Notifier sends desktop notifications.
+type Notifier interface {
+	// Notify sends a desktop notification.
+	Notify(n Notification) error
+	// Close releases any connection used to send notifications.
+	Close() error
+}
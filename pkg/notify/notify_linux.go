@@ -0,0 +1,111 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notify
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// https://specifications.freedesktop.org/notification-spec/latest/protocol.html
+const (
+	dbusDest       = "org.freedesktop.Notifications"
+	dbusPath       = "/org/freedesktop/Notifications"
+	dbusMethod     = "org.freedesktop.Notifications.Notify"
+	dbusAppIcon    = "dinkur"
+	defaultTimeout = int32(-1) // let the notification server decide
+)
+
+// New creates a new desktop notifier that sends notifications to the
+// org.freedesktop.Notifications service on the session D-Bus, as given by the
+// DBUS_SESSION_BUS_ADDRESS environment variable.
+func New(appName string) (Notifier, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("connect to session D-Bus: %w", err)
+	}
+	return newDBusNotifier(appName, conn), nil
+}
+
+// NewAtAddress creates a new desktop notifier like New, but using the D-Bus
+// at the given address, such as "unix:path=/run/user/1000/bus", instead of
+// the session D-Bus.
+func NewAtAddress(appName, address string) (Notifier, error) {
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		return nil, fmt.Errorf("connect to D-Bus: %w", err)
+	}
+	return newDBusNotifier(appName, conn), nil
+}
+
+func newDBusNotifier(appName string, conn *dbus.Conn) *dbusNotifier {
+	return &dbusNotifier{
+		appName:  appName,
+		conn:     conn,
+		obj:      conn.Object(dbusDest, dbusPath),
+		replaces: map[string]uint32{},
+	}
+}
+
+type dbusNotifier struct {
+	appName  string
+	conn     *dbus.Conn
+	obj      dbus.BusObject
+	replaces map[string]uint32
+	mutex    sync.Mutex
+}
+
+func (n *dbusNotifier) Notify(notif Notification) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var replacesID uint32
+	if notif.ReplacesKey != "" {
+		replacesID = n.replaces[notif.ReplacesKey]
+	}
+	hints := map[string]dbus.Variant{
+		"urgency":       dbus.MakeVariant(byte(notif.Urgency)),
+		"desktop-entry": dbus.MakeVariant(dbusAppIcon),
+	}
+	var id uint32
+	call := n.obj.Call(dbusMethod, 0,
+		n.appName,     // app_name
+		replacesID,    // replaces_id
+		dbusAppIcon,   // app_icon
+		notif.Summary, // summary
+		notif.Body,    // body
+		[]string{},    // actions
+		hints,         // hints
+		defaultTimeout,
+	)
+	if err := call.Store(&id); err != nil {
+		return fmt.Errorf("send notification: %w", err)
+	}
+	if notif.ReplacesKey != "" {
+		n.replaces[notif.ReplacesKey] = id
+	}
+	return nil
+}
+
+func (n *dbusNotifier) Close() error {
+	return n.conn.Close()
+}
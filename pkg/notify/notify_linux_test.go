@@ -0,0 +1,176 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package notify
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const testBusConfig = `<!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-Bus Bus Configuration 1.0//EN"
+ "http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd">
+<busconfig>
+  <type>session</type>
+  <listen>unix:path=%s</listen>
+  <auth>EXTERNAL</auth>
+  <policy context="default">
+    <allow send_destination="*"/>
+    <allow receive_sender="*"/>
+    <allow own="*"/>
+  </policy>
+</busconfig>
+`
+
+// startTestBus starts a private D-Bus daemon, or skips the test if
+// dbus-daemon is not installed, and returns its address.
+func startTestBus(t *testing.T) string {
+	t.Helper()
+	dbusDaemon, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon not found")
+	}
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "bus.conf")
+	config := fmt.Sprintf(testBusConfig, filepath.Join(dir, "bus"))
+	if err := os.WriteFile(configFile, []byte(config), 0600); err != nil {
+		t.Fatalf("write D-Bus config: %s", err)
+	}
+	cmd := exec.Command(dbusDaemon, "--config-file="+configFile, "--nofork", "--print-address")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("pipe dbus-daemon output: %s", err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start dbus-daemon: %s", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Fatalf("read dbus-daemon address: %s", err)
+	}
+	return strings.TrimSpace(address)
+}
+
+type testNotification struct {
+	appName    string
+	replacesID uint32
+	summary    string
+	body       string
+	hints      map[string]dbus.Variant
+}
+
+// testNotificationServer implements the Notify method of the
+// org.freedesktop.Notifications service.
+type testNotificationServer struct {
+	notifications []testNotification
+	mutex         sync.Mutex
+}
+
+func (s *testNotificationServer) Notify(appName string, replacesID uint32, appIcon, summary, body string, actions []string, hints map[string]dbus.Variant, timeout int32) (uint32, *dbus.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.notifications = append(s.notifications, testNotification{
+		appName:    appName,
+		replacesID: replacesID,
+		summary:    summary,
+		body:       body,
+		hints:      hints,
+	})
+	if replacesID != 0 {
+		return replacesID, nil
+	}
+	return uint32(len(s.notifications)), nil
+}
+
+func startTestNotificationServer(t *testing.T, address string) *testNotificationServer {
+	t.Helper()
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		t.Fatalf("connect to D-Bus: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	server := &testNotificationServer{}
+	if err := conn.Export(server, dbusPath, dbusDest); err != nil {
+		t.Fatalf("export notification server: %s", err)
+	}
+	reply, err := conn.RequestName(dbusDest, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		t.Fatalf("request D-Bus name: %s", err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		t.Fatalf("D-Bus name %s already taken", dbusDest)
+	}
+	return server
+}
+
+func TestNotify(t *testing.T) {
+	address := startTestBus(t)
+	server := startTestNotificationServer(t, address)
+	n, err := NewAtAddress("Dinkur", address)
+	if err != nil {
+		t.Fatalf("new notifier: %s", err)
+	}
+	defer n.Close()
+
+	sent := []Notification{
+		{Summary: "Welcome back", Body: "You were away.", Urgency: UrgencyNormal, ReplacesKey: "afk"},
+		{Summary: "Welcome back again", Urgency: UrgencyCritical, ReplacesKey: "afk"},
+		{Summary: "Not tracking any entry", Urgency: UrgencyLow},
+	}
+	for _, notif := range sent {
+		if err := n.Notify(notif); err != nil {
+			t.Fatalf("notify %q: %s", notif.Summary, err)
+		}
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if len(server.notifications) != len(sent) {
+		t.Fatalf("want %d notifications, got %d", len(sent), len(server.notifications))
+	}
+	wantReplacesIDs := []uint32{0, 1, 0}
+	for i, got := range server.notifications {
+		want := sent[i]
+		if got.appName != "Dinkur" {
+			t.Errorf("notification %d: want app name %q, got %q", i, "Dinkur", got.appName)
+		}
+		if got.summary != want.Summary || got.body != want.Body {
+			t.Errorf("notification %d: want %q %q, got %q %q", i, want.Summary, want.Body, got.summary, got.body)
+		}
+		if got.replacesID != wantReplacesIDs[i] {
+			t.Errorf("notification %d: want replaces ID %d, got %d", i, wantReplacesIDs[i], got.replacesID)
+		}
+		if urgency, ok := got.hints["urgency"].Value().(byte); !ok || urgency != byte(want.Urgency) {
+			t.Errorf("notification %d: want urgency %d, got %v", i, want.Urgency, got.hints["urgency"])
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pairing
+
+import (
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"strings"
+)
+
+// ErrKeyExchangeInvalid is returned when the other daemon's key exchange
+// message is malformed, or when the key exchange is used out of order.
+var ErrKeyExchangeInvalid = errors.New("invalid pairing key exchange")
+
+// Role is the side of a key exchange.
+type Role byte
+
+const (
+	// RoleRequester is the daemon where the pairing code was entered, which
+	// sends the first key exchange message.
+	RoleRequester Role = iota
+	// RoleResponder is the daemon that created the pairing code.
+	RoleResponder
+)
+
+// The blinding points M and N of SPAKE2 must have no known discrete
+// logarithm, so they are derived by hashing fixed seeds onto the curve.
+//
+// The crypto/elliptic big.Int API is used as it is the only P-256 arithmetic
+// available in the standard library of our minimum Go version.
+var (
+	pakeCurve = elliptic.P256()
+	pakeM     = hashToPoint("dinkur pairing SPAKE2 point M")
+	pakeN     = hashToPoint("dinkur pairing SPAKE2 point N")
+)
+
+type point struct {
+	x, y *big.Int
+}
+
+// KeyExchange is one side of a SPAKE2 password-authenticated key exchange
+// (RFC 9382) over P-256, keyed by the pairing code, followed by key
+// confirmation in both directions.
+//
+// The TLS certificate fingerprints of both daemons are included in the
+// transcript, so the confirmations only match if both daemons saw the same
+// certificates, and if both knew the pairing code. A man-in-the-middle only
+// gets a single guess of the code per key exchange, and cannot test any
+// guesses offline.
+type KeyExchange struct {
+	role        Role
+	w           *big.Int
+	secret      *big.Int
+	message     []byte
+	requesterID string
+	responderID string
+
+	ownConfirmKey  []byte
+	peerConfirmKey []byte
+	peerMessage    []byte
+}
+
+// NewKeyExchange starts a key exchange using the pairing code, and the TLS
+// certificate fingerprints of the requesting and responding daemons as seen
+// by this daemon.
+func NewKeyExchange(role Role, code, requesterFingerprint, responderFingerprint string) (*KeyExchange, error) {
+	params := pakeCurve.Params()
+	secret, err := rand.Int(rand.Reader, new(big.Int).Sub(params.N, big.NewInt(1)))
+	if err != nil {
+		return nil, err
+	}
+	secret.Add(secret, big.NewInt(1))
+	kx := &KeyExchange{
+		role:        role,
+		w:           codeScalar(code),
+		secret:      secret,
+		requesterID: strings.ToUpper(requesterFingerprint),
+		responderID: strings.ToUpper(responderFingerprint),
+	}
+	blind := pakeM
+	if role == RoleResponder {
+		blind = pakeN
+	}
+	sx, sy := pakeCurve.ScalarBaseMult(secret.Bytes())
+	bx, by := pakeCurve.ScalarMult(blind.x, blind.y, kx.w.Bytes())
+	x, y := pakeCurve.Add(sx, sy, bx, by)
+	kx.message = elliptic.Marshal(pakeCurve, x, y)
+	return kx, nil
+}
+
+// Message returns this daemon's key exchange message, to be sent to the other
+// daemon.
+func (kx *KeyExchange) Message() []byte {
+	return kx.message
+}
+
+// Finish derives the shared keys from the other daemon's key exchange
+// message. The keys only match if both daemons used the same pairing code
+// and fingerprints, which is checked using the confirmations.
+func (kx *KeyExchange) Finish(peerMessage []byte) error {
+	if kx.peerMessage != nil {
+		return ErrKeyExchangeInvalid
+	}
+	px, py := elliptic.Unmarshal(pakeCurve, peerMessage)
+	if px == nil {
+		return fmt.Errorf("%w: malformed message", ErrKeyExchangeInvalid)
+	}
+	unblind := pakeN
+	if kx.role == RoleResponder {
+		unblind = pakeM
+	}
+	// K = secret * (peerMessage - w*unblind)
+	params := pakeCurve.Params()
+	bx, by := pakeCurve.ScalarMult(unblind.x, unblind.y, kx.w.Bytes())
+	by.Sub(params.P, by)
+	qx, qy := pakeCurve.Add(px, py, bx, by)
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return fmt.Errorf("%w: message is the point at infinity", ErrKeyExchangeInvalid)
+	}
+	sharedX, sharedY := pakeCurve.ScalarMult(qx, qy, kx.secret.Bytes())
+	sharedKey := elliptic.Marshal(pakeCurve, sharedX, sharedY)
+
+	requesterMessage, responderMessage := kx.message, peerMessage
+	if kx.role == RoleResponder {
+		requesterMessage, responderMessage = peerMessage, kx.message
+	}
+	transcript := sha256.New()
+	writeLengthPrefixed(transcript, []byte(kx.requesterID))
+	writeLengthPrefixed(transcript, []byte(kx.responderID))
+	writeLengthPrefixed(transcript, requesterMessage)
+	writeLengthPrefixed(transcript, responderMessage)
+	writeLengthPrefixed(transcript, sharedKey)
+	writeLengthPrefixed(transcript, kx.w.Bytes())
+	transcriptHash := transcript.Sum(nil)
+	// the first half is reserved as the encryption key, Ke, in RFC 9382
+	authKey := transcriptHash[len(transcriptHash)/2:]
+
+	requesterConfirmKey := hmacSum(authKey, []byte("ConfirmationKeys requester"))
+	responderConfirmKey := hmacSum(authKey, []byte("ConfirmationKeys responder"))
+	if kx.role == RoleRequester {
+		kx.ownConfirmKey, kx.peerConfirmKey = requesterConfirmKey, responderConfirmKey
+	} else {
+		kx.ownConfirmKey, kx.peerConfirmKey = responderConfirmKey, requesterConfirmKey
+	}
+	kx.peerMessage = peerMessage
+	return nil
+}
+
+// Confirmation returns this daemon's key confirmation, to be sent to the
+// other daemon, proving that this daemon knows the pairing code. Returns nil
+// if Finish has not been called.
+func (kx *KeyExchange) Confirmation() []byte {
+	if kx.peerMessage == nil {
+		return nil
+	}
+	return hmacSum(kx.ownConfirmKey, kx.peerMessage)
+}
+
+// VerifyConfirmation reports whether the other daemon's key confirmation
+// proves that it knows the pairing code, and that it saw the same TLS
+// certificate fingerprints.
+func (kx *KeyExchange) VerifyConfirmation(confirmation []byte) bool {
+	if kx.peerMessage == nil {
+		return false
+	}
+	return hmac.Equal(confirmation, hmacSum(kx.peerConfirmKey, kx.message))
+}
+
+// codeScalar returns the password scalar w of SPAKE2 for a pairing code.
+func codeScalar(code string) *big.Int {
+	sum := sha256.Sum256([]byte("dinkur pairing code\x00" + NormalizeCode(code)))
+	w := new(big.Int).SetBytes(sum[:])
+	return w.Mod(w, pakeCurve.Params().N)
+}
+
+// hashToPoint deterministically maps a seed to a curve point using
+// try-and-increment, so that no one knows its discrete logarithm.
+func hashToPoint(seed string) point {
+	params := pakeCurve.Params()
+	three := big.NewInt(3)
+	for counter := uint32(0); ; counter++ {
+		h := sha256.New()
+		h.Write([]byte(seed))
+		binary.Write(h, binary.BigEndian, counter)
+		x := new(big.Int).SetBytes(h.Sum(nil))
+		x.Mod(x, params.P)
+		// y² = x³ - 3x + b
+		y2 := new(big.Int).Exp(x, three, params.P)
+		y2.Sub(y2, new(big.Int).Mul(three, x))
+		y2.Add(y2, params.B)
+		y2.Mod(y2, params.P)
+		y := new(big.Int).ModSqrt(y2, params.P)
+		if y != nil && pakeCurve.IsOnCurve(x, y) {
+			return point{x, y}
+		}
+	}
+}
+
+func writeLengthPrefixed(h hash.Hash, b []byte) {
+	binary.Write(h, binary.LittleEndian, uint64(len(b)))
+	h.Write(b)
+}
+
+func hmacSum(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,222 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pairing
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"testing"
+)
+
+const (
+	testRequesterFingerprint = "AA:BB:CC"
+	testResponderFingerprint = "DD:EE:FF"
+)
+
+func newTestKeyExchanges(t *testing.T, requesterCode, responderCode string) (*KeyExchange, *KeyExchange) {
+	t.Helper()
+	requester, err := NewKeyExchange(RoleRequester, requesterCode, testRequesterFingerprint, testResponderFingerprint)
+	if err != nil {
+		t.Fatalf("new requester key exchange: %s", err)
+	}
+	responder, err := NewKeyExchange(RoleResponder, responderCode, testRequesterFingerprint, testResponderFingerprint)
+	if err != nil {
+		t.Fatalf("new responder key exchange: %s", err)
+	}
+	return requester, responder
+}
+
+func finishTestKeyExchanges(t *testing.T, requester, responder *KeyExchange) {
+	t.Helper()
+	if err := requester.Finish(responder.Message()); err != nil {
+		t.Fatalf("finish requester key exchange: %s", err)
+	}
+	if err := responder.Finish(requester.Message()); err != nil {
+		t.Fatalf("finish responder key exchange: %s", err)
+	}
+}
+
+func TestKeyExchangeSameCode(t *testing.T) {
+	requester, responder := newTestKeyExchanges(t, "123-456", "123456")
+	finishTestKeyExchanges(t, requester, responder)
+	if !responder.VerifyConfirmation(requester.Confirmation()) {
+		t.Error("responder rejected requester's confirmation")
+	}
+	if !requester.VerifyConfirmation(responder.Confirmation()) {
+		t.Error("requester rejected responder's confirmation")
+	}
+}
+
+func TestKeyExchangeFingerprintCase(t *testing.T) {
+	requester, err := NewKeyExchange(RoleRequester, "123456", "aa:bb:cc", "dd:ee:ff")
+	if err != nil {
+		t.Fatalf("new requester key exchange: %s", err)
+	}
+	responder, err := NewKeyExchange(RoleResponder, "123456", "AA:BB:CC", "DD:EE:FF")
+	if err != nil {
+		t.Fatalf("new responder key exchange: %s", err)
+	}
+	finishTestKeyExchanges(t, requester, responder)
+	if !responder.VerifyConfirmation(requester.Confirmation()) {
+		t.Error("responder rejected requester's confirmation")
+	}
+}
+
+func TestKeyExchangeWrongCode(t *testing.T) {
+	requester, responder := newTestKeyExchanges(t, "123456", "123457")
+	finishTestKeyExchanges(t, requester, responder)
+	if responder.VerifyConfirmation(requester.Confirmation()) {
+		t.Error("responder accepted confirmation using the wrong code")
+	}
+	if requester.VerifyConfirmation(responder.Confirmation()) {
+		t.Error("requester accepted confirmation using the wrong code")
+	}
+}
+
+func TestKeyExchangeChangedFingerprint(t *testing.T) {
+	tests := []struct {
+		name                 string
+		requesterFingerprint string
+		responderFingerprint string
+	}{
+		{
+			name:                 "requester",
+			requesterFingerprint: "11:22:33",
+			responderFingerprint: testResponderFingerprint,
+		},
+		{
+			name:                 "responder",
+			requesterFingerprint: testRequesterFingerprint,
+			responderFingerprint: "11:22:33",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// the requester sees a different certificate than the responder
+			// has, such as when the connection is intercepted
+			requester, err := NewKeyExchange(RoleRequester, "123456", tc.requesterFingerprint, tc.responderFingerprint)
+			if err != nil {
+				t.Fatalf("new requester key exchange: %s", err)
+			}
+			responder, err := NewKeyExchange(RoleResponder, "123456", testRequesterFingerprint, testResponderFingerprint)
+			if err != nil {
+				t.Fatalf("new responder key exchange: %s", err)
+			}
+			finishTestKeyExchanges(t, requester, responder)
+			if responder.VerifyConfirmation(requester.Confirmation()) {
+				t.Error("responder accepted confirmation using a changed fingerprint")
+			}
+			if requester.VerifyConfirmation(responder.Confirmation()) {
+				t.Error("requester accepted confirmation using a changed fingerprint")
+			}
+		})
+	}
+}
+
+func TestKeyExchangeReflectedMessage(t *testing.T) {
+	requester, _ := newTestKeyExchanges(t, "123456", "123456")
+	if err := requester.Finish(requester.Message()); err != nil {
+		t.Fatalf("finish requester key exchange: %s", err)
+	}
+	if requester.VerifyConfirmation(requester.Confirmation()) {
+		t.Error("requester accepted its own reflected confirmation")
+	}
+}
+
+func TestKeyExchangeMalformedMessage(t *testing.T) {
+	requester, responder := newTestKeyExchanges(t, "123456", "123456")
+	valid := responder.Message()
+	offCurve := append([]byte(nil), valid...)
+	offCurve[len(offCurve)-1] ^= 1
+	tests := []struct {
+		name    string
+		message []byte
+	}{
+		{name: "empty", message: nil},
+		{name: "infinity encoding", message: []byte{0}},
+		{name: "truncated", message: valid[:len(valid)-1]},
+		{name: "compressed", message: elliptic.MarshalCompressed(pakeCurve, pakeM.x, pakeM.y)},
+		{name: "off curve", message: offCurve},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := requester.Finish(tc.message)
+			if !errors.Is(err, ErrKeyExchangeInvalid) {
+				t.Fatalf("want %q, got: %v", ErrKeyExchangeInvalid, err)
+			}
+			if requester.Confirmation() != nil {
+				t.Error("want no confirmation after failed key exchange")
+			}
+		})
+	}
+}
+
+func TestKeyExchangePointAtInfinity(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    Role
+		unblind point
+	}{
+		{name: "requester", role: RoleRequester, unblind: pakeN},
+		{name: "responder", role: RoleResponder, unblind: pakeM},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kx, err := NewKeyExchange(tc.role, "123456", testRequesterFingerprint, testResponderFingerprint)
+			if err != nil {
+				t.Fatalf("new key exchange: %s", err)
+			}
+			// a message of w*M or w*N, by someone who knows the code,
+			// would make the shared key the point at infinity
+			x, y := pakeCurve.ScalarMult(tc.unblind.x, tc.unblind.y, codeScalar("123456").Bytes())
+			err = kx.Finish(elliptic.Marshal(pakeCurve, x, y))
+			if !errors.Is(err, ErrKeyExchangeInvalid) {
+				t.Fatalf("want %q, got: %v", ErrKeyExchangeInvalid, err)
+			}
+		})
+	}
+}
+
+func TestKeyExchangeOutOfOrder(t *testing.T) {
+	requester, responder := newTestKeyExchanges(t, "123456", "123456")
+	if requester.Confirmation() != nil {
+		t.Error("want no confirmation before finishing the key exchange")
+	}
+	if requester.VerifyConfirmation(nil) {
+		t.Error("want no confirmation to be verified before finishing the key exchange")
+	}
+	finishTestKeyExchanges(t, requester, responder)
+	if err := requester.Finish(responder.Message()); !errors.Is(err, ErrKeyExchangeInvalid) {
+		t.Errorf("want %q when finishing twice, got: %v", ErrKeyExchangeInvalid, err)
+	}
+}
+
+func TestHashToPoint(t *testing.T) {
+	if !pakeCurve.IsOnCurve(pakeM.x, pakeM.y) {
+		t.Error("M is not on the curve")
+	}
+	if !pakeCurve.IsOnCurve(pakeN.x, pakeN.y) {
+		t.Error("N is not on the curve")
+	}
+	if pakeM.x.Cmp(pakeN.x) == 0 {
+		t.Error("M and N are the same point")
+	}
+}
@@ -0,0 +1,140 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package pairing contains the credentials used to set up a trust
+// relationship between two Dinkur daemons, and a file-based store of the
+// paired daemons.
+//
+// One daemon creates a short pairing code, which the user enters on the other
+// daemon. The other daemon connects without verifying the TLS certificate,
+// and both daemons then run a password-authenticated key exchange keyed by
+// the code, with both daemons' TLS certificate fingerprints in the
+// transcript. Each daemon proves that it knows the code before any tokens
+// are exchanged, so a man-in-the-middle with a different certificate cannot
+// complete the pairing, and only gets a single guess of the code per attempt.
+// Both daemons then pin each other's certificate, and exchange a random token
+// used to authenticate when connecting from another computer.
+package pairing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dinkur/dinkur/pkg/discovery"
+)
+
+// Errors specific to pairing Dinkur daemons.
+var (
+	ErrPeerNotFound        = errors.New("paired peer not found")
+	ErrCodeInvalid         = errors.New("invalid or expired pairing code")
+	ErrFingerprintMismatch = errors.New("TLS certificate does not match the pinned fingerprint")
+	ErrNoPeerCertificate   = errors.New("peer did not present a TLS certificate")
+)
+
+// codeDigits is the number of digits in a pairing code.
+const codeDigits = 6
+
+// NewCode returns a new random pairing code, formatted as two groups of three
+// digits, such as "123-456".
+func NewCode() (string, error) {
+	max := big.NewInt(1_000_000)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	code := fmt.Sprintf("%0*d", codeDigits, n)
+	return code[:codeDigits/2] + "-" + code[codeDigits/2:], nil
+}
+
+// NormalizeCode removes any separators from a pairing code, so that codes
+// entered as "123 456", "123-456", or "123456" are treated the same.
+func NormalizeCode(code string) string {
+	var sb strings.Builder
+	for _, r := range code {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// NewToken returns a new random token, used by a peer to authenticate itself.
+func NewToken() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// HashToken returns the hex-encoded SHA-256 hash of a token, so that tokens
+// given out to peers do not need to be stored in plain text.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PinnedTLSConfig returns a TLS config that only accepts a server
+// certificate with the given fingerprint, as returned by
+// discovery.Fingerprint. The certificate chain and hostname are not verified,
+// which allows self-signed certificates.
+func PinnedTLSConfig(fingerprint string) *tls.Config {
+	return &tls.Config{
+		// verified in VerifyConnection instead
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			got, err := peerFingerprint(state)
+			if err != nil {
+				return err
+			}
+			if !strings.EqualFold(got, fingerprint) {
+				return fmt.Errorf("%w: got %s", ErrFingerprintMismatch, got)
+			}
+			return nil
+		},
+	}
+}
+
+// FetchFingerprint connects to a TLS server and returns the fingerprint of
+// its certificate, without verifying it.
+func FetchFingerprint(ctx context.Context, address string) (string, error) {
+	dialer := tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true}}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	return peerFingerprint(conn.(*tls.Conn).ConnectionState())
+}
+
+func peerFingerprint(state tls.ConnectionState) (string, error) {
+	if len(state.PeerCertificates) == 0 {
+		return "", ErrNoPeerCertificate
+	}
+	return discovery.Fingerprint(state.PeerCertificates[0].Raw), nil
+}
@@ -0,0 +1,182 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pairing
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Peer is a paired Dinkur daemon, including its credentials.
+type Peer struct {
+	// Name is the unique name of the peer, which is the hostname of the
+	// computer it is running on.
+	Name string `yaml:"name"`
+	// Address is the host and port of the peer's gRPC API.
+	Address string `yaml:"address"`
+	// TLSFingerprint is the pinned fingerprint of the peer's TLS certificate.
+	TLSFingerprint string `yaml:"tlsFingerprint"`
+	// Token is sent to the peer to authenticate when syncing with it.
+	Token string `yaml:"token"`
+	// TokenHash is the HashToken of the token the peer sends to authenticate
+	// when syncing with us.
+	TokenHash string `yaml:"tokenHash"`
+	// Paired is when the pairing was made.
+	Paired time.Time `yaml:"paired"`
+}
+
+type peersFile struct {
+	Peers []Peer `yaml:"peers"`
+}
+
+// Store is a YAML file of paired peers. The file is only readable by the
+// current user, as it contains the tokens used to sync with the peers.
+type Store struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewStore returns a store of paired peers using the given file path. The
+// file is created when the first peer is added.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the file path of the store.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Peers returns all paired peers, ordered by name.
+func (s *Store) Peers() ([]Peer, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.readNoLock()
+}
+
+// Peer returns the paired peer with the given name.
+func (s *Store) Peer(name string) (Peer, error) {
+	peers, err := s.Peers()
+	if err != nil {
+		return Peer{}, err
+	}
+	for _, p := range peers {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Peer{}, fmt.Errorf("%w: %q", ErrPeerNotFound, name)
+}
+
+// PeerByToken returns the paired peer that authenticates using the given
+// token.
+func (s *Store) PeerByToken(token string) (Peer, error) {
+	peers, err := s.Peers()
+	if err != nil {
+		return Peer{}, err
+	}
+	hash := []byte(HashToken(token))
+	for _, p := range peers {
+		if subtle.ConstantTimeCompare(hash, []byte(p.TokenHash)) == 1 {
+			return p, nil
+		}
+	}
+	return Peer{}, ErrPeerNotFound
+}
+
+// Save adds the peer, replacing any existing peer with the same name.
+func (s *Store) Save(peer Peer) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	peers, err := s.readNoLock()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i, p := range peers {
+		if p.Name == peer.Name {
+			peers[i] = peer
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		peers = append(peers, peer)
+	}
+	return s.writeNoLock(peers)
+}
+
+// Delete removes the peer with the given name, and returns the removed peer.
+func (s *Store) Delete(name string) (Peer, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	peers, err := s.readNoLock()
+	if err != nil {
+		return Peer{}, err
+	}
+	for i, p := range peers {
+		if p.Name == name {
+			peers = append(peers[:i], peers[i+1:]...)
+			return p, s.writeNoLock(peers)
+		}
+	}
+	return Peer{}, fmt.Errorf("%w: %q", ErrPeerNotFound, name)
+}
+
+func (s *Store) readNoLock() ([]Peer, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read peers file: %w", err)
+	}
+	var file peersFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse peers file: %w", err)
+	}
+	sort.Slice(file.Peers, func(i, j int) bool {
+		return file.Peers[i].Name < file.Peers[j].Name
+	})
+	return file.Peers, nil
+}
+
+func (s *Store) writeNoLock(peers []Peer) error {
+	data, err := yaml.Marshal(peersFile{Peers: peers})
+	if err != nil {
+		return fmt.Errorf("encode peers file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("create peers file directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("write peers file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package textsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// line is a single entry in an entry file. The entry's values are only there
+// for readability, as importing only uses the changes.
+type line struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name,omitempty"`
+	Start     *time.Time   `json:"start,omitempty"`
+	End       *time.Time   `json:"end,omitempty"`
+	Continues string       `json:"continues,omitempty"`
+	Deleted   bool         `json:"deleted,omitempty"`
+	Changes   []lineChange `json:"changes"`
+}
+
+// lineChange is a dinkur.EntryChange, without the entry UUID.
+type lineChange struct {
+	Field     string    `json:"field"`
+	NodeID    string    `json:"node"`
+	Seq       uint64    `json:"seq"`
+	Timestamp time.Time `json:"time"`
+	Value     string    `json:"value"`
+}
+
+var changeFields = map[string]dinkur.EntryChangeField{
+	dinkur.EntryChangeFieldName.String():      dinkur.EntryChangeFieldName,
+	dinkur.EntryChangeFieldStart.String():     dinkur.EntryChangeFieldStart,
+	dinkur.EntryChangeFieldEnd.String():       dinkur.EntryChangeFieldEnd,
+	dinkur.EntryChangeFieldDeleted.String():   dinkur.EntryChangeFieldDeleted,
+	dinkur.EntryChangeFieldContinues.String(): dinkur.EntryChangeFieldContinues,
+}
+
+func (l line) entryChanges() ([]dinkur.EntryChange, error) {
+	if l.ID == "" {
+		return nil, fmt.Errorf("%w: missing entry ID", ErrInvalidLine)
+	}
+	changes := make([]dinkur.EntryChange, len(l.Changes))
+	for i, c := range l.Changes {
+		field, ok := changeFields[c.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidLine, c.Field)
+		}
+		changes[i] = dinkur.EntryChange{
+			EntryUUID: l.ID,
+			NodeID:    c.NodeID,
+			Seq:       c.Seq,
+			Timestamp: c.Timestamp,
+			Field:     field,
+			Value:     c.Value,
+		}
+	}
+	return changes, nil
+}
+
+// month returns the month of the file that the line belongs in.
+func (l line) month() time.Time {
+	t := l.sortTime().UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// sortTime returns the start time of the entry, or the time of its first
+// change if the start time is unknown.
+func (l line) sortTime() time.Time {
+	if l.Start != nil {
+		return *l.Start
+	}
+	var first time.Time
+	for _, c := range l.Changes {
+		if first.IsZero() || c.Timestamp.Before(first) {
+			first = c.Timestamp
+		}
+	}
+	return first
+}
+
+// resolveLines resolves each entry to its latest value of each field, using
+// the same rules as when syncing databases. Only the changes that decide the
+// entry's values are kept, together with all deletions, as the other changes
+// are overwritten anyway.
+func resolveLines(changes []dinkur.EntryChange) ([]line, error) {
+	byEntry := make(map[string][]dinkur.EntryChange)
+	var entryUUIDs []string
+	for _, c := range changes {
+		if _, ok := byEntry[c.EntryUUID]; !ok {
+			entryUUIDs = append(entryUUIDs, c.EntryUUID)
+		}
+		byEntry[c.EntryUUID] = append(byEntry[c.EntryUUID], c)
+	}
+	sort.Strings(entryUUIDs)
+	lines := make([]line, 0, len(entryUUIDs))
+	for _, entryUUID := range entryUUIDs {
+		l, err := resolveLine(entryUUID, byEntry[entryUUID])
+		if err != nil {
+			return nil, fmt.Errorf("entry %s: %w", entryUUID, err)
+		}
+		lines = append(lines, l)
+	}
+	return lines, nil
+}
+
+func resolveLine(entryUUID string, changes []dinkur.EntryChange) (line, error) {
+	latest := make(map[dinkur.EntryChangeField]dinkur.EntryChange)
+	var kept []dinkur.EntryChange
+	l := line{ID: entryUUID}
+	for _, c := range changes {
+		if c.Field == dinkur.EntryChangeFieldDeleted {
+			kept = append(kept, c)
+			continue
+		}
+		if prev, ok := latest[c.Field]; !ok || isChangeAfter(c, prev) {
+			latest[c.Field] = c
+		}
+	}
+	for _, c := range kept {
+		var observed dinkur.VectorClock
+		if err := json.Unmarshal([]byte(c.Value), &observed); err != nil {
+			return line{}, fmt.Errorf("parse observed changes of deletion: %w", err)
+		}
+		if observedAllChanges(observed, changes) {
+			l.Deleted = true
+		}
+	}
+	for _, c := range latest {
+		kept = append(kept, c)
+	}
+	if c, ok := latest[dinkur.EntryChangeFieldName]; ok {
+		l.Name = c.Value
+	}
+	if c, ok := latest[dinkur.EntryChangeFieldStart]; ok {
+		l.Start = parseTime(c.Value)
+	}
+	if c, ok := latest[dinkur.EntryChangeFieldEnd]; ok {
+		l.End = parseTime(c.Value)
+	}
+	if c, ok := latest[dinkur.EntryChangeFieldContinues]; ok {
+		l.Continues = c.Value
+	}
+	sort.Slice(kept, func(i, j int) bool {
+		if kept[i].Field != kept[j].Field {
+			return kept[i].Field < kept[j].Field
+		}
+		return isChangeAfter(kept[j], kept[i])
+	})
+	l.Changes = make([]lineChange, len(kept))
+	for i, c := range kept {
+		l.Changes[i] = lineChange{
+			Field:     c.Field.String(),
+			NodeID:    c.NodeID,
+			Seq:       c.Seq,
+			Timestamp: c.Timestamp.UTC(),
+			Value:     c.Value,
+		}
+	}
+	return l, nil
+}
+
+// isChangeAfter uses the same ordering as the dinkurdb package when resolving
+// the latest change of a field.
+func isChangeAfter(a, b dinkur.EntryChange) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	if a.NodeID != b.NodeID {
+		return a.NodeID > b.NodeID
+	}
+	return a.Seq > b.Seq
+}
+
+func observedAllChanges(observed dinkur.VectorClock, changes []dinkur.EntryChange) bool {
+	for _, c := range changes {
+		if c.Field == dinkur.EntryChangeFieldDeleted {
+			continue
+		}
+		if observed[c.NodeID] < c.Seq {
+			return false
+		}
+	}
+	return true
+}
+
+func parseTime(s string) *time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
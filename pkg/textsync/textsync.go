@@ -0,0 +1,272 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package textsync mirrors the entries of a Dinkur database to a directory
+// of line-oriented text files, so they can be synced using a version control
+// system such as git, without running a Dinkur daemon on each computer.
+//
+// Each file holds the entries that started in a given month (in UTC), such as
+// "2022-07.jsonl", with one JSON-encoded entry per line, ordered by start
+// time. Entries are identified by their UUID, which never changes.
+//
+// Alongside the entry's values, each line holds the sync changes that set
+// them, as used by the dinkur.Sync interface. Importing a file applies those
+// changes to the database, where concurrent edits are resolved the same way
+// as when syncing two databases directly: each field is resolved to the
+// change with the latest timestamp. Lines may therefore be duplicated, such as
+// after a union merge in git, and still resolve to the same entries on every
+// computer. Exporting then rewrites the files from the database, removing any
+// duplicates.
+//
+// Deleted entries are kept as lines marked as deleted, so the deletion can be
+// imported on the other computers.
+package textsync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Errors specific to syncing entries via text files.
+var (
+	ErrInvalidLine = errors.New("invalid entry line")
+)
+
+const (
+	// FileExt is the file extension of the entry files.
+	FileExt = ".jsonl"
+	// monthLayout is the time layout of the entry file names, excluding the
+	// file extension.
+	monthLayout = "2006-01"
+)
+
+// Result holds the number of changes imported from, and files written to, the
+// directory.
+type Result struct {
+	// Imported is the number of changes that were new to the database.
+	Imported int
+	// Written is the number of files that were created, updated, or removed.
+	Written int
+}
+
+// Sync imports all changes from the directory into the database, and then
+// exports the resulting entries back to the directory.
+func Sync(ctx context.Context, client dinkur.Sync, dir string) (Result, error) {
+	imported, err := Import(ctx, client, dir)
+	if err != nil {
+		return Result{}, err
+	}
+	written, err := Export(ctx, client, dir)
+	if err != nil {
+		return Result{Imported: imported}, err
+	}
+	return Result{Imported: imported, Written: written}, nil
+}
+
+// Import applies the changes in all entry files in the directory to the
+// database, and returns the number of changes that were new to the database.
+// Lines that are not entries, such as git conflict markers, are ignored.
+func Import(ctx context.Context, client dinkur.Sync, dir string) (int, error) {
+	files, err := entryFiles(dir)
+	if err != nil {
+		return 0, err
+	}
+	var changes []dinkur.EntryChange
+	for _, file := range files {
+		fileChanges, err := readFileChanges(file)
+		if err != nil {
+			return 0, err
+		}
+		changes = append(changes, fileChanges...)
+	}
+	if len(changes) == 0 {
+		return 0, nil
+	}
+	known, err := client.GetSyncChanges(ctx, dinkur.SearchSyncChanges{})
+	if err != nil {
+		return 0, fmt.Errorf("get database changes: %w", err)
+	}
+	knownIDs := make(map[changeID]struct{}, len(known))
+	for _, c := range known {
+		knownIDs[changeID{c.NodeID, c.Seq}] = struct{}{}
+	}
+	var newChanges []dinkur.EntryChange
+	for _, c := range changes {
+		id := changeID{c.NodeID, c.Seq}
+		if _, ok := knownIDs[id]; ok {
+			continue
+		}
+		knownIDs[id] = struct{}{}
+		newChanges = append(newChanges, c)
+	}
+	if len(newChanges) == 0 {
+		return 0, nil
+	}
+	if err := client.ApplySyncChanges(ctx, newChanges); err != nil {
+		return 0, fmt.Errorf("apply changes: %w", err)
+	}
+	return len(newChanges), nil
+}
+
+// Export writes the entries of the database to one file per month in the
+// directory, and returns the number of files that were created, updated, or
+// removed. Files are only written if their content changed.
+func Export(ctx context.Context, client dinkur.Sync, dir string) (int, error) {
+	changes, err := client.GetSyncChanges(ctx, dinkur.SearchSyncChanges{})
+	if err != nil {
+		return 0, fmt.Errorf("get database changes: %w", err)
+	}
+	lines, err := resolveLines(changes)
+	if err != nil {
+		return 0, err
+	}
+	byFile := make(map[string][]line)
+	for _, l := range lines {
+		name := l.month().Format(monthLayout) + FileExt
+		byFile[name] = append(byFile[name], l)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, fmt.Errorf("create directory: %w", err)
+	}
+	written := 0
+	for name, fileLines := range byFile {
+		changed, err := writeFileLines(filepath.Join(dir, name), fileLines)
+		if err != nil {
+			return written, err
+		}
+		if changed {
+			written++
+		}
+	}
+	files, err := entryFiles(dir)
+	if err != nil {
+		return written, err
+	}
+	for _, file := range files {
+		if _, ok := byFile[filepath.Base(file)]; ok {
+			continue
+		}
+		if err := os.Remove(file); err != nil {
+			return written, fmt.Errorf("remove file: %w", err)
+		}
+		written++
+	}
+	return written, nil
+}
+
+type changeID struct {
+	nodeID string
+	seq    uint64
+}
+
+// entryFiles returns the paths of all entry files in the directory, sorted by
+// name. A missing directory has no files.
+func entryFiles(dir string) ([]string, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read directory: %w", err)
+	}
+	var files []string
+	for _, e := range dirEntries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, FileExt) {
+			continue
+		}
+		if _, err := time.Parse(monthLayout, strings.TrimSuffix(name, FileExt)); err != nil {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	return files, nil
+}
+
+func readFileChanges(path string) ([]dinkur.EntryChange, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+	var changes []dinkur.EntryChange
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		text := bytes.TrimSpace(scanner.Bytes())
+		// skips empty lines and git conflict markers
+		if len(text) == 0 || text[0] != '{' {
+			continue
+		}
+		var l line
+		if err := json.Unmarshal(text, &l); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w: %v", path, lineNum, ErrInvalidLine, err)
+		}
+		lineChanges, err := l.entryChanges()
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+		}
+		changes = append(changes, lineChanges...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	return changes, nil
+}
+
+func writeFileLines(path string, lines []line) (bool, error) {
+	sort.Slice(lines, func(i, j int) bool {
+		a, b := lines[i].sortTime(), lines[j].sortTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return lines[i].ID < lines[j].ID
+	})
+	var buf bytes.Buffer
+	for _, l := range lines {
+		data, err := json.Marshal(l)
+		if err != nil {
+			return false, fmt.Errorf("encode entry %s: %w", l.ID, err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	old, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(old, buf.Bytes()) {
+		return false, nil
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return false, fmt.Errorf("write file: %w", err)
+	}
+	return true, nil
+}
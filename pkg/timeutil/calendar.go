@@ -0,0 +1,213 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeutil
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnknownWeekday is returned when parsing a weekday name that is not
+	// recognized.
+	ErrUnknownWeekday = errors.New("unknown weekday")
+)
+
+// DefaultCalendar is the calendar used when none is specified, with weeks
+// starting on monday, monday to friday as working weekdays, and no holidays.
+var DefaultCalendar = Calendar{
+	FirstWeekday: time.Monday,
+	Workdays: []time.Weekday{
+		time.Monday,
+		time.Tuesday,
+		time.Wednesday,
+		time.Thursday,
+		time.Friday,
+	},
+}
+
+// Calendar holds regional settings used when calculating time spans, such as
+// weeks, and when separating workdays from weekends and holidays.
+type Calendar struct {
+	// FirstWeekday is the weekday that each week starts on.
+	FirstWeekday time.Weekday
+	// Workdays are the working weekdays. All other weekdays are considered
+	// weekend.
+	Workdays []time.Weekday
+	// Holidays are dates that are not working days, even if they fall on one
+	// of the working weekdays.
+	Holidays []Holiday
+}
+
+// Holiday is a single date that is not a working day.
+type Holiday struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Name  string
+}
+
+// String returns the holiday's date in ISO 8601 format, followed by its name.
+func (h Holiday) String() string {
+	return strings.TrimSpace(fmt.Sprintf("%04d-%02d-%02d %s", h.Year, h.Month, h.Day, h.Name))
+}
+
+// Span returns a TimeSpan for the shorthand given a specific reference time of
+// when "now" is, using this calendar's first day of the week. The count is
+// the number of units used by rolling shorthands, such as the N in
+// "last N days".
+func (c Calendar) Span(s TimeSpanShorthand, now time.Time, count uint) TimeSpan {
+	switch s {
+	case TimeSpanThisWeek:
+		return c.Week(now)
+	case TimeSpanPrevWeek:
+		return c.Week(now.AddDate(0, 0, -7))
+	case TimeSpanNextWeek:
+		return c.Week(now.AddDate(0, 0, 7))
+	default:
+		return s.spanCount(now, count)
+	}
+}
+
+// Week returns a TimeSpan from 00:00 on the first weekday - 23:59 on the last
+// weekday for the same week as "now".
+func (c Calendar) Week(now time.Time) TimeSpan {
+	var (
+		y, m, d    = now.Date()
+		loc        = now.Location()
+		sinceFirst = c.DaysSinceFirstWeekday(now.Weekday())
+		start      = time.Date(y, m, d-sinceFirst, 0, 0, 0, 0, loc)
+		end        = time.Date(y, m, d+6-sinceFirst, 23, 59, 59, 9999, loc)
+	)
+	return TimeSpan{&start, &end}
+}
+
+// DaysSinceFirstWeekday returns the number of days that has passed since
+// the last time it was the calendar's first weekday.
+func (c Calendar) DaysSinceFirstWeekday(day time.Weekday) int {
+	return (int(day) - int(c.FirstWeekday) + 7) % 7
+}
+
+// IsWorkday returns true if the date of the time is one of the working
+// weekdays and not a holiday.
+func (c Calendar) IsWorkday(t time.Time) bool {
+	if _, ok := c.Holiday(t); ok {
+		return false
+	}
+	return c.IsWorkingWeekday(t.Weekday())
+}
+
+// IsWorkingWeekday returns true if the weekday is one of the working
+// weekdays, regardless of any holidays.
+func (c Calendar) IsWorkingWeekday(day time.Weekday) bool {
+	for _, wd := range c.Workdays {
+		if wd == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Holiday returns the holiday on the same date as the time, if any.
+func (c Calendar) Holiday(t time.Time) (Holiday, bool) {
+	y, m, d := t.Date()
+	for _, h := range c.Holidays {
+		if h.Year == y && h.Month == m && h.Day == d {
+			return h, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// CountWorkdays returns the number of workdays within the time span, where both
+// the start and end dates are included. Zero is returned if the span has no
+// start or end.
+func (c Calendar) CountWorkdays(span TimeSpan) int {
+	if span.Start == nil || span.End == nil {
+		return 0
+	}
+	var (
+		y, m, d = span.Start.Date()
+		loc     = span.Start.Location()
+		count   int
+	)
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); !day.After(*span.End); day = day.AddDate(0, 0, 1) {
+		if c.IsWorkday(day) {
+			count++
+		}
+	}
+	return count
+}
+
+// ParseWeekday parses an English weekday name, such as "monday" or "mon",
+// case insensitive.
+func ParseWeekday(s string) (time.Weekday, error) {
+	lower := strings.ToLower(strings.TrimSpace(s))
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if lower == name || lower == name[:3] {
+			return wd, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("%w: %q", ErrUnknownWeekday, s)
+}
+
+// ReadHolidays parses a list of holidays, with one holiday per line in the
+// format of an ISO 8601 date followed by an optional name, such as:
+//
+//	2022-12-24 Christmas Eve
+//	2022-12-25 Christmas Day
+//
+// Empty lines and lines starting with # are ignored.
+func ReadHolidays(r io.Reader) ([]Holiday, error) {
+	var holidays []Holiday
+	scanner := bufio.NewScanner(r)
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		dateStr, name := line, ""
+		if i := strings.IndexAny(line, " \t"); i != -1 {
+			dateStr, name = line[:i], strings.TrimSpace(line[i+1:])
+		}
+		date, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		holidays = append(holidays, Holiday{
+			Year:  date.Year(),
+			Month: date.Month(),
+			Day:   date.Day(),
+			Name:  name,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
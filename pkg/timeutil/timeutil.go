@@ -56,6 +56,36 @@ const (
 	TimeSpanNextDay
 	// TimeSpanNextWeek represents a TimeSpan of 00:00 monday - 23:59 sunday next week
 	TimeSpanNextWeek
+	// TimeSpanThisMonth represents a TimeSpan of 00:00 on the 1st - 23:59 on
+	// the last day of this month
+	TimeSpanThisMonth
+	// TimeSpanPrevMonth represents a TimeSpan of 00:00 on the 1st - 23:59 on
+	// the last day of last month
+	TimeSpanPrevMonth
+	// TimeSpanNextMonth represents a TimeSpan of 00:00 on the 1st - 23:59 on
+	// the last day of next month
+	TimeSpanNextMonth
+	// TimeSpanThisQuarter represents a TimeSpan of 00:00 on the first day -
+	// 23:59 on the last day of this quarter
+	TimeSpanThisQuarter
+	// TimeSpanPrevQuarter represents a TimeSpan of 00:00 on the first day -
+	// 23:59 on the last day of last quarter
+	TimeSpanPrevQuarter
+	// TimeSpanNextQuarter represents a TimeSpan of 00:00 on the first day -
+	// 23:59 on the last day of next quarter
+	TimeSpanNextQuarter
+	// TimeSpanThisYear represents a TimeSpan of 00:00 january 1st - 23:59
+	// december 31st this year
+	TimeSpanThisYear
+	// TimeSpanPrevYear represents a TimeSpan of 00:00 january 1st - 23:59
+	// december 31st last year
+	TimeSpanPrevYear
+	// TimeSpanNextYear represents a TimeSpan of 00:00 january 1st - 23:59
+	// december 31st next year
+	TimeSpanNextYear
+	// TimeSpanLastDays represents a rolling TimeSpan of 00:00 N-1 days ago -
+	// 23:59 today, where N is the count given to SpanCount.
+	TimeSpanLastDays
 )
 
 func (s TimeSpanShorthand) String() string {
@@ -78,13 +108,49 @@ func (s TimeSpanShorthand) String() string {
 		return "tomorrow"
 	case TimeSpanNextWeek:
 		return "next week"
+	case TimeSpanThisMonth:
+		return "month"
+	case TimeSpanPrevMonth:
+		return "last month"
+	case TimeSpanNextMonth:
+		return "next month"
+	case TimeSpanThisQuarter:
+		return "quarter"
+	case TimeSpanPrevQuarter:
+		return "last quarter"
+	case TimeSpanNextQuarter:
+		return "next quarter"
+	case TimeSpanThisYear:
+		return "year"
+	case TimeSpanPrevYear:
+		return "last year"
+	case TimeSpanNextYear:
+		return "next year"
+	case TimeSpanLastDays:
+		return "last days"
 	default:
 		return fmt.Sprintf("%[1]T(%[1]d)", s)
 	}
 }
 
 // Span returns a TimeSpan given a specific reference time of when "now" is.
+// Rolling shorthands, such as TimeSpanLastDays, use a count of 1.
 func (s TimeSpanShorthand) Span(now time.Time) TimeSpan {
+	return s.SpanCount(now, 1)
+}
+
+// SpanCount returns a TimeSpan given a specific reference time of when "now"
+// is, where the count is the number of units used by rolling shorthands, such
+// as the N in "last N days". The count is ignored by all other shorthands, and
+// a count of zero is treated as 1.
+//
+// The DefaultCalendar is used for week-based shorthands. Use Calendar.Span to
+// use a different calendar.
+func (s TimeSpanShorthand) SpanCount(now time.Time, count uint) TimeSpan {
+	return DefaultCalendar.Span(s, now, count)
+}
+
+func (s TimeSpanShorthand) spanCount(now time.Time, count uint) TimeSpan {
 	switch s {
 	case TimeSpanPast:
 		return TimeSpan{nil, &now}
@@ -92,16 +158,30 @@ func (s TimeSpanShorthand) Span(now time.Time) TimeSpan {
 		return TimeSpan{&now, nil}
 	case TimeSpanThisDay:
 		return Day(now)
-	case TimeSpanThisWeek:
-		return Week(now)
 	case TimeSpanPrevDay:
 		return Day(now.Add(-24 * time.Hour))
-	case TimeSpanPrevWeek:
-		return Week(now.Add(-7 * 24 * time.Hour))
 	case TimeSpanNextDay:
 		return Day(now.Add(24 * time.Hour))
-	case TimeSpanNextWeek:
-		return Week(now.Add(7 * 24 * time.Hour))
+	case TimeSpanThisMonth:
+		return Month(now)
+	case TimeSpanPrevMonth:
+		return Month(firstOfMonth(now).AddDate(0, -1, 0))
+	case TimeSpanNextMonth:
+		return Month(firstOfMonth(now).AddDate(0, 1, 0))
+	case TimeSpanThisQuarter:
+		return Quarter(now)
+	case TimeSpanPrevQuarter:
+		return Quarter(firstOfMonth(now).AddDate(0, -3, 0))
+	case TimeSpanNextQuarter:
+		return Quarter(firstOfMonth(now).AddDate(0, 3, 0))
+	case TimeSpanThisYear:
+		return Year(now)
+	case TimeSpanPrevYear:
+		return Year(now.AddDate(-1, 0, 0))
+	case TimeSpanNextYear:
+		return Year(now.AddDate(1, 0, 0))
+	case TimeSpanLastDays:
+		return LastDays(now, count)
 	default:
 		return TimeSpan{}
 	}
@@ -119,25 +199,77 @@ func Day(now time.Time) TimeSpan {
 }
 
 // Week returns a TimeSpan from 00:00 monday - 23:59 sunday for the same week
-// as "now".
+// as "now". Use Calendar.Week for weeks starting on other weekdays.
 func Week(now time.Time) TimeSpan {
+	return Calendar{FirstWeekday: time.Monday}.Week(now)
+}
+
+// Month returns a TimeSpan from 00:00 on the 1st - 23:59 on the last day of
+// the same month as "now".
+func Month(now time.Time) TimeSpan {
+	var (
+		y, m, _ = now.Date()
+		loc     = now.Location()
+		start   = time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		end     = time.Date(y, m+1, 0, 23, 59, 59, 9999, loc)
+	)
+	return TimeSpan{&start, &end}
+}
+
+// Quarter returns a TimeSpan from 00:00 on the first day - 23:59 on the last
+// day of the same quarter as "now", where the quarters start in january,
+// april, july, and october.
+func Quarter(now time.Time) TimeSpan {
 	var (
-		y, m, d     = now.Date()
-		loc         = now.Location()
-		wd          = now.Weekday()
-		sinceMonday = DaysSinceMonday(wd)
-		start       = time.Date(y, m, d-sinceMonday, 0, 0, 0, 0, loc)
-		end         = time.Date(y, m, d+6-sinceMonday, 23, 59, 59, 9999, loc)
+		y, m, _    = now.Date()
+		loc        = now.Location()
+		firstMonth = m - (m-1)%3
+		start      = time.Date(y, firstMonth, 1, 0, 0, 0, 0, loc)
+		end        = time.Date(y, firstMonth+3, 0, 23, 59, 59, 9999, loc)
 	)
 	return TimeSpan{&start, &end}
 }
 
+// Year returns a TimeSpan from 00:00 january 1st - 23:59 december 31st for the
+// same year as "now".
+func Year(now time.Time) TimeSpan {
+	var (
+		y     = now.Year()
+		loc   = now.Location()
+		start = time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+		end   = time.Date(y, time.December, 31, 23, 59, 59, 9999, loc)
+	)
+	return TimeSpan{&start, &end}
+}
+
+// LastDays returns a TimeSpan from 00:00 N-1 days ago - 23:59 today, so that
+// it contains N days including today. A count of zero is treated as 1.
+func LastDays(now time.Time, count uint) TimeSpan {
+	if count == 0 {
+		count = 1
+	}
+	var (
+		y, m, d = now.Date()
+		loc     = now.Location()
+		start   = time.Date(y, m, d-int(count)+1, 0, 0, 0, 0, loc)
+		end     = time.Date(y, m, d, 23, 59, 59, 9999, loc)
+	)
+	return TimeSpan{&start, &end}
+}
+
+func firstOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
+
 // DaysSinceMonday returns the number of days has passed since last time it was
 // a monday.
 //
 // This function is a naïve implementation that assumes all weeks have the
 // 7 weekdays. In reality, there are some weird edge cases where some regions
 // have skipped some days, but those cases are left as "undefined behavior".
+//
+// Use Calendar.DaysSinceFirstWeekday for weeks starting on other weekdays.
 func DaysSinceMonday(day time.Weekday) int {
 	switch day {
 	case time.Tuesday:
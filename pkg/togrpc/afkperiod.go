@@ -0,0 +1,68 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AFKPeriodPtr converts a Go AFK period to a gRPC AFK period, or nil.
+func AFKPeriodPtr(period *dinkur.AFKPeriod) *dinkurapiv1.AfkPeriod {
+	if period == nil {
+		return nil
+	}
+	return &dinkurapiv1.AfkPeriod{
+		Id:         uint64(period.ID),
+		Created:    Timestamp(period.CreatedAt),
+		Updated:    Timestamp(period.UpdatedAt),
+		Start:      Timestamp(period.Start),
+		End:        TimestampPtr(period.End),
+		Hook:       period.Hook,
+		Resolution: AFKResolution(period.Resolution),
+	}
+}
+
+// AFKPeriodSlice converts a slice of Go AFK periods to gRPC AFK periods.
+func AFKPeriodSlice(slice []dinkur.AFKPeriod) []*dinkurapiv1.AfkPeriod {
+	periods := make([]*dinkurapiv1.AfkPeriod, len(slice))
+	for i, p := range slice {
+		periods[i] = AFKPeriodPtr(&p)
+	}
+	return periods
+}
+
+// AFKResolution converts a Go AFK resolution to a gRPC AFK resolution.
+func AFKResolution(r dinkur.AFKResolution) dinkurapiv1.AfkResolution {
+	switch r {
+	case dinkur.AFKResolutionKeep:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_KEEP
+	case dinkur.AFKResolutionDiscard:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_DISCARD
+	case dinkur.AFKResolutionNewEntry:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_NEW_ENTRY
+	case dinkur.AFKResolutionDismissed:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_DISMISSED
+	case dinkur.AFKResolutionSplit:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_SPLIT
+	default:
+		return dinkurapiv1.AfkResolution_AFK_RESOLUTION_UNSPECIFIED
+	}
+}
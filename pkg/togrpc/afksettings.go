@@ -0,0 +1,52 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AFKSettings converts Go AFK settings to gRPC AFK settings.
+func AFKSettings(s dinkur.AFKSettings) *dinkurapiv1.AfkSettings {
+	return &dinkurapiv1.AfkSettings{
+		Threshold:      Duration(s.Threshold),
+		PollInterval:   Duration(s.PollInterval),
+		AllowHooks:     s.AllowHooks,
+		DenyHooks:      s.DenyHooks,
+		AvailableHooks: s.AvailableHooks,
+	}
+}
+
+// EditAFKSettings converts a Go AFK settings edit to a gRPC request to update
+// the AFK settings.
+func EditAFKSettings(edit dinkur.EditAFKSettings) *dinkurapiv1.UpdateAfkSettingsRequest {
+	return &dinkurapiv1.UpdateAfkSettingsRequest{
+		Settings: &dinkurapiv1.AfkSettings{
+			Threshold:    DurationPtr(edit.Threshold),
+			PollInterval: DurationPtr(edit.PollInterval),
+			AllowHooks:   edit.AllowHooks,
+			DenyHooks:    edit.DenyHooks,
+		},
+		ClearAllowHooks: edit.ClearAllowHooks,
+		ClearDenyHooks:  edit.ClearDenyHooks,
+	}
+}
@@ -36,6 +36,9 @@ func EntryPtr(entry *dinkur.Entry) *dinkurapiv1.Entry {
 		Name:    entry.Name,
 		Start:   Timestamp(entry.Start),
 		End:     TimestampPtr(entry.End),
+		Uuid:    entry.UUID,
+
+		ContinuesUuid: entry.ContinuesUUID,
 	}
 }
 
@@ -0,0 +1,45 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Peer converts a Go peer to a gRPC peer.
+func Peer(peer dinkur.Peer) *dinkurapiv1.Peer {
+	return &dinkurapiv1.Peer{
+		Name:           peer.Name,
+		Address:        peer.Address,
+		TlsFingerprint: peer.TLSFingerprint,
+		Paired:         Timestamp(peer.Paired),
+	}
+}
+
+// PeerSlice converts a slice of Go peers to gRPC peers.
+func PeerSlice(slice []dinkur.Peer) []*dinkurapiv1.Peer {
+	peers := make([]*dinkurapiv1.Peer, len(slice))
+	for i, p := range slice {
+		peers[i] = Peer(p)
+	}
+	return peers
+}
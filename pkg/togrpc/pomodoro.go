@@ -0,0 +1,109 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// PomodoroPtr converts a Go Pomodoro timer state to a gRPC Pomodoro timer
+// state, or nil.
+func PomodoroPtr(p *dinkur.Pomodoro) *dinkurapiv1.PomodoroState {
+	if p == nil {
+		return nil
+	}
+	return &dinkurapiv1.PomodoroState{
+		EntryName:       p.EntryName,
+		Settings:        PomodoroSettings(p.Settings),
+		Phase:           PomodoroPhase(p.Phase),
+		PhaseStart:      Timestamp(p.PhaseStart),
+		PhaseEnd:        Timestamp(p.PhaseEnd),
+		CompletedCycles: uint64(p.CompletedCycles),
+	}
+}
+
+// PomodoroSettings converts Go Pomodoro settings to gRPC Pomodoro settings.
+func PomodoroSettings(s dinkur.PomodoroSettings) *dinkurapiv1.PomodoroSettings {
+	return &dinkurapiv1.PomodoroSettings{
+		Work:           Duration(s.Work),
+		Break:          Duration(s.Break),
+		LongBreak:      Duration(s.LongBreak),
+		LongBreakEvery: uint64(s.LongBreakEvery),
+		BreakEntryName: s.BreakEntryName,
+	}
+}
+
+// PomodoroPhase converts a Go Pomodoro phase to a gRPC Pomodoro phase.
+func PomodoroPhase(p dinkur.PomodoroPhase) dinkurapiv1.PomodoroPhase {
+	switch p {
+	case dinkur.PomodoroPhaseWork:
+		return dinkurapiv1.PomodoroPhase_POMODORO_PHASE_WORK
+	case dinkur.PomodoroPhaseBreak:
+		return dinkurapiv1.PomodoroPhase_POMODORO_PHASE_BREAK
+	case dinkur.PomodoroPhaseLongBreak:
+		return dinkurapiv1.PomodoroPhase_POMODORO_PHASE_LONG_BREAK
+	default:
+		return dinkurapiv1.PomodoroPhase_POMODORO_PHASE_UNSPECIFIED
+	}
+}
+
+// PomodoroEvent converts a Go Pomodoro event to a gRPC Pomodoro event.
+func PomodoroEvent(e dinkur.PomodoroEvent) dinkurapiv1.PomodoroEvent {
+	switch e {
+	case dinkur.PomodoroEventStarted:
+		return dinkurapiv1.PomodoroEvent_POMODORO_EVENT_STARTED
+	case dinkur.PomodoroEventBreakStarted:
+		return dinkurapiv1.PomodoroEvent_POMODORO_EVENT_BREAK_STARTED
+	case dinkur.PomodoroEventWorkStarted:
+		return dinkurapiv1.PomodoroEvent_POMODORO_EVENT_WORK_STARTED
+	case dinkur.PomodoroEventStopped:
+		return dinkurapiv1.PomodoroEvent_POMODORO_EVENT_STOPPED
+	default:
+		return dinkurapiv1.PomodoroEvent_POMODORO_EVENT_UNSPECIFIED
+	}
+}
+
+// PomodoroCyclePtr converts a Go Pomodoro cycle to a gRPC Pomodoro cycle, or
+// nil.
+func PomodoroCyclePtr(cycle *dinkur.PomodoroCycle) *dinkurapiv1.PomodoroCycle {
+	if cycle == nil {
+		return nil
+	}
+	return &dinkurapiv1.PomodoroCycle{
+		Id:        uint64(cycle.ID),
+		Created:   Timestamp(cycle.CreatedAt),
+		Updated:   Timestamp(cycle.UpdatedAt),
+		EntryUuid: cycle.EntryUUID,
+		Start:     Timestamp(cycle.Start),
+		End:       Timestamp(cycle.End),
+	}
+}
+
+// PomodoroCycleSlice converts a slice of Go Pomodoro cycles to gRPC Pomodoro
+// cycles.
+func PomodoroCycleSlice(slice []dinkur.PomodoroCycle) []*dinkurapiv1.PomodoroCycle {
+	cycles := make([]*dinkurapiv1.PomodoroCycle, len(slice))
+	for i, c := range slice {
+		cycles[i] = PomodoroCyclePtr(&c)
+	}
+	return cycles
+}
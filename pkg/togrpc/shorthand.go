@@ -43,6 +43,26 @@ func Shorthand(s timeutil.TimeSpanShorthand) dinkurapiv1.GetEntryListRequest_Sho
 		return dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_DAY
 	case timeutil.TimeSpanNextWeek:
 		return dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_MON_TO_SUN
+	case timeutil.TimeSpanThisMonth:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_MONTH
+	case timeutil.TimeSpanPrevMonth:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_MONTH
+	case timeutil.TimeSpanNextMonth:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_MONTH
+	case timeutil.TimeSpanThisQuarter:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_QUARTER
+	case timeutil.TimeSpanPrevQuarter:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_QUARTER
+	case timeutil.TimeSpanNextQuarter:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_QUARTER
+	case timeutil.TimeSpanThisYear:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_THIS_YEAR
+	case timeutil.TimeSpanPrevYear:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_PREV_YEAR
+	case timeutil.TimeSpanNextYear:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_NEXT_YEAR
+	case timeutil.TimeSpanLastDays:
+		return dinkurapiv1.GetEntryListRequest_SHORTHAND_LAST_DAYS
 	default:
 		return dinkurapiv1.GetEntryListRequest_SHORTHAND_UNSPECIFIED
 	}
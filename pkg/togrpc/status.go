@@ -31,5 +31,10 @@ func Status(status dinkur.Status) *dinkurapiv1.Status {
 		Updated:   Timestamp(status.UpdatedAt),
 		AfkSince:  TimestampPtr(status.AFKSince),
 		BackSince: TimestampPtr(status.BackSince),
+		Heartbeat: TimestampPtr(status.Heartbeat),
+
+		NotTrackingSince:   TimestampPtr(status.NotTrackingSince),
+		SuggestedEntryName: status.SuggestedEntryName,
+		PausedEntryId:      uint64(status.PausedEntryID),
 	}
 }
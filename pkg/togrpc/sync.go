@@ -0,0 +1,66 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// EntryChange converts a Go entry change to a gRPC entry change.
+func EntryChange(change dinkur.EntryChange) *dinkurapiv1.EntryChange {
+	return &dinkurapiv1.EntryChange{
+		EntryUuid: change.EntryUUID,
+		NodeId:    change.NodeID,
+		Seq:       change.Seq,
+		Timestamp: Timestamp(change.Timestamp),
+		Field:     EntryChangeField(change.Field),
+		Value:     change.Value,
+	}
+}
+
+// EntryChangeSlice converts a slice of Go entry changes to gRPC entry changes.
+func EntryChangeSlice(slice []dinkur.EntryChange) []*dinkurapiv1.EntryChange {
+	changes := make([]*dinkurapiv1.EntryChange, len(slice))
+	for i, c := range slice {
+		changes[i] = EntryChange(c)
+	}
+	return changes
+}
+
+// EntryChangeField converts a Go entry change field to a gRPC entry change
+// field.
+func EntryChangeField(f dinkur.EntryChangeField) dinkurapiv1.EntryChangeField {
+	switch f {
+	case dinkur.EntryChangeFieldName:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_NAME
+	case dinkur.EntryChangeFieldStart:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_START
+	case dinkur.EntryChangeFieldEnd:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_END
+	case dinkur.EntryChangeFieldDeleted:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_DELETED
+	case dinkur.EntryChangeFieldContinues:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_CONTINUES
+	default:
+		return dinkurapiv1.EntryChangeField_ENTRY_CHANGE_FIELD_UNSPECIFIED
+	}
+}